@@ -2,23 +2,33 @@ package config
 
 import (
     "fmt"
+    "time"
 
     "github.com/caarlos0/env/v11"
 )
 
 type (
     Config struct {
-        App      App
-        Postgres Postgres
-        Log      Log
-        Swagger  Swagger
-        Metrics  Metrics
-        HTTP     HTTP
-        Redis    Redis
+        App         App
+        Postgres    Postgres
+        Log         Log
+        Swagger     Swagger
+        Metrics     Metrics
+        HTTP        HTTP
+        GRPC        GRPC
+        GraphQL     GraphQL
+        Redis       Redis
+        JWT         JWT
+        Payment     Payment
+        Certificate Certificate
+        Waitlist    Waitlist
+        Blog        Blog
+        Outbox      Outbox
     }
 
     App struct {
         Name string `env:"APP_NAME,required"`
+        Env  string `env:"APP_ENV" envDefault:"production"` // local, dev or production
     }
 
     Postgres struct {
@@ -36,6 +46,55 @@ type (
         RedisHost     string `env:"REDIS_HOST,required"`
         RedisPort     string `env:"REDIS_PORT,required"`
         RedisDbName   string `env:"REDIS_DB_NAME,required"`
+
+        CourseTTL   time.Duration `env:"REDIS_COURSE_TTL"   envDefault:"10m"`
+        UserTTL     time.Duration `env:"REDIS_USER_TTL"     envDefault:"10m"`
+        NotFoundTTL time.Duration `env:"REDIS_NOT_FOUND_TTL" envDefault:"1m"` // Negative caching of missing IDs
+
+        ReportTTL      time.Duration `env:"REDIS_REPORT_TTL"       envDefault:"5m"`
+        ReportStaleTTL time.Duration `env:"REDIS_REPORT_STALE_TTL" envDefault:"10m"` // Served while being rebuilt
+        ReportLockTTL  time.Duration `env:"REDIS_REPORT_LOCK_TTL"  envDefault:"30s"` // Upper bound of a rebuild
+    }
+
+    // JWT -.
+    JWT struct {
+        Secret     string        `env:"JWT_SECRET,required"`
+        AccessTTL  time.Duration `env:"JWT_ACCESS_TTL"  envDefault:"15m"`
+        RefreshTTL time.Duration `env:"JWT_REFRESH_TTL" envDefault:"720h"`
+    }
+
+    // Payment -.
+    Payment struct {
+        Provider       string `env:"PAYMENT_PROVIDER,required"` // fake, allowed only in local and dev environments
+        WebhookSecret  string `env:"PAYMENT_WEBHOOK_SECRET,required"`
+        FakeWebhookURL string `env:"PAYMENT_FAKE_WEBHOOK_URL" envDefault:"http://localhost:8080/v1/payments/webhook"`
+    }
+
+    // Certificate -.
+    Certificate struct {
+        SigningKey string `env:"CERTIFICATE_SIGNING_KEY,required"`
+        VerifyURL  string `env:"CERTIFICATE_VERIFY_URL" envDefault:"http://localhost:8080/v1/certificates/verify/"`
+    }
+
+    // Waitlist -.
+    Waitlist struct {
+        HoldTTL        time.Duration `env:"WAITLIST_HOLD_TTL"        envDefault:"24h"` // How long a freed seat is held
+        ExpiryInterval time.Duration `env:"WAITLIST_EXPIRY_INTERVAL" envDefault:"1m"`  // How often expired holds are released
+    }
+
+    // Blog -.
+    Blog struct {
+        PublishInterval time.Duration `env:"BLOG_PUBLISH_INTERVAL" envDefault:"1m"` // How often scheduled posts are published
+    }
+
+    // Outbox -.
+    Outbox struct {
+        RelayInterval time.Duration `env:"OUTBOX_RELAY_INTERVAL" envDefault:"1s"`     // How often pending events are published
+        BatchSize     uint32        `env:"OUTBOX_BATCH_SIZE"     envDefault:"100"`    // Events published per run
+        MaxAttempts   int           `env:"OUTBOX_MAX_ATTEMPTS"   envDefault:"10"`     // Attempts before an event is marked as failed
+        RetryBackoff  time.Duration `env:"OUTBOX_RETRY_BACKOFF"  envDefault:"5s"`     // First retry delay, doubled with every attempt
+        Stream        string        `env:"OUTBOX_STREAM"         envDefault:"events"` // Redis stream the events are published to
+        StreamMaxLen  int64         `env:"OUTBOX_STREAM_MAX_LEN" envDefault:"100000"` // Approximate, 0 disables trimming
     }
 
     // Log -.
@@ -58,6 +117,21 @@ type (
         Port           string `env:"HTTP_PORT,required"`
         UsePreforkMode bool   `env:"HTTP_USE_PREFORK_MODE" envDefault:"false"`
     }
+
+    // GRPC -.
+    GRPC struct {
+        Port              string `env:"GRPC_PORT,required"`
+        ReflectionEnabled bool   `env:"GRPC_REFLECTION_ENABLED" envDefault:"true"`
+    }
+
+    // GraphQL -.
+    GraphQL struct {
+        Enabled        bool          `env:"GRAPHQL_ENABLED"          envDefault:"true"`
+        MaxDepth       int           `env:"GRAPHQL_MAX_DEPTH"        envDefault:"10"`
+        MaxComplexity  int           `env:"GRAPHQL_MAX_COMPLEXITY"   envDefault:"5000"`  // Estimated number of resolved fields
+        MaxQueryLength int           `env:"GRAPHQL_MAX_QUERY_LENGTH" envDefault:"10000"` // Bytes
+        BatchWait      time.Duration `env:"GRAPHQL_BATCH_WAIT"       envDefault:"2ms"`   // How long loaders collect keys
+    }
 )
 
 // NewConfig initializes a new Config instance by parsing environment variables.
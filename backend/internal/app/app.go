@@ -1,20 +1,29 @@
 package app
 
 import (
+    "context"
     "fmt"
     "os"
     "os/signal"
     "syscall"
 
     "github.com/deadnotxaa/education-platform/backend/config"
+    "github.com/deadnotxaa/education-platform/backend/internal/controller/grpc"
+    "github.com/deadnotxaa/education-platform/backend/internal/controller/grpc/interceptor"
     "github.com/deadnotxaa/education-platform/backend/internal/controller/http"
+    "github.com/deadnotxaa/education-platform/backend/internal/repo"
+    "github.com/deadnotxaa/education-platform/backend/internal/repo/broker"
     "github.com/deadnotxaa/education-platform/backend/internal/repo/cache"
+    "github.com/deadnotxaa/education-platform/backend/internal/repo/certificate"
+    "github.com/deadnotxaa/education-platform/backend/internal/repo/payment"
     "github.com/deadnotxaa/education-platform/backend/internal/repo/persistent"
     "github.com/deadnotxaa/education-platform/backend/internal/usecase/platform"
+    "github.com/deadnotxaa/education-platform/backend/pkg/grpcserver"
     "github.com/deadnotxaa/education-platform/backend/pkg/httpserver"
     "github.com/deadnotxaa/education-platform/backend/pkg/logger"
     "github.com/deadnotxaa/education-platform/backend/pkg/postgres"
     "github.com/deadnotxaa/education-platform/backend/pkg/redis"
+    "github.com/deadnotxaa/education-platform/backend/pkg/scheduler"
 )
 
 func Run(cfg *config.Config) {
@@ -59,19 +68,76 @@ func Run(cfg *config.Config) {
 
     // Use-Case
     rdbRepo := cache.New(rdb)
-    pgRepo := persistent.New(pg, rdbRepo)
+    pgRepo := persistent.New(pg, rdbRepo, l,
+        persistent.ReportCacheTTL(cfg.Redis.ReportTTL, cfg.Redis.ReportStaleTTL, cfg.Redis.ReportLockTTL),
+    )
+
+    paymentGateway, err := newPaymentGateway(cfg, l)
+    if err != nil {
+        l.Fatal(fmt.Errorf("app - Run - newPaymentGateway: %w", err))
+    }
 
     platformUseCase := platform.New(
         pgRepo,
         rdbRepo,
+        l,
+        platform.JWT(cfg.JWT.Secret, cfg.JWT.AccessTTL, cfg.JWT.RefreshTTL),
+        platform.CacheTTL(cfg.Redis.CourseTTL, cfg.Redis.UserTTL, cfg.Redis.NotFoundTTL),
+        platform.PaymentGateway(paymentGateway),
+        platform.Certificates(certificate.NewPDF(), cfg.Certificate.SigningKey, cfg.Certificate.VerifyURL),
+        platform.WaitlistHoldTTL(cfg.Waitlist.HoldTTL),
+        platform.EventPublisher(broker.NewRedisStream(rdb, cfg.Outbox.Stream, cfg.Outbox.StreamMaxLen)),
+        platform.OutboxRelay(cfg.Outbox.BatchSize, cfg.Outbox.MaxAttempts, cfg.Outbox.RetryBackoff),
     )
 
+    // Background jobs
+    sched := scheduler.New()
+    sched.Every(cfg.Waitlist.ExpiryInterval, func(ctx context.Context) {
+        expired, err := platformUseCase.ExpireWaitlistOffers(ctx)
+        if err != nil {
+            l.Error(fmt.Errorf("app - Run - platformUseCase.ExpireWaitlistOffers: %w", err))
+        }
+
+        if expired > 0 {
+            l.Info("app - Run - expired waitlist offers: %d", expired)
+        }
+    })
+    sched.Every(cfg.Blog.PublishInterval, func(ctx context.Context) {
+        published, err := platformUseCase.PublishScheduledBlogPosts(ctx)
+        if err != nil {
+            l.Error(fmt.Errorf("app - Run - platformUseCase.PublishScheduledBlogPosts: %w", err))
+        }
+
+        if published > 0 {
+            l.Info("app - Run - published scheduled blog posts: %d", published)
+        }
+    })
+
+    sched.Every(cfg.Outbox.RelayInterval, func(ctx context.Context) {
+        relayed, err := platformUseCase.RelayOutboxEvents(ctx)
+        if err != nil {
+            l.Error(fmt.Errorf("app - Run - platformUseCase.RelayOutboxEvents: %w", err))
+        }
+
+        if relayed > 0 {
+            l.Info("app - Run - relayed outbox events: %d", relayed)
+        }
+    })
+
     // HTTP Server
     httpServer := httpserver.New(httpserver.Port(cfg.HTTP.Port), httpserver.Prefork(cfg.HTTP.UsePreforkMode))
     http.NewRouter(httpServer.App, cfg, platformUseCase, l)
 
+    // gRPC Server
+    grpcServer := grpcserver.New(
+        grpcserver.Port(cfg.GRPC.Port),
+        grpcserver.UnaryInterceptors(interceptor.Logger(l), interceptor.Prometheus(), interceptor.Recovery(l)),
+    )
+    grpc.NewRouter(grpcServer.App, cfg, platformUseCase, l)
+
     // Start servers
     httpServer.Start()
+    grpcServer.Start()
 
     // Waiting signal
     interrupt := make(chan os.Signal, 1)
@@ -82,11 +148,35 @@ func Run(cfg *config.Config) {
         l.Info("app - Run - signal: %s", s.String())
     case err = <-httpServer.Notify():
         l.Error(fmt.Errorf("app - Run - httpServer.Notify: %w", err))
+    case err = <-grpcServer.Notify():
+        l.Error(fmt.Errorf("app - Run - grpcServer.Notify: %w", err))
     }
 
     // Shutdown
+    sched.Shutdown()
+
     err = httpServer.Shutdown()
     if err != nil {
         l.Error(fmt.Errorf("app - Run - httpServer.Shutdown: %w", err))
     }
+
+    err = grpcServer.Shutdown()
+    if err != nil {
+        l.Error(fmt.Errorf("app - Run - grpcServer.Shutdown: %w", err))
+    }
+}
+
+// newPaymentGateway picks the payment provider from the config. The fake provider captures without taking
+// any money, so the app refuses to start with it outside local and dev environments.
+func newPaymentGateway(cfg *config.Config, l logger.Interface) (repo.PaymentGateway, error) {
+    switch cfg.Payment.Provider {
+    case "fake":
+        if cfg.App.Env != "local" && cfg.App.Env != "dev" {
+            return nil, fmt.Errorf("fake payment provider is not allowed in %q environment", cfg.App.Env)
+        }
+
+        return payment.NewFake(cfg.Payment.WebhookSecret, cfg.Payment.FakeWebhookURL, l), nil
+    default:
+        return nil, fmt.Errorf("unknown payment provider %q", cfg.Payment.Provider)
+    }
 }
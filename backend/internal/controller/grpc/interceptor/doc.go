@@ -0,0 +1,2 @@
+// Package interceptor implements unary interceptors of the gRPC server, counterparts of the HTTP middleware.
+package interceptor
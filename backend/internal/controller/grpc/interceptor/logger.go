@@ -0,0 +1,42 @@
+package interceptor
+
+import (
+    "context"
+    "strings"
+    "time"
+
+    "github.com/deadnotxaa/education-platform/backend/pkg/logger"
+    "google.golang.org/grpc"
+    "google.golang.org/grpc/peer"
+    "google.golang.org/grpc/status"
+)
+
+func buildCallMessage(ctx context.Context, method string, err error, duration time.Duration) string {
+    var result strings.Builder
+
+    if p, ok := peer.FromContext(ctx); ok {
+        result.WriteString(p.Addr.String())
+    }
+
+    result.WriteString(" - ")
+    result.WriteString(method)
+    result.WriteString(" - ")
+    result.WriteString(status.Code(err).String())
+    result.WriteString(" ")
+    result.WriteString(duration.String())
+
+    return result.String()
+}
+
+// Logger logs every unary call with its status code and duration.
+func Logger(l logger.Interface) grpc.UnaryServerInterceptor {
+    return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+        start := time.Now()
+
+        resp, err := handler(ctx, req)
+
+        l.Info(buildCallMessage(ctx, info.FullMethod, err, time.Since(start)))
+
+        return resp, err
+    }
+}
@@ -0,0 +1,54 @@
+package interceptor
+
+import (
+    "context"
+    "time"
+
+    "github.com/prometheus/client_golang/prometheus"
+    "github.com/prometheus/client_golang/prometheus/promauto"
+    "google.golang.org/grpc"
+    "google.golang.org/grpc/status"
+)
+
+var (
+    grpcRequestsTotal = promauto.NewCounterVec(
+        prometheus.CounterOpts{
+            Name: "grpc_requests_total",
+            Help: "Total number of gRPC calls",
+        },
+        []string{"method", "code"},
+    )
+
+    grpcRequestDuration = promauto.NewHistogramVec(
+        prometheus.HistogramOpts{
+            Name:    "grpc_request_duration_seconds",
+            Help:    "Duration of gRPC calls in seconds",
+            Buckets: prometheus.DefBuckets,
+        },
+        []string{"method", "code"},
+    )
+
+    grpcRequestsInFlight = promauto.NewGauge(prometheus.GaugeOpts{
+        Name: "grpc_requests_in_flight",
+        Help: "Current number of gRPC calls being processed",
+    })
+)
+
+// Prometheus collects call metrics, they are exported by the /metrics route of the HTTP server.
+// Methods are registered service methods, so the label needs no normalization.
+func Prometheus() grpc.UnaryServerInterceptor {
+    return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+        start := time.Now()
+        grpcRequestsInFlight.Inc()
+
+        resp, err := handler(ctx, req)
+
+        code := status.Code(err).String()
+
+        grpcRequestsTotal.WithLabelValues(info.FullMethod, code).Inc()
+        grpcRequestDuration.WithLabelValues(info.FullMethod, code).Observe(time.Since(start).Seconds())
+        grpcRequestsInFlight.Dec()
+
+        return resp, err
+    }
+}
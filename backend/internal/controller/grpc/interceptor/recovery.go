@@ -0,0 +1,27 @@
+package interceptor
+
+import (
+    "context"
+    "fmt"
+    "runtime/debug"
+
+    "github.com/deadnotxaa/education-platform/backend/pkg/logger"
+    "google.golang.org/grpc"
+    "google.golang.org/grpc/codes"
+    "google.golang.org/grpc/status"
+)
+
+// Recovery turns a panic of a handler into an Internal status and logs its stack trace.
+func Recovery(l logger.Interface) grpc.UnaryServerInterceptor {
+    return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+        defer func() {
+            if r := recover(); r != nil {
+                l.Error(fmt.Sprintf("%s PANIC DETECTED: %v\n%s\n", info.FullMethod, r, debug.Stack()))
+
+                err = status.Error(codes.Internal, "internal server error")
+            }
+        }()
+
+        return handler(ctx, req)
+    }
+}
@@ -0,0 +1,5 @@
+// Package platformv1 contains the protobuf messages and gRPC services of the platform API, generated from the
+// .proto files of this directory.
+package platformv1
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative course.proto user.proto report.proto
@@ -0,0 +1,169 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: report.proto
+
+package platformv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ReportService_GetTopCoursesReport_FullMethodName = "/platform.v1.ReportService/GetTopCoursesReport"
+	ReportService_GetPurchaseReport_FullMethodName   = "/platform.v1.ReportService/GetPurchaseReport"
+)
+
+// ReportServiceClient is the client API for ReportService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ReportService - analytics reports, calls require the report:read permission and carry an
+// "authorization: Bearer <access token>" metadata entry.
+type ReportServiceClient interface {
+	// GetTopCoursesReport returns the best rated courses.
+	GetTopCoursesReport(ctx context.Context, in *GetTopCoursesReportRequest, opts ...grpc.CallOption) (*GetTopCoursesReportResponse, error)
+	// GetPurchaseReport returns the latest purchases filtered by date range and specialization.
+	GetPurchaseReport(ctx context.Context, in *GetPurchaseReportRequest, opts ...grpc.CallOption) (*GetPurchaseReportResponse, error)
+}
+
+type reportServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReportServiceClient(cc grpc.ClientConnInterface) ReportServiceClient {
+	return &reportServiceClient{cc}
+}
+
+func (c *reportServiceClient) GetTopCoursesReport(ctx context.Context, in *GetTopCoursesReportRequest, opts ...grpc.CallOption) (*GetTopCoursesReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTopCoursesReportResponse)
+	err := c.cc.Invoke(ctx, ReportService_GetTopCoursesReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reportServiceClient) GetPurchaseReport(ctx context.Context, in *GetPurchaseReportRequest, opts ...grpc.CallOption) (*GetPurchaseReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPurchaseReportResponse)
+	err := c.cc.Invoke(ctx, ReportService_GetPurchaseReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReportServiceServer is the server API for ReportService service.
+// All implementations must embed UnimplementedReportServiceServer
+// for forward compatibility.
+//
+// ReportService - analytics reports, calls require the report:read permission and carry an
+// "authorization: Bearer <access token>" metadata entry.
+type ReportServiceServer interface {
+	// GetTopCoursesReport returns the best rated courses.
+	GetTopCoursesReport(context.Context, *GetTopCoursesReportRequest) (*GetTopCoursesReportResponse, error)
+	// GetPurchaseReport returns the latest purchases filtered by date range and specialization.
+	GetPurchaseReport(context.Context, *GetPurchaseReportRequest) (*GetPurchaseReportResponse, error)
+	mustEmbedUnimplementedReportServiceServer()
+}
+
+// UnimplementedReportServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedReportServiceServer struct{}
+
+func (UnimplementedReportServiceServer) GetTopCoursesReport(context.Context, *GetTopCoursesReportRequest) (*GetTopCoursesReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopCoursesReport not implemented")
+}
+func (UnimplementedReportServiceServer) GetPurchaseReport(context.Context, *GetPurchaseReportRequest) (*GetPurchaseReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPurchaseReport not implemented")
+}
+func (UnimplementedReportServiceServer) mustEmbedUnimplementedReportServiceServer() {}
+func (UnimplementedReportServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeReportServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReportServiceServer will
+// result in compilation errors.
+type UnsafeReportServiceServer interface {
+	mustEmbedUnimplementedReportServiceServer()
+}
+
+func RegisterReportServiceServer(s grpc.ServiceRegistrar, srv ReportServiceServer) {
+	// If the following call pancis, it indicates UnimplementedReportServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ReportService_ServiceDesc, srv)
+}
+
+func _ReportService_GetTopCoursesReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopCoursesReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportServiceServer).GetTopCoursesReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReportService_GetTopCoursesReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportServiceServer).GetTopCoursesReport(ctx, req.(*GetTopCoursesReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReportService_GetPurchaseReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPurchaseReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportServiceServer).GetPurchaseReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReportService_GetPurchaseReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportServiceServer).GetPurchaseReport(ctx, req.(*GetPurchaseReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ReportService_ServiceDesc is the grpc.ServiceDesc for ReportService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReportService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "platform.v1.ReportService",
+	HandlerType: (*ReportServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTopCoursesReport",
+			Handler:    _ReportService_GetTopCoursesReport_Handler,
+		},
+		{
+			MethodName: "GetPurchaseReport",
+			Handler:    _ReportService_GetPurchaseReport_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "report.proto",
+}
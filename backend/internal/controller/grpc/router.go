@@ -0,0 +1,40 @@
+// Package grpc implements the gRPC API, a counterpart of the HTTP API for internal services.
+package grpc
+
+import (
+    "github.com/deadnotxaa/education-platform/backend/config"
+    platformv1 "github.com/deadnotxaa/education-platform/backend/internal/controller/grpc/proto/v1"
+    v1 "github.com/deadnotxaa/education-platform/backend/internal/controller/grpc/v1"
+    "github.com/deadnotxaa/education-platform/backend/internal/usecase"
+    "github.com/deadnotxaa/education-platform/backend/pkg/logger"
+    pbgrpc "google.golang.org/grpc"
+    "google.golang.org/grpc/health"
+    healthpb "google.golang.org/grpc/health/grpc_health_v1"
+    "google.golang.org/grpc/reflection"
+)
+
+// NewRouter registers the services, the health service and, if enabled, server reflection.
+func NewRouter(app *pbgrpc.Server, cfg *config.Config, t usecase.Platform, l logger.Interface) {
+    // Routers
+    {
+        v1.NewCourseRoutes(app, t, l)
+        v1.NewUserRoutes(app, t, l)
+        v1.NewReportRoutes(app, t, l)
+    }
+
+    // Health checks, every service is reported as serving while the server runs
+    healthServer := health.NewServer()
+    for _, service := range []string{
+        platformv1.CourseService_ServiceDesc.ServiceName,
+        platformv1.UserService_ServiceDesc.ServiceName,
+        platformv1.ReportService_ServiceDesc.ServiceName,
+    } {
+        healthServer.SetServingStatus(service, healthpb.HealthCheckResponse_SERVING)
+    }
+    healthpb.RegisterHealthServer(app, healthServer)
+
+    // Reflection for grpcurl and similar clients
+    if cfg.GRPC.ReflectionEnabled {
+        reflection.Register(app)
+    }
+}
@@ -0,0 +1,57 @@
+package v1
+
+import (
+    "context"
+    "strings"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+    "github.com/deadnotxaa/education-platform/backend/internal/usecase"
+    "github.com/deadnotxaa/education-platform/backend/pkg/logger"
+    "google.golang.org/grpc/metadata"
+)
+
+// V1 - dependencies shared by the services, each service embeds it next to its Unimplemented server.
+type V1 struct {
+    p usecase.Platform
+    l logger.Interface
+}
+
+// authenticate returns the account of the "authorization: Bearer <access token>" metadata entry.
+func (r *V1) authenticate(ctx context.Context) (int, error) {
+    md, _ := metadata.FromIncomingContext(ctx)
+
+    values := md.Get("authorization")
+    if len(values) == 0 {
+        return 0, errorResponse(entity.ErrUnauthenticated)
+    }
+
+    token, found := strings.CutPrefix(values[0], "Bearer ")
+    if !found || token == "" {
+        return 0, errorResponse(entity.ErrUnauthenticated)
+    }
+
+    accountID, err := r.p.ParseAccessToken(token)
+    if err != nil {
+        r.l.Error(err, "grpc - v1 - authenticate")
+
+        return 0, errorResponse(entity.ErrInvalidToken)
+    }
+
+    return accountID, nil
+}
+
+// authorize rejects accounts without the permission.
+func (r *V1) authorize(ctx context.Context, accountID int, permission entity.Permission) error {
+    allowed, err := r.p.HasPermission(ctx, accountID, permission)
+    if err != nil {
+        r.l.Error(err, "grpc - v1 - authorize")
+
+        return errorResponse(err)
+    }
+
+    if !allowed {
+        return errorResponse(entity.ErrForbidden)
+    }
+
+    return nil
+}
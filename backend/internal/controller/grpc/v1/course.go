@@ -0,0 +1,109 @@
+package v1
+
+import (
+    "context"
+    "slices"
+
+    platformv1 "github.com/deadnotxaa/education-platform/backend/internal/controller/grpc/proto/v1"
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+)
+
+type courseService struct {
+    platformv1.UnimplementedCourseServiceServer
+    V1
+}
+
+func (r *courseService) GetCourse(ctx context.Context, req *platformv1.GetCourseRequest) (*platformv1.Course, error) {
+    var v violations
+
+    v.check(req.GetId() > 0, "id", "gt=0")
+
+    if err := v.err(); err != nil {
+        return nil, err
+    }
+
+    course, err := r.p.GetCourseById(ctx, int(req.GetId()))
+    if err != nil {
+        r.l.Error(err, "grpc - v1 - GetCourse")
+
+        return nil, errorResponse(err)
+    }
+
+    return newCourse(course), nil
+}
+
+func (r *courseService) ListCourses(ctx context.Context, req *platformv1.ListCoursesRequest) (*platformv1.ListCoursesResponse, error) {
+    var v violations
+
+    v.check(req.GetSpecializationId() >= 0, "specialization_id", "gte=0")
+    v.check(req.GetDifficultyLevelId() >= 0, "difficulty_level_id", "gte=0")
+    v.check(req.GetMinPrice() >= 0, "min_price", "gte=0")
+    v.check(req.GetMaxPrice() >= 0, "max_price", "gte=0")
+    v.check(req.GetMinDuration() >= 0, "min_duration", "gte=0")
+    v.check(req.GetMaxDuration() >= 0, "max_duration", "gte=0")
+    v.check(req.GetSortBy() == "" || slices.Contains([]entity.CourseSortField{
+        entity.CourseSortByID, entity.CourseSortByPrice, entity.CourseSortByRating, entity.CourseSortByCreatedAt,
+    }, entity.CourseSortField(req.GetSortBy())), "sort_by", "oneof=id price rating created_at")
+    v.check(req.GetLimit() <= 100, "limit", "lte=100")
+
+    if err := v.err(); err != nil {
+        return nil, err
+    }
+
+    page, err := r.p.ListCourses(ctx, entity.CourseFilter{
+        SpecializationID:  int(req.GetSpecializationId()),
+        DifficultyLevelID: int(req.GetDifficultyLevelId()),
+        MinPrice:          optionalInt(req.MinPrice),
+        MaxPrice:          optionalInt(req.MaxPrice),
+        MinDuration:       optionalInt(req.MinDuration),
+        MaxDuration:       optionalInt(req.MaxDuration),
+        SortBy:            entity.CourseSortField(req.GetSortBy()),
+        Descending:        req.GetDescending(),
+        Cursor:            req.GetCursor(),
+        Limit:             req.GetLimit(),
+    })
+    if err != nil {
+        r.l.Error(err, "grpc - v1 - ListCourses")
+
+        return nil, errorResponse(err)
+    }
+
+    resp := &platformv1.ListCoursesResponse{
+        Courses:    make([]*platformv1.CourseListItem, 0, len(page.Courses)),
+        NextCursor: page.NextCursor,
+    }
+
+    for _, item := range page.Courses {
+        resp.Courses = append(resp.Courses, &platformv1.CourseListItem{
+            Course:        newCourse(item.Course),
+            AverageRating: item.AverageRating,
+        })
+    }
+
+    return resp, nil
+}
+
+func newCourse(c entity.Course) *platformv1.Course {
+    return &platformv1.Course{
+        Id:                int32(c.CourseID),
+        Name:              c.Name,
+        Description:       c.Description,
+        SpecializationId:  int32(c.SpecializationID),
+        Duration:          int32(c.Duration),
+        Price:             int32(c.Price),
+        DifficultyLevelId: int32(c.DifficultyLevelID),
+        CreatedAt:         c.CreatedAt,
+        UpdatedAt:         c.UpdatedAt,
+    }
+}
+
+// optionalInt converts an unset proto3 optional field to nil.
+func optionalInt(v *int32) *int {
+    if v == nil {
+        return nil
+    }
+
+    i := int(*v)
+
+    return &i
+}
@@ -0,0 +1,85 @@
+package v1
+
+import (
+    "errors"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+    "google.golang.org/genproto/googleapis/rpc/errdetails"
+    "google.golang.org/grpc/codes"
+    "google.golang.org/grpc/status"
+)
+
+// _errorDomain - ErrorInfo domain, its reasons are the codes of the error catalog.
+const _errorDomain = "education-platform"
+
+// statusCode returns the gRPC code of an error kind.
+func statusCode(kind entity.ErrorKind) codes.Code {
+    switch kind {
+    case entity.ErrorKindNotFound:
+        return codes.NotFound
+    case entity.ErrorKindConflict:
+        return codes.FailedPrecondition
+    case entity.ErrorKindValidation:
+        return codes.InvalidArgument
+    case entity.ErrorKindForbidden:
+        return codes.PermissionDenied
+    case entity.ErrorKindUnauthorized:
+        return codes.Unauthenticated
+    default:
+        return codes.Internal
+    }
+}
+
+// errorResponse converts a use case error to a status, domain errors keep their code and other errors
+// become internal_error.
+func errorResponse(err error) error {
+    return newStatus(err).Err()
+}
+
+func newStatus(err error) *status.Status {
+    e := entity.ErrInternal
+
+    var domainErr *entity.DomainError
+    if errors.As(err, &domainErr) {
+        e = domainErr
+    }
+
+    st := status.New(statusCode(e.Kind), e.Message)
+
+    withInfo, detailsErr := st.WithDetails(&errdetails.ErrorInfo{
+        Reason:   e.Code,
+        Domain:   _errorDomain,
+        Metadata: map[string]string{"kind": string(e.Kind)},
+    })
+    if detailsErr != nil {
+        return st
+    }
+
+    return withInfo
+}
+
+// violations - request fields breaking validation rules, reported like invalid_params of the HTTP API.
+type violations []*errdetails.BadRequest_FieldViolation
+
+// check records the field when the rule doesn't hold.
+func (v *violations) check(ok bool, field, rule string) {
+    if !ok {
+        *v = append(*v, &errdetails.BadRequest_FieldViolation{Field: field, Description: rule})
+    }
+}
+
+// err returns validation_failed with the recorded fields, nil if there are none.
+func (v violations) err() error {
+    if len(v) == 0 {
+        return nil
+    }
+
+    st := newStatus(entity.ErrValidationFailed)
+
+    withFields, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: v})
+    if err != nil {
+        return st.Err()
+    }
+
+    return withFields.Err()
+}
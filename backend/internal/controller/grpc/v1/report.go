@@ -0,0 +1,117 @@
+package v1
+
+import (
+    "context"
+    "time"
+
+    platformv1 "github.com/deadnotxaa/education-platform/backend/internal/controller/grpc/proto/v1"
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+)
+
+type reportService struct {
+    platformv1.UnimplementedReportServiceServer
+    V1
+}
+
+func (r *reportService) GetTopCoursesReport(ctx context.Context, req *platformv1.GetTopCoursesReportRequest) (*platformv1.GetTopCoursesReportResponse, error) {
+    if err := r.authorizeReport(ctx); err != nil {
+        return nil, err
+    }
+
+    var v violations
+
+    v.check(req.GetLimit() <= 100, "limit", "lte=100")
+
+    if err := v.err(); err != nil {
+        return nil, err
+    }
+
+    report, err := r.p.GetTopCoursesReport(ctx, req.GetLimit())
+    if err != nil {
+        r.l.Error(err, "grpc - v1 - GetTopCoursesReport")
+
+        return nil, errorResponse(err)
+    }
+
+    resp := &platformv1.GetTopCoursesReportResponse{Courses: make([]*platformv1.TopCourse, 0, len(report))}
+
+    for _, row := range report {
+        resp.Courses = append(resp.Courses, &platformv1.TopCourse{
+            Name:               row.CourseName,
+            DifficultyLevel:    row.DifficultyLevel,
+            Duration:           int32(row.Duration),
+            AverageRating:      row.AverageRating,
+            TotalReviews:       int32(row.TotalReviews),
+            TeachersWorkPlaces: row.TeachersWorkPlaces,
+        })
+    }
+
+    return resp, nil
+}
+
+func (r *reportService) GetPurchaseReport(ctx context.Context, req *platformv1.GetPurchaseReportRequest) (*platformv1.GetPurchaseReportResponse, error) {
+    if err := r.authorizeReport(ctx); err != nil {
+        return nil, err
+    }
+
+    filter := entity.DetailedPurchaseReportFilter{
+        SpecializationID: int(req.GetSpecializationId()),
+        Limit:            req.GetLimit(),
+    }
+
+    var (
+        v       violations
+        fromErr error
+        toErr   error
+    )
+
+    if req.GetDateFrom() != "" {
+        filter.From, fromErr = time.Parse(time.DateOnly, req.GetDateFrom())
+    }
+    if req.GetDateTo() != "" {
+        filter.To, toErr = time.Parse(time.DateOnly, req.GetDateTo())
+    }
+
+    v.check(req.GetLimit() <= 100, "limit", "lte=100")
+    v.check(fromErr == nil, "date_from", "datetime=2006-01-02")
+    v.check(toErr == nil, "date_to", "datetime=2006-01-02")
+    v.check(req.GetSpecializationId() >= 0, "specialization_id", "gte=0")
+    v.check(filter.From.IsZero() || filter.To.IsZero() || !filter.From.After(filter.To), "date_from", "ltefield=date_to")
+
+    if err := v.err(); err != nil {
+        return nil, err
+    }
+
+    report, err := r.p.GetDetailedPurchaseReport(ctx, filter)
+    if err != nil {
+        r.l.Error(err, "grpc - v1 - GetPurchaseReport")
+
+        return nil, errorResponse(err)
+    }
+
+    resp := &platformv1.GetPurchaseReportResponse{Purchases: make([]*platformv1.Purchase, 0, len(report))}
+
+    for _, row := range report {
+        resp.Purchases = append(resp.Purchases, &platformv1.Purchase{
+            UserName:           row.UserName,
+            UserSurname:        row.UserSurname,
+            CourseName:         row.CourseName,
+            SpecializationName: row.SpecializationName,
+            CourseType:         row.CourseType,
+            TotalPrice:         row.TotalPrice,
+            PurchaseDate:       row.PurchaseDate,
+            TeacherWorkPlace:   row.TeacherWorkPlace,
+        })
+    }
+
+    return resp, nil
+}
+
+func (r *reportService) authorizeReport(ctx context.Context) error {
+    accountID, err := r.authenticate(ctx)
+    if err != nil {
+        return err
+    }
+
+    return r.authorize(ctx, accountID, entity.PermissionReportRead)
+}
@@ -0,0 +1,23 @@
+package v1
+
+import (
+    platformv1 "github.com/deadnotxaa/education-platform/backend/internal/controller/grpc/proto/v1"
+    "github.com/deadnotxaa/education-platform/backend/internal/usecase"
+    "github.com/deadnotxaa/education-platform/backend/pkg/logger"
+    "google.golang.org/grpc"
+)
+
+// NewCourseRoutes -.
+func NewCourseRoutes(app *grpc.Server, p usecase.Platform, l logger.Interface) {
+    platformv1.RegisterCourseServiceServer(app, &courseService{V1: V1{p: p, l: l}})
+}
+
+// NewUserRoutes -.
+func NewUserRoutes(app *grpc.Server, p usecase.Platform, l logger.Interface) {
+    platformv1.RegisterUserServiceServer(app, &userService{V1: V1{p: p, l: l}})
+}
+
+// NewReportRoutes -.
+func NewReportRoutes(app *grpc.Server, p usecase.Platform, l logger.Interface) {
+    platformv1.RegisterReportServiceServer(app, &reportService{V1: V1{p: p, l: l}})
+}
@@ -0,0 +1,54 @@
+package v1
+
+import (
+    "context"
+
+    platformv1 "github.com/deadnotxaa/education-platform/backend/internal/controller/grpc/proto/v1"
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+)
+
+type userService struct {
+    platformv1.UnimplementedUserServiceServer
+    V1
+}
+
+func (r *userService) GetUser(ctx context.Context, req *platformv1.GetUserRequest) (*platformv1.User, error) {
+    accountID, err := r.authenticate(ctx)
+    if err != nil {
+        return nil, err
+    }
+
+    var v violations
+
+    v.check(req.GetId() > 0, "id", "gt=0")
+
+    if err = v.err(); err != nil {
+        return nil, err
+    }
+
+    if accountID != int(req.GetId()) {
+        if err = r.authorize(ctx, accountID, entity.PermissionUserRead); err != nil {
+            return nil, err
+        }
+    }
+
+    user, err := r.p.GetUserById(ctx, int(req.GetId()))
+    if err != nil {
+        r.l.Error(err, "grpc - v1 - GetUser")
+
+        return nil, errorResponse(err)
+    }
+
+    return &platformv1.User{
+        AccountId:         int32(user.AccountID),
+        Name:              user.Name,
+        Surname:           user.Surname,
+        BirthDate:         user.BirthDate,
+        Email:             user.Email,
+        ProfilePictureUrl: user.ProfilePictureUrl,
+        PhoneNumber:       user.PhoneNumber,
+        SnilsNumber:       user.SnilsNumber,
+        CreatedAt:         user.CreatedAt,
+        UpdatedAt:         user.UpdatedAt,
+    }, nil
+}
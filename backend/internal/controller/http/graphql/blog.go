@@ -0,0 +1,90 @@
+package graphql
+
+import (
+    "context"
+    "errors"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+)
+
+// BlogPost -.
+func (r *Resolver) BlogPost(ctx context.Context, args struct{ ID int32 }) (*blogPostResolver, error) {
+    post, err := r.p.GetBlogPost(ctx, int(args.ID))
+    if err != nil {
+        if errors.Is(err, entity.ErrBlogPostNotFound) {
+            return nil, nil
+        }
+
+        r.l.Error(err, "graphql - BlogPost")
+
+        return nil, errorResponse(err)
+    }
+
+    return &blogPostResolver{post: post}, nil
+}
+
+// BlogPosts -.
+func (r *Resolver) BlogPosts(ctx context.Context, args struct {
+    First int32
+    After *string
+    Tag   *string
+    Topic *string
+},
+) (*blogPostPageResolver, error) {
+    limit, err := pageSize(args.First)
+    if err != nil {
+        return nil, err
+    }
+
+    page, err := r.p.ListBlogPosts(ctx, entity.BlogFilter{
+        Tag:    optionalString(args.Tag),
+        Topic:  optionalString(args.Topic),
+        Cursor: optionalString(args.After),
+        Limit:  limit,
+    })
+    if err != nil {
+        r.l.Error(err, "graphql - BlogPosts")
+
+        return nil, errorResponse(err)
+    }
+
+    return &blogPostPageResolver{page: page}, nil
+}
+
+type blogPostPageResolver struct {
+    page entity.BlogPostPage
+}
+
+func (r *blogPostPageResolver) Posts() []*blogPostResolver {
+    posts := make([]*blogPostResolver, 0, len(r.page.Posts))
+    for _, post := range r.page.Posts {
+        posts = append(posts, &blogPostResolver{post: post})
+    }
+
+    return posts
+}
+
+func (r *blogPostPageResolver) NextCursor() *string {
+    return nextCursor(r.page.NextCursor)
+}
+
+type blogPostResolver struct {
+    post entity.BlogPost
+}
+
+func (r *blogPostResolver) ID() int32                 { return int32(r.post.PostID) }
+func (r *blogPostResolver) AuthorID() int32           { return int32(r.post.AuthorID) }
+func (r *blogPostResolver) Title() string             { return r.post.Title }
+func (r *blogPostResolver) PublicationDate() string   { return r.post.PublicationDate }
+func (r *blogPostResolver) Topic() string             { return r.post.Topic }
+func (r *blogPostResolver) ReadingTimeMinutes() int32 { return int32(r.post.ReadingTimeMinutes) }
+func (r *blogPostResolver) CoverImageURL() string     { return r.post.CoverImageUrl }
+func (r *blogPostResolver) Content() string           { return r.post.Content }
+
+func (r *blogPostResolver) Tags() []string {
+    if r.post.Tags == nil {
+        return []string{}
+    }
+
+    return r.post.Tags
+}
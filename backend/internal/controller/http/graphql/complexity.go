@@ -0,0 +1,174 @@
+package graphql
+
+import (
+    "fmt"
+    "strconv"
+
+    gqlerrors "github.com/graph-gophers/graphql-go/errors"
+    "github.com/vektah/gqlparser/v2"
+    "github.com/vektah/gqlparser/v2/ast"
+)
+
+// listSize - expected number of items of a list field, see the @listSize directive of the schema.
+type listSize struct {
+    assumed         int
+    slicingArgument string
+}
+
+// complexity estimates how many fields a query resolves: every field counts once and the selections
+// of a list field count once per expected item, so nested lists multiply.
+type complexity struct {
+    schema *ast.Schema
+    sizes  map[*ast.FieldDefinition]listSize
+    limit  int
+}
+
+func newComplexity(schema string, limit int) (*complexity, error) {
+    s, err := gqlparser.LoadSchema(&ast.Source{Name: "schema.graphql", Input: schema})
+    if err != nil {
+        return nil, fmt.Errorf("graphql - newComplexity - gqlparser.LoadSchema: %w", err)
+    }
+
+    c := &complexity{
+        schema: s,
+        sizes:  make(map[*ast.FieldDefinition]listSize),
+        limit:  limit,
+    }
+
+    for _, def := range s.Types {
+        for _, field := range def.Fields {
+            d := field.Directives.ForName("listSize")
+            if d == nil {
+                continue
+            }
+
+            var size listSize
+
+            if arg := d.Arguments.ForName("assumedSize"); arg != nil {
+                if size.assumed, err = strconv.Atoi(arg.Value.Raw); err != nil {
+                    return nil, fmt.Errorf("graphql - newComplexity - %s.%s assumedSize: %w", def.Name, field.Name, err)
+                }
+            }
+
+            if arg := d.Arguments.ForName("slicingArgument"); arg != nil {
+                size.slicingArgument = arg.Value.Raw
+            }
+
+            c.sizes[field] = size
+        }
+    }
+
+    return c, nil
+}
+
+// check rejects queries that don't match the schema or exceed the limit. The operation is left to
+// the executor when it can't be chosen, the executor reports it.
+func (c *complexity) check(query, operationName string, variables map[string]any) []*gqlerrors.QueryError {
+    doc, errs := gqlparser.LoadQueryWithRules(c.schema, query, nil)
+    if len(errs) > 0 {
+        queryErrs := make([]*gqlerrors.QueryError, 0, len(errs))
+
+        for _, err := range errs {
+            e := newQueryError(errInvalidQuery(err.Message))
+            for _, loc := range err.Locations {
+                e.Locations = append(e.Locations, gqlerrors.Location{Line: loc.Line, Column: loc.Column})
+            }
+
+            queryErrs = append(queryErrs, e)
+        }
+
+        return queryErrs
+    }
+
+    var op *ast.OperationDefinition
+
+    switch {
+    case operationName != "":
+        op = doc.Operations.ForName(operationName)
+    case len(doc.Operations) == 1:
+        op = doc.Operations[0]
+    }
+
+    if op == nil {
+        return nil
+    }
+
+    if cost := c.selectionCost(op.SelectionSet, variables); cost > c.limit {
+        return []*gqlerrors.QueryError{newQueryError(errQueryTooComplex(c.limit))}
+    }
+
+    return nil
+}
+
+// selectionCost returns the cost of the selections, it stops counting past the limit.
+func (c *complexity) selectionCost(set ast.SelectionSet, variables map[string]any) int {
+    cost := 0
+
+    for _, selection := range set {
+        switch s := selection.(type) {
+        case *ast.Field:
+            cost += c.fieldCost(s, variables)
+        case *ast.InlineFragment:
+            cost += c.selectionCost(s.SelectionSet, variables)
+        case *ast.FragmentSpread:
+            if s.Definition != nil {
+                cost += c.selectionCost(s.Definition.SelectionSet, variables)
+            }
+        }
+
+        if cost > c.limit {
+            return cost
+        }
+    }
+
+    return cost
+}
+
+func (c *complexity) fieldCost(field *ast.Field, variables map[string]any) int {
+    if len(field.SelectionSet) == 0 {
+        return 1
+    }
+
+    children := c.selectionCost(field.SelectionSet, variables)
+
+    size, ok := c.sizes[field.Definition]
+    if !ok {
+        return 1 + children
+    }
+
+    n := size.assumed
+    if size.slicingArgument != "" {
+        n = sliceSize(field, size.slicingArgument, variables)
+    }
+
+    if n > 0 && children > c.limit/n {
+        return c.limit + 1
+    }
+
+    return 1 + n*children
+}
+
+// sliceSize returns the value of the slicing argument, or its default when it's missing or not positive
+// since resolvers treat it the same way.
+func sliceSize(field *ast.Field, name string, variables map[string]any) int {
+    var n int
+
+    switch v := field.ArgumentMap(variables)[name].(type) {
+    case int64:
+        n = int(v)
+    case float64: // Variables are decoded from JSON
+        n = int(v)
+    }
+
+    if n > 0 {
+        return n
+    }
+
+    if def := field.Definition.Arguments.ForName(name); def != nil && def.DefaultValue != nil {
+        if n, err := strconv.Atoi(def.DefaultValue.Raw); err == nil {
+            return n
+        }
+    }
+
+    return 1
+}
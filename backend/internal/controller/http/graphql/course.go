@@ -0,0 +1,240 @@
+package graphql
+
+import (
+    "context"
+    "errors"
+    "strings"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+)
+
+type coursesArgs struct {
+    First             int32
+    After             *string
+    SpecializationID  *int32
+    DifficultyLevelID *int32
+    SortBy            string
+    Descending        bool
+}
+
+// Course -.
+func (r *Resolver) Course(ctx context.Context, args struct{ ID int32 }) (*courseResolver, error) {
+    course, err := r.p.GetCourseById(ctx, int(args.ID))
+    if err != nil {
+        if errors.Is(err, entity.ErrCourseNotFound) {
+            return nil, nil
+        }
+
+        r.l.Error(err, "graphql - Course")
+
+        return nil, errorResponse(err)
+    }
+
+    return &courseResolver{root: r, course: course}, nil
+}
+
+// Courses -.
+func (r *Resolver) Courses(ctx context.Context, args coursesArgs) (*coursePageResolver, error) {
+    limit, err := pageSize(args.First)
+    if err != nil {
+        return nil, err
+    }
+
+    filter := entity.CourseFilter{
+        SpecializationID:  optionalInt(args.SpecializationID),
+        DifficultyLevelID: optionalInt(args.DifficultyLevelID),
+        Cursor:            optionalString(args.After),
+        SortBy:            entity.CourseSortField(strings.ToLower(args.SortBy)), // CREATED_AT -> created_at
+        Descending:        args.Descending,
+        Limit:             limit,
+    }
+
+    page, err := r.p.ListCourses(ctx, filter)
+    if err != nil {
+        r.l.Error(err, "graphql - Courses")
+
+        return nil, errorResponse(err)
+    }
+
+    return &coursePageResolver{root: r, page: page}, nil
+}
+
+type coursePageResolver struct {
+    root *Resolver
+    page entity.CoursePage
+}
+
+func (r *coursePageResolver) Courses() []*courseResolver {
+    courses := make([]*courseResolver, 0, len(r.page.Courses))
+
+    for _, item := range r.page.Courses {
+        rating := item.AverageRating
+        courses = append(courses, &courseResolver{root: r.root, course: item.Course, averageRating: &rating})
+    }
+
+    return courses
+}
+
+func (r *coursePageResolver) NextCursor() *string {
+    return nextCursor(r.page.NextCursor)
+}
+
+type courseResolver struct {
+    root          *Resolver
+    course        entity.Course
+    averageRating *float64 // Only known in the listing
+}
+
+// loadCourse returns the course of a nested field, nil when it was deleted.
+func loadCourse(ctx context.Context, root *Resolver, courseID int) (*courseResolver, error) {
+    course, found, err := loadersFrom(ctx).courses.Load(ctx, courseID)
+    if err != nil {
+        root.l.Error(err, "graphql - loadCourse")
+
+        return nil, errorResponse(err)
+    }
+
+    if !found {
+        return nil, nil
+    }
+
+    return &courseResolver{root: root, course: course}, nil
+}
+
+func (r *courseResolver) ID() int32                { return int32(r.course.CourseID) }
+func (r *courseResolver) Name() string             { return r.course.Name }
+func (r *courseResolver) Description() string      { return r.course.Description }
+func (r *courseResolver) SpecializationID() int32  { return int32(r.course.SpecializationID) }
+func (r *courseResolver) Duration() int32          { return int32(r.course.Duration) }
+func (r *courseResolver) Price() int32             { return int32(r.course.Price) }
+func (r *courseResolver) DifficultyLevelID() int32 { return int32(r.course.DifficultyLevelID) }
+func (r *courseResolver) CreatedAt() string        { return r.course.CreatedAt }
+func (r *courseResolver) UpdatedAt() string        { return r.course.UpdatedAt }
+func (r *courseResolver) AverageRating() *float64  { return r.averageRating }
+
+func (r *courseResolver) Syllabus(ctx context.Context) ([]*syllabusTopicResolver, error) {
+    topics, _, err := loadersFrom(ctx).syllabi.Load(ctx, r.course.CourseID)
+    if err != nil {
+        r.root.l.Error(err, "graphql - Course.syllabus")
+
+        return nil, errorResponse(err)
+    }
+
+    resolvers := make([]*syllabusTopicResolver, 0, len(topics))
+    for _, topic := range topics {
+        resolvers = append(resolvers, &syllabusTopicResolver{topic: topic})
+    }
+
+    return resolvers, nil
+}
+
+func (r *courseResolver) Staff(ctx context.Context) ([]*staffMemberResolver, error) {
+    staff, _, err := loadersFrom(ctx).staff.Load(ctx, r.course.CourseID)
+    if err != nil {
+        r.root.l.Error(err, "graphql - Course.staff")
+
+        return nil, errorResponse(err)
+    }
+
+    resolvers := make([]*staffMemberResolver, 0, len(staff))
+    for _, member := range staff {
+        resolvers = append(resolvers, &staffMemberResolver{root: r.root, member: member})
+    }
+
+    return resolvers, nil
+}
+
+func (r *courseResolver) Cohorts(ctx context.Context) ([]*calendarResolver, error) {
+    calendars, _, err := loadersFrom(ctx).cohorts.Load(ctx, r.course.CourseID)
+    if err != nil {
+        r.root.l.Error(err, "graphql - Course.cohorts")
+
+        return nil, errorResponse(err)
+    }
+
+    resolvers := make([]*calendarResolver, 0, len(calendars))
+    for _, calendar := range calendars {
+        resolvers = append(resolvers, &calendarResolver{root: r.root, calendar: calendar})
+    }
+
+    return resolvers, nil
+}
+
+func (r *courseResolver) Reviews(ctx context.Context, args struct{ First int32 }) ([]*reviewResolver, error) {
+    first, err := pageSize(args.First)
+    if err != nil {
+        return nil, err
+    }
+
+    reviews, _, err := loadersFrom(ctx).reviews.Load(ctx, reviewsKey{courseID: r.course.CourseID, first: first})
+    if err != nil {
+        r.root.l.Error(err, "graphql - Course.reviews")
+
+        return nil, errorResponse(err)
+    }
+
+    resolvers := make([]*reviewResolver, 0, len(reviews))
+    for _, review := range reviews {
+        resolvers = append(resolvers, &reviewResolver{root: r.root, review: review})
+    }
+
+    return resolvers, nil
+}
+
+type syllabusTopicResolver struct {
+    topic entity.SyllabusTopic
+}
+
+func (r *syllabusTopicResolver) ID() int32                  { return int32(r.topic.ID) }
+func (r *syllabusTopicResolver) Position() int32            { return int32(r.topic.Position) }
+func (r *syllabusTopicResolver) Name() string               { return r.topic.Name }
+func (r *syllabusTopicResolver) Description() string        { return r.topic.Description }
+func (r *syllabusTopicResolver) Technologies() string       { return r.topic.Technologies }
+func (r *syllabusTopicResolver) LaborIntensityHours() int32 { return int32(r.topic.LaborIntensityHours) }
+func (r *syllabusTopicResolver) ProjectsNumber() int32      { return int32(r.topic.ProjectsNumber) }
+
+func (r *syllabusTopicResolver) Projects() []*projectResolver {
+    projects := make([]*projectResolver, 0, len(r.topic.Projects))
+    for _, project := range r.topic.Projects {
+        projects = append(projects, &projectResolver{project: project})
+    }
+
+    return projects
+}
+
+type projectResolver struct {
+    project entity.Project
+}
+
+func (r *projectResolver) ID() int32           { return int32(r.project.ProjectID) }
+func (r *projectResolver) Name() string        { return r.project.Name }
+func (r *projectResolver) Description() string { return r.project.Description }
+
+type calendarResolver struct {
+    root     *Resolver
+    calendar entity.CourseCalendar
+}
+
+func (r *calendarResolver) ID() int32              { return int32(r.calendar.ID) }
+func (r *calendarResolver) StartDate() string      { return r.calendar.StartDate }
+func (r *calendarResolver) EndSalesDate() string   { return r.calendar.EndSalesDate }
+func (r *calendarResolver) RemainingPlaces() int32 { return int32(r.calendar.RemainingPlaces) }
+
+func (r *calendarResolver) Course(ctx context.Context) (*courseResolver, error) {
+    return loadCourse(ctx, r.root, r.calendar.CourseID)
+}
+
+type reviewResolver struct {
+    root   *Resolver
+    review entity.CourseReview
+}
+
+func (r *reviewResolver) ID() int32          { return int32(r.review.ReviewID) }
+func (r *reviewResolver) UserID() int32      { return int32(r.review.UserID) }
+func (r *reviewResolver) Rating() int32      { return int32(r.review.Rating) }
+func (r *reviewResolver) Comment() string    { return r.review.Comment }
+func (r *reviewResolver) ReviewDate() string { return r.review.ReviewDate }
+
+func (r *reviewResolver) Course(ctx context.Context) (*courseResolver, error) {
+    return loadCourse(ctx, r.root, r.review.CourseID)
+}
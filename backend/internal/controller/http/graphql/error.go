@@ -0,0 +1,66 @@
+package graphql
+
+import (
+    "errors"
+    "fmt"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/controller/http/problem"
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+    gqlerrors "github.com/graph-gophers/graphql-go/errors"
+)
+
+// resolverError - error of a field, the executor copies its extensions into the response.
+// The code and type match the error catalog, same as problem responses.
+type resolverError struct {
+    e      *entity.DomainError
+    detail string
+}
+
+func (e *resolverError) Error() string {
+    if e.detail != "" {
+        return e.detail
+    }
+
+    return e.e.Message
+}
+
+// Extensions -.
+func (e *resolverError) Extensions() map[string]any {
+    return map[string]any{
+        "code": e.e.Code,
+        "type": problem.TypePrefix + e.e.Code,
+    }
+}
+
+// errorResponse converts a use case error, domain errors keep their code and other errors become internal_error.
+func errorResponse(err error) error {
+    e := entity.ErrInternal
+
+    var domainErr *entity.DomainError
+    if errors.As(err, &domainErr) {
+        e = domainErr
+    }
+
+    return &resolverError{e: e}
+}
+
+// invalidArgumentResponse reports an argument outside of its range.
+func invalidArgumentResponse(name, reason string) error {
+    return &resolverError{e: entity.ErrValidationFailed, detail: fmt.Sprintf("argument %q: %s", name, reason)}
+}
+
+func errInvalidQuery(detail string) *resolverError {
+    return &resolverError{e: entity.ErrInvalidQuery, detail: detail}
+}
+
+func errQueryTooComplex(limit int) *resolverError {
+    return &resolverError{e: entity.ErrQueryTooComplex, detail: fmt.Sprintf("query complexity exceeds the limit of %d", limit)}
+}
+
+// newQueryError converts an error reported before execution to the response format of the executor.
+func newQueryError(e *resolverError) *gqlerrors.QueryError {
+    return &gqlerrors.QueryError{
+        Message:    e.Error(),
+        Extensions: e.Extensions(),
+    }
+}
@@ -0,0 +1,112 @@
+package graphql
+
+import (
+    "context"
+    "fmt"
+    "sync"
+    "time"
+)
+
+// Loader collects the keys requested by concurrently running resolvers during a short wait and fetches
+// them with one call, every key is fetched once per request. Resolvers of list items run concurrently,
+// so a list of N courses makes one query per nested field instead of N.
+type Loader[K comparable, V any] struct {
+    fetch    func(ctx context.Context, keys []K) (map[K]V, error)
+    wait     time.Duration
+    maxBatch int
+
+    mu      sync.Mutex
+    pending *batch[K, V]
+    batches map[K]*batch[K, V] // Batch of every requested key
+}
+
+type batch[K comparable, V any] struct {
+    keys   []K
+    done   chan struct{}
+    values map[K]V
+    err    error
+}
+
+// NewLoader -.
+func NewLoader[K comparable, V any](fetch func(ctx context.Context, keys []K) (map[K]V, error),
+    wait time.Duration, maxBatch int,
+) *Loader[K, V] {
+    return &Loader[K, V]{
+        fetch:    fetch,
+        wait:     wait,
+        maxBatch: maxBatch,
+        batches:  make(map[K]*batch[K, V]),
+    }
+}
+
+// Load returns the value of the key, found is false when the fetch didn't return the key.
+func (l *Loader[K, V]) Load(ctx context.Context, key K) (value V, found bool, err error) {
+    l.mu.Lock()
+
+    b, ok := l.batches[key]
+    if !ok {
+        b = l.add(ctx, key)
+    }
+
+    l.mu.Unlock()
+
+    select {
+    case <-b.done:
+    case <-ctx.Done():
+        return value, false, ctx.Err()
+    }
+
+    if b.err != nil {
+        return value, false, b.err
+    }
+
+    value, found = b.values[key]
+
+    return value, found, nil
+}
+
+// add puts the key into the pending batch, the first key schedules the batch and the last one that fits
+// dispatches it right away. Must be called with l.mu held.
+func (l *Loader[K, V]) add(ctx context.Context, key K) *batch[K, V] {
+    if l.pending == nil {
+        b := &batch[K, V]{done: make(chan struct{})}
+        l.pending = b
+
+        time.AfterFunc(l.wait, func() {
+            l.mu.Lock()
+            dispatch := l.pending == b
+            if dispatch {
+                l.pending = nil
+            }
+            l.mu.Unlock()
+
+            if dispatch {
+                l.run(ctx, b)
+            }
+        })
+    }
+
+    b := l.pending
+    b.keys = append(b.keys, key)
+    l.batches[key] = b
+
+    if len(b.keys) >= l.maxBatch {
+        l.pending = nil
+
+        go l.run(ctx, b)
+    }
+
+    return b
+}
+
+// run fetches the batch, a panic fails the batch instead of the server since it runs outside of the resolvers.
+func (l *Loader[K, V]) run(ctx context.Context, b *batch[K, V]) {
+    defer close(b.done)
+    defer func() {
+        if r := recover(); r != nil {
+            b.err = fmt.Errorf("graphql - Loader - fetch: panic: %v", r)
+        }
+    }()
+
+    b.values, b.err = l.fetch(ctx, b.keys)
+}
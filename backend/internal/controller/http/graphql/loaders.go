@@ -0,0 +1,70 @@
+package graphql
+
+import (
+    "context"
+    "fmt"
+    "time"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+    "github.com/deadnotxaa/education-platform/backend/internal/usecase"
+)
+
+type loadersKey struct{}
+
+// reviewsKey - reviews of a course are loaded by the requested count, a batch makes one call per count.
+type reviewsKey struct {
+    courseID int
+    first    uint32
+}
+
+// loaders - per request loaders of the fields that would make a query per parent.
+type loaders struct {
+    courses        *Loader[int, entity.Course]
+    teachers       *Loader[int, entity.TeacherProfile]
+    teacherCourses *Loader[int, []entity.TeacherCourse]
+    syllabi        *Loader[int, []entity.SyllabusTopic]
+    staff          *Loader[int, []entity.CourseStaffMember]
+    cohorts        *Loader[int, []entity.CourseCalendar]
+    reviews        *Loader[reviewsKey, []entity.CourseReview]
+}
+
+func newLoaders(p usecase.Platform, wait time.Duration, maxBatch int) *loaders {
+    return &loaders{
+        courses:        NewLoader(p.GetCoursesByIDs, wait, maxBatch),
+        teachers:       NewLoader(p.GetTeacherProfilesByIDs, wait, maxBatch),
+        teacherCourses: NewLoader(p.ListEmployeeCoursesByEmployees, wait, maxBatch),
+        syllabi:        NewLoader(p.GetCourseSyllabi, wait, maxBatch),
+        staff:          NewLoader(p.ListCourseStaffByCourses, wait, maxBatch),
+        cohorts:        NewLoader(p.ListUpcomingCourseCalendarsByCourses, wait, maxBatch),
+        reviews: NewLoader(func(ctx context.Context, keys []reviewsKey) (map[reviewsKey][]entity.CourseReview, error) {
+            courseIDs := make(map[uint32][]int)
+            for _, key := range keys {
+                courseIDs[key.first] = append(courseIDs[key.first], key.courseID)
+            }
+
+            reviews := make(map[reviewsKey][]entity.CourseReview, len(keys))
+
+            for first, ids := range courseIDs {
+                byCourse, err := p.ListApprovedReviewsByCourses(ctx, ids, first)
+                if err != nil {
+                    return nil, fmt.Errorf("p.ListApprovedReviewsByCourses: %w", err)
+                }
+
+                for courseID, courseReviews := range byCourse {
+                    reviews[reviewsKey{courseID: courseID, first: first}] = courseReviews
+                }
+            }
+
+            return reviews, nil
+        }, wait, maxBatch),
+    }
+}
+
+func withLoaders(ctx context.Context, l *loaders) context.Context {
+    return context.WithValue(ctx, loadersKey{}, l)
+}
+
+// loadersFrom returns the loaders of the request, the handler sets them before executing a query.
+func loadersFrom(ctx context.Context) *loaders {
+    return ctx.Value(loadersKey{}).(*loaders)
+}
@@ -0,0 +1,53 @@
+package graphql
+
+import (
+    "time"
+)
+
+const (
+    _defaultMaxDepth       = 10
+    _defaultMaxComplexity  = 5000
+    _defaultMaxQueryLength = 10000
+    _defaultMaxParallelism = 100 // A batch can only collect the keys of resolvers running at once
+
+    _defaultBatchWait = 2 * time.Millisecond
+    _defaultMaxBatch  = 100
+)
+
+// Option -.
+type Option func(*routes)
+
+// MaxDepth - how deep selections may be nested.
+func MaxDepth(depth int) Option {
+    return func(r *routes) {
+        r.maxDepth = depth
+    }
+}
+
+// MaxComplexity - how many fields a query may resolve, list fields count their selections once per expected item.
+func MaxComplexity(complexity int) Option {
+    return func(r *routes) {
+        r.maxComplexity = complexity
+    }
+}
+
+// MaxQueryLength - size limit of the query text in bytes.
+func MaxQueryLength(length int) Option {
+    return func(r *routes) {
+        r.maxQueryLength = length
+    }
+}
+
+// MaxParallelism - how many resolvers of one query run at once.
+func MaxParallelism(n int) Option {
+    return func(r *routes) {
+        r.maxParallelism = n
+    }
+}
+
+// BatchWait - how long loaders collect keys before fetching them, a full batch is fetched right away.
+func BatchWait(wait time.Duration) Option {
+    return func(r *routes) {
+        r.batchWait = wait
+    }
+}
@@ -0,0 +1,60 @@
+package graphql
+
+import (
+    "context"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+)
+
+// PartnerCompanies requires an access token, the career center isn't public.
+func (r *Resolver) PartnerCompanies(ctx context.Context, args struct {
+    First int32
+    After *int32
+},
+) (*partnerCompanyPageResolver, error) {
+    if _, ok := accountID(ctx); !ok {
+        return nil, errorResponse(entity.ErrUnauthenticated)
+    }
+
+    limit, err := pageSize(args.First)
+    if err != nil {
+        return nil, err
+    }
+
+    page, err := r.p.ListPartnerCompanies(ctx, optionalInt(args.After), limit)
+    if err != nil {
+        r.l.Error(err, "graphql - PartnerCompanies")
+
+        return nil, errorResponse(err)
+    }
+
+    return &partnerCompanyPageResolver{page: page}, nil
+}
+
+type partnerCompanyPageResolver struct {
+    page entity.PartnerCompanyPage
+}
+
+func (r *partnerCompanyPageResolver) Companies() []*partnerCompanyResolver {
+    companies := make([]*partnerCompanyResolver, 0, len(r.page.Companies))
+    for _, company := range r.page.Companies {
+        companies = append(companies, &partnerCompanyResolver{company: company})
+    }
+
+    return companies
+}
+
+func (r *partnerCompanyPageResolver) NextAfterID() *int32 {
+    return nextID(r.page.NextID)
+}
+
+type partnerCompanyResolver struct {
+    company entity.PartnerCompany
+}
+
+func (r *partnerCompanyResolver) ID() int32                  { return int32(r.company.CompanyID) }
+func (r *partnerCompanyResolver) ShortName() string          { return r.company.ShortName }
+func (r *partnerCompanyResolver) FullName() string           { return r.company.FullName }
+func (r *partnerCompanyResolver) HiredGraduatesCount() int32 { return int32(r.company.HiredGraduatesCount) }
+func (r *partnerCompanyResolver) Requirements() string       { return r.company.Requirements }
+func (r *partnerCompanyResolver) AgreementStatus() bool      { return r.company.AgreementStatus }
@@ -0,0 +1,70 @@
+package graphql
+
+import (
+    "context"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/usecase"
+    "github.com/deadnotxaa/education-platform/backend/pkg/logger"
+)
+
+// _maxPageSize - upper bound of the first argument, same as the page size limit of the REST API.
+const _maxPageSize = 100
+
+// Resolver - root resolver, the type resolvers keep a pointer to it for the use case and the logger.
+// Fields of one level run concurrently, nested fields go through the request loaders.
+type Resolver struct {
+    p usecase.Platform
+    l logger.Interface
+}
+
+type accountIDKey struct{}
+
+// accountID returns the account of the access token sent with the query.
+func accountID(ctx context.Context) (int, bool) {
+    id, ok := ctx.Value(accountIDKey{}).(int)
+
+    return id, ok
+}
+
+// pageSize checks the first argument, the schema gives it a default.
+func pageSize(first int32) (uint32, error) {
+    if first < 1 || first > _maxPageSize {
+        return 0, invalidArgumentResponse("first", "gte=1,lte=100")
+    }
+
+    return uint32(first), nil
+}
+
+func optionalInt(v *int32) int {
+    if v == nil {
+        return 0
+    }
+
+    return int(*v)
+}
+
+func optionalString(v *string) string {
+    if v == nil {
+        return ""
+    }
+
+    return *v
+}
+
+func nextID(id int) *int32 {
+    if id == 0 {
+        return nil
+    }
+
+    v := int32(id)
+
+    return &v
+}
+
+func nextCursor(cursor string) *string {
+    if cursor == "" {
+        return nil
+    }
+
+    return &cursor
+}
@@ -0,0 +1,135 @@
+// Package graphql implements the read-only GraphQL gateway over usecase.Platform. Nested fields are
+// loaded in batches per request, queries are limited by depth, length and complexity.
+package graphql
+
+import (
+    "context"
+    _ "embed"
+    "fmt"
+    "strings"
+    "time"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/controller/http/problem"
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+    "github.com/deadnotxaa/education-platform/backend/internal/usecase"
+    "github.com/deadnotxaa/education-platform/backend/pkg/logger"
+    "github.com/gofiber/fiber/v2"
+    graphqlgo "github.com/graph-gophers/graphql-go"
+    gqlerrors "github.com/graph-gophers/graphql-go/errors"
+)
+
+//go:embed schema.graphql
+var _schema string
+
+type routes struct {
+    p usecase.Platform
+    l logger.Interface
+
+    schema     *graphqlgo.Schema
+    complexity *complexity
+
+    maxDepth       int
+    maxComplexity  int
+    maxQueryLength int
+    maxParallelism int
+
+    batchWait time.Duration
+    maxBatch  int
+}
+
+// request - body of a GraphQL query.
+type request struct {
+    Query         string         `json:"query"`
+    OperationName string         `json:"operationName"`
+    Variables     map[string]any `json:"variables"`
+}
+
+// NewRoutes mounts POST /graphql. The schema is embedded, so it panics only when the schema and
+// the resolvers don't match.
+func NewRoutes(app fiber.Router, p usecase.Platform, l logger.Interface, opts ...Option) {
+    r := &routes{
+        p:              p,
+        l:              l,
+        maxDepth:       _defaultMaxDepth,
+        maxComplexity:  _defaultMaxComplexity,
+        maxQueryLength: _defaultMaxQueryLength,
+        maxParallelism: _defaultMaxParallelism,
+        batchWait:      _defaultBatchWait,
+        maxBatch:       _defaultMaxBatch,
+    }
+
+    // Custom options
+    for _, opt := range opts {
+        opt(r)
+    }
+
+    r.schema = graphqlgo.MustParseSchema(_schema, &Resolver{p: p, l: l},
+        graphqlgo.MaxDepth(r.maxDepth),
+        graphqlgo.MaxParallelism(r.maxParallelism),
+    )
+
+    c, err := newComplexity(_schema, r.maxComplexity)
+    if err != nil {
+        panic(fmt.Errorf("graphql - NewRoutes - newComplexity: %w", err))
+    }
+
+    r.complexity = c
+
+    app.Post("/graphql", r.query)
+}
+
+// @Summary     GraphQL query
+// @Description Runs a query against the read-only GraphQL schema, the Authorization header is only required by "me".
+// @Description Field errors are returned in "errors" with the error catalog code in "extensions".
+// @ID          graphql
+// @Tags        graphql
+// @Accept      json
+// @Produce     json
+// @Param       request body request true "Query, operation name and variables"
+// @Success     200 {object} map[string]interface{}
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Router      /graphql [post]
+func (r *routes) query(ctx *fiber.Ctx) error {
+    var body request
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - graphql - query")
+
+        return problem.WriteDetail(ctx, entity.ErrInvalidRequest, "invalid request body")
+    }
+
+    if body.Query == "" {
+        return problem.WriteDetail(ctx, entity.ErrInvalidRequest, "query is required")
+    }
+
+    userCtx := ctx.UserContext()
+
+    if header := ctx.Get(fiber.HeaderAuthorization); header != "" {
+        token, found := strings.CutPrefix(header, "Bearer ")
+        if !found || token == "" {
+            return problem.Write(ctx, entity.ErrUnauthenticated)
+        }
+
+        accountID, err := r.p.ParseAccessToken(token)
+        if err != nil {
+            return problem.WriteDetail(ctx, entity.ErrInvalidToken, "invalid access token")
+        }
+
+        userCtx = context.WithValue(userCtx, accountIDKey{}, accountID)
+    }
+
+    if len(body.Query) > r.maxQueryLength {
+        return ctx.JSON(&graphqlgo.Response{Errors: []*gqlerrors.QueryError{
+            newQueryError(errInvalidQuery(fmt.Sprintf("query length exceeds the limit of %d bytes", r.maxQueryLength))),
+        }})
+    }
+
+    if errs := r.complexity.check(body.Query, body.OperationName, body.Variables); len(errs) > 0 {
+        return ctx.JSON(&graphqlgo.Response{Errors: errs})
+    }
+
+    userCtx = withLoaders(userCtx, newLoaders(r.p, r.batchWait, r.maxBatch))
+
+    return ctx.JSON(r.schema.Exec(userCtx, body.Query, body.OperationName, body.Variables))
+}
@@ -0,0 +1,131 @@
+package graphql
+
+import (
+    "context"
+    "strings"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+)
+
+// Teacher -.
+func (r *Resolver) Teacher(ctx context.Context, args struct{ ID int32 }) (*teacherResolver, error) {
+    return loadTeacher(ctx, r, int(args.ID))
+}
+
+// Teachers -.
+func (r *Resolver) Teachers(ctx context.Context, args struct {
+    First int32
+    After *int32
+},
+) (*teacherPageResolver, error) {
+    limit, err := pageSize(args.First)
+    if err != nil {
+        return nil, err
+    }
+
+    page, err := r.p.ListTeachers(ctx, optionalInt(args.After), limit)
+    if err != nil {
+        r.l.Error(err, "graphql - Teachers")
+
+        return nil, errorResponse(err)
+    }
+
+    return &teacherPageResolver{root: r, page: page}, nil
+}
+
+// loadTeacher returns the teacher profile of an employee, nil when the employee isn't a teacher.
+func loadTeacher(ctx context.Context, root *Resolver, employeeID int) (*teacherResolver, error) {
+    profile, found, err := loadersFrom(ctx).teachers.Load(ctx, employeeID)
+    if err != nil {
+        root.l.Error(err, "graphql - loadTeacher")
+
+        return nil, errorResponse(err)
+    }
+
+    if !found {
+        return nil, nil
+    }
+
+    return &teacherResolver{root: root, profile: profile}, nil
+}
+
+// staffRole converts a staff role to the enum value, Teacher -> TEACHER.
+func staffRole(role entity.StaffRole) string {
+    return strings.ToUpper(string(role))
+}
+
+type teacherPageResolver struct {
+    root *Resolver
+    page entity.TeacherPage
+}
+
+func (r *teacherPageResolver) Teachers() []*teacherResolver {
+    teachers := make([]*teacherResolver, 0, len(r.page.Teachers))
+    for _, profile := range r.page.Teachers {
+        teachers = append(teachers, &teacherResolver{root: r.root, profile: profile})
+    }
+
+    return teachers
+}
+
+func (r *teacherPageResolver) NextAfterID() *int32 {
+    return nextID(r.page.NextID)
+}
+
+type teacherResolver struct {
+    root    *Resolver
+    profile entity.TeacherProfile
+}
+
+func (r *teacherResolver) EmployeeID() int32               { return int32(r.profile.EmployeeID) }
+func (r *teacherResolver) Name() string                    { return r.profile.Name }
+func (r *teacherResolver) Surname() string                 { return r.profile.Surname }
+func (r *teacherResolver) WorkPlace() string               { return r.profile.WorkPlace }
+func (r *teacherResolver) OverallExperience() int32        { return int32(r.profile.OverallExperience) }
+func (r *teacherResolver) SpecializationExperience() int32 { return int32(r.profile.SpecializationExperience) }
+func (r *teacherResolver) PhotoURL() string                { return r.profile.PhotoURL }
+func (r *teacherResolver) Bio() string                     { return r.profile.Bio }
+func (r *teacherResolver) AverageRating() float64          { return r.profile.AverageRating }
+func (r *teacherResolver) ReviewsCount() int32             { return int32(r.profile.ReviewsCount) }
+
+func (r *teacherResolver) Courses(ctx context.Context) ([]*teacherCourseResolver, error) {
+    courses, _, err := loadersFrom(ctx).teacherCourses.Load(ctx, r.profile.EmployeeID)
+    if err != nil {
+        r.root.l.Error(err, "graphql - Teacher.courses")
+
+        return nil, errorResponse(err)
+    }
+
+    resolvers := make([]*teacherCourseResolver, 0, len(courses))
+    for _, course := range courses {
+        resolvers = append(resolvers, &teacherCourseResolver{root: r.root, course: course})
+    }
+
+    return resolvers, nil
+}
+
+type teacherCourseResolver struct {
+    root   *Resolver
+    course entity.TeacherCourse
+}
+
+func (r *teacherCourseResolver) CourseID() int32   { return int32(r.course.CourseID) }
+func (r *teacherCourseResolver) StaffRole() string { return staffRole(r.course.StaffRole) }
+
+func (r *teacherCourseResolver) Course(ctx context.Context) (*courseResolver, error) {
+    return loadCourse(ctx, r.root, r.course.CourseID)
+}
+
+type staffMemberResolver struct {
+    root   *Resolver
+    member entity.CourseStaffMember
+}
+
+func (r *staffMemberResolver) EmployeeID() int32 { return int32(r.member.EmployeeID) }
+func (r *staffMemberResolver) Name() string      { return r.member.Name }
+func (r *staffMemberResolver) Surname() string   { return r.member.Surname }
+func (r *staffMemberResolver) StaffRole() string { return staffRole(r.member.StaffRole) }
+
+func (r *staffMemberResolver) Teacher(ctx context.Context) (*teacherResolver, error) {
+    return loadTeacher(ctx, r.root, r.member.EmployeeID)
+}
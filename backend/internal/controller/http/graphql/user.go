@@ -0,0 +1,35 @@
+package graphql
+
+import (
+    "context"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+)
+
+// Me -.
+func (r *Resolver) Me(ctx context.Context) (*userResolver, error) {
+    id, ok := accountID(ctx)
+    if !ok {
+        return nil, errorResponse(entity.ErrUnauthenticated)
+    }
+
+    user, err := r.p.GetUserById(ctx, id)
+    if err != nil {
+        r.l.Error(err, "graphql - Me")
+
+        return nil, errorResponse(err)
+    }
+
+    return &userResolver{user: user}, nil
+}
+
+type userResolver struct {
+    user entity.User
+}
+
+func (r *userResolver) AccountID() int32          { return int32(r.user.AccountID) }
+func (r *userResolver) Name() string              { return r.user.Name }
+func (r *userResolver) Surname() string           { return r.user.Surname }
+func (r *userResolver) Email() string             { return r.user.Email }
+func (r *userResolver) ProfilePictureURL() string { return r.user.ProfilePictureUrl }
+func (r *userResolver) CreatedAt() string         { return r.user.CreatedAt }
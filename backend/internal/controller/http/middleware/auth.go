@@ -0,0 +1,52 @@
+package middleware
+
+import (
+    "context"
+    "strings"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/controller/http/problem"
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+    "github.com/gofiber/fiber/v2"
+)
+
+type accountIDKey struct{}
+
+// TokenVerifier - validates access tokens, implemented by usecase.Platform.
+type TokenVerifier interface {
+    ParseAccessToken(token string) (int, error)
+}
+
+// Authenticate rejects requests without a valid "Authorization: Bearer <access token>" header
+// and stores the authenticated account ID in the request context.
+func Authenticate(v TokenVerifier) fiber.Handler {
+    return func(ctx *fiber.Ctx) error {
+        token, found := strings.CutPrefix(ctx.Get(fiber.HeaderAuthorization), "Bearer ")
+        if !found || token == "" {
+            return problem.Write(ctx, entity.ErrUnauthenticated)
+        }
+
+        accountID, err := v.ParseAccessToken(token)
+        if err != nil {
+            return problem.WriteDetail(ctx, entity.ErrInvalidToken, "invalid access token")
+        }
+
+        ctx.Locals(accountIDKey{}, accountID)
+        ctx.SetUserContext(context.WithValue(ctx.UserContext(), accountIDKey{}, accountID))
+
+        return ctx.Next()
+    }
+}
+
+// AccountID returns the account ID set by Authenticate.
+func AccountID(ctx *fiber.Ctx) (int, bool) {
+    accountID, ok := ctx.Locals(accountIDKey{}).(int)
+
+    return accountID, ok
+}
+
+// AccountIDFromContext returns the account ID set by Authenticate from the request's user context.
+func AccountIDFromContext(ctx context.Context) (int, bool) {
+    accountID, ok := ctx.Value(accountIDKey{}).(int)
+
+    return accountID, ok
+}
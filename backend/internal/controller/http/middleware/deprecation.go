@@ -0,0 +1,18 @@
+package middleware
+
+import (
+    "fmt"
+
+    "github.com/gofiber/fiber/v2"
+)
+
+// Deprecated marks a route replaced by a newer API version: the Deprecation header tells clients to migrate
+// and the Link header points to the successor route.
+func Deprecated(successor string) fiber.Handler {
+    return func(ctx *fiber.Ctx) error {
+        ctx.Set("Deprecation", "true")
+        ctx.Append(fiber.HeaderLink, fmt.Sprintf(`<%s>; rel="successor-version"`, successor))
+
+        return ctx.Next()
+    }
+}
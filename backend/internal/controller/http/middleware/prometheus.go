@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
@@ -61,13 +62,84 @@ func PrometheusMiddleware() fiber.Handler {
 
 // normalizeEndpoint normalizes endpoint paths to avoid high cardinality
 func normalizeEndpoint(path string) string {
+	// Paths with parameters are reported by their route pattern
+	if strings.HasPrefix(path, "/v1/certificates/verify/") {
+		return "/v1/certificates/verify/:code"
+	}
+	if strings.HasPrefix(path, "/v1/user/") && strings.HasSuffix(path, "/progress") {
+		return "/v1/user/:id/progress"
+	}
+	if strings.HasPrefix(path, "/v2/courses/") {
+		return "/v2/courses/:id"
+	}
+	if strings.HasPrefix(path, "/v2/users/") {
+		return "/v2/users/:id"
+	}
+	if strings.HasPrefix(path, "/errors/") {
+		return "/errors/:code"
+	}
+
 	switch path {
 	case "/v1/course/getcourse":
 		return "/v1/course/getcourse"
+	case "/v1/course/create-course":
+		return "/v1/course/create-course"
+	case "/v1/course/update-course":
+		return "/v1/course/update-course"
+	case "/v1/course/delete-course":
+		return "/v1/course/delete-course"
+	case "/v1/courses":
+		return "/v1/courses"
+	case "/v1/search":
+		return "/v1/search"
+	case "/v1/auth/register", "/v1/auth/login", "/v1/auth/refresh", "/v1/auth/logout":
+		return path
+	case "/v1/purchase/purchase-course", "/v1/purchase/complete-purchase", "/v1/purchase/cancel-purchase":
+		return path
+	case "/v1/payments/create-payment", "/v1/payments/confirm-payment", "/v1/payments/refund-payment",
+		"/v1/payments/webhook":
+		return path
+	case "/v1/review/create-review", "/v1/review/update-review", "/v1/review/delete-review",
+		"/v1/review/moderation-queue", "/v1/review/approve-review", "/v1/review/reject-review":
+		return path
+	case "/v1/certificates/issue-certificate", "/v1/certificates/my-certificates",
+		"/v1/certificates/download-certificate":
+		return path
+	case "/v1/syllabus/get-syllabus", "/v1/syllabus/create-topic", "/v1/syllabus/update-topic",
+		"/v1/syllabus/delete-topic", "/v1/syllabus/reorder-topics", "/v1/syllabus/create-project",
+		"/v1/syllabus/update-project", "/v1/syllabus/delete-project":
+		return path
+	case "/v1/progress/update-topic", "/v1/progress/submit-project", "/v1/progress/review-queue",
+		"/v1/progress/grade-project":
+		return path
+	case "/v1/calendar/upcoming", "/v1/calendar/create-calendar", "/v1/calendar/update-calendar",
+		"/v1/calendar/join-waitlist", "/v1/calendar/leave-waitlist", "/v1/calendar/my-waitlist":
+		return path
+	case "/v1/career/enroll-student", "/v1/career/upload-cv", "/v1/career/expiring-support",
+		"/v1/career/extend-support", "/v1/career/apply-job", "/v1/career/my-applications",
+		"/v1/career/change-application-status", "/v1/career/application-history":
+		return path
+	case "/v1/career/companies", "/v1/career/get-company", "/v1/career/create-company", "/v1/career/update-company",
+		"/v1/career/delete-company", "/v1/career/match-students":
+		return path
+	case "/v1/blog/posts", "/v1/blog/get-post", "/v1/blog/create-post", "/v1/blog/update-post",
+		"/v1/blog/schedule-post", "/v1/blog/publish-post", "/v1/blog/unpublished":
+		return path
+	case "/v1/teacher/teachers", "/v1/teacher/get-teacher", "/v1/teacher/update-profile",
+		"/v1/staff/course-staff", "/v1/staff/assign", "/v1/staff/unassign":
+		return path
+	case "/v2/reports/top-courses", "/v2/reports/purchases":
+		return path
+	case "/errors":
+		return path
+	case "/graphql":
+		return path
 	case "/v1/user/getuser":
 		return "/v1/user/getuser"
 	case "/v1/report/get-top-courses-report":
 		return "/v1/report/get-top-courses-report"
+	case "/v1/report/get-detailed-purchase-report":
+		return "/v1/report/get-detailed-purchase-report"
 	case "/metrics":
 		return "/metrics"
 	default:
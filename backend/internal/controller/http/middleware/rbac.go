@@ -0,0 +1,38 @@
+package middleware
+
+import (
+    "context"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/controller/http/problem"
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+    "github.com/deadnotxaa/education-platform/backend/pkg/logger"
+    "github.com/gofiber/fiber/v2"
+)
+
+// PermissionChecker - checks account permissions, implemented by usecase.Platform.
+type PermissionChecker interface {
+    HasPermission(ctx context.Context, accountID int, permission entity.Permission) (bool, error)
+}
+
+// RequirePermission rejects requests of accounts without the permission, must run after Authenticate.
+func RequirePermission(c PermissionChecker, l logger.Interface, permission entity.Permission) fiber.Handler {
+    return func(ctx *fiber.Ctx) error {
+        accountID, ok := AccountID(ctx)
+        if !ok {
+            return problem.Write(ctx, entity.ErrUnauthenticated)
+        }
+
+        allowed, err := c.HasPermission(ctx.UserContext(), accountID, permission)
+        if err != nil {
+            l.Error(err, "http - middleware - RequirePermission")
+
+            return problem.Write(ctx, err)
+        }
+
+        if !allowed {
+            return problem.Write(ctx, entity.ErrForbidden)
+        }
+
+        return ctx.Next()
+    }
+}
@@ -0,0 +1,64 @@
+package problem
+
+import (
+    "net/http"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/controller/http/v1/response"
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+    "github.com/gofiber/fiber/v2"
+)
+
+// NewCatalogRoutes serves the error catalog, problem types resolve to its entries.
+func NewCatalogRoutes(app fiber.Router) {
+    app.Get("/errors", listErrors)
+    app.Get("/errors/:code", getError)
+}
+
+// @Summary     Error Catalog
+// @Description List every error code with its kind and HTTP status
+// @ID          listErrors
+// @Tags  	    errors
+// @Produce     json
+// @Success     200 {array}  response.ErrorCatalogEntry
+// @Router      /errors [get]
+func listErrors(ctx *fiber.Ctx) error {
+    catalog := entity.ErrorCatalog()
+
+    entries := make([]response.ErrorCatalogEntry, 0, len(catalog))
+    for i := range catalog {
+        entries = append(entries, catalogEntry(&catalog[i]))
+    }
+
+    return ctx.Status(http.StatusOK).JSON(entries)
+}
+
+// @Summary     Error Catalog Entry
+// @Description Describe an error code, problem types point here
+// @ID          getError
+// @Tags  	    errors
+// @Produce     json
+// @Param       code path string true "Error code"
+// @Success     200 {object} response.ErrorCatalogEntry
+// @Failure     404 {object} response.Problem
+// @Router      /errors/{code} [get]
+func getError(ctx *fiber.Ctx) error {
+    code := ctx.Params("code")
+
+    for _, e := range entity.ErrorCatalog() {
+        if e.Code == code {
+            return ctx.Status(http.StatusOK).JSON(catalogEntry(&e))
+        }
+    }
+
+    return Write(ctx, entity.ErrRouteNotFound)
+}
+
+func catalogEntry(e *entity.DomainError) response.ErrorCatalogEntry {
+    return response.ErrorCatalogEntry{
+        Type:   TypePrefix + e.Code,
+        Code:   e.Code,
+        Kind:   string(e.Kind),
+        Status: Status(e.Kind),
+        Title:  e.Message,
+    }
+}
@@ -0,0 +1,122 @@
+// Package problem reports errors as RFC 7807 problem details. Domain errors from internal/entity keep their
+// code and get the status of their kind, any other error is reported as internal_error.
+package problem
+
+import (
+    "errors"
+    "net/http"
+    "reflect"
+    "strings"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/controller/http/v1/response"
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+    "github.com/go-playground/validator/v10"
+    "github.com/gofiber/fiber/v2"
+)
+
+const (
+    // ContentType - media type of problem responses.
+    ContentType = "application/problem+json"
+
+    // TypePrefix - problem types point to the error catalog entries.
+    TypePrefix = "/errors/"
+)
+
+// Status returns the HTTP status of an error kind.
+func Status(kind entity.ErrorKind) int {
+    switch kind {
+    case entity.ErrorKindNotFound:
+        return http.StatusNotFound
+    case entity.ErrorKindConflict:
+        return http.StatusConflict
+    case entity.ErrorKindValidation:
+        return http.StatusBadRequest
+    case entity.ErrorKindForbidden:
+        return http.StatusForbidden
+    case entity.ErrorKindUnauthorized:
+        return http.StatusUnauthorized
+    default:
+        return http.StatusInternalServerError
+    }
+}
+
+// Write reports an error.
+func Write(ctx *fiber.Ctx, err error) error {
+    return WriteDetail(ctx, err, "")
+}
+
+// WriteDetail reports an error with a detail message, it replaces the domain error message.
+// Details of internal errors are never sent.
+func WriteDetail(ctx *fiber.Ctx, err error, detail string) error {
+    e := entity.ErrInternal
+
+    var domainErr *entity.DomainError
+    if errors.As(err, &domainErr) {
+        e = domainErr
+    }
+
+    if detail == "" || e.Kind == entity.ErrorKindInternal {
+        detail = e.Message
+    }
+
+    return send(ctx, New(ctx, e, detail))
+}
+
+// Validation reports request fields rejected by the validator, other errors are reported as invalid_request.
+func Validation(ctx *fiber.Ctx, err error) error {
+    var validationErrs validator.ValidationErrors
+    if !errors.As(err, &validationErrs) {
+        return Write(ctx, entity.ErrInvalidRequest)
+    }
+
+    p := New(ctx, entity.ErrValidationFailed, entity.ErrValidationFailed.Message)
+
+    for _, fe := range validationErrs {
+        reason := fe.Tag()
+        if fe.Param() != "" {
+            reason += "=" + fe.Param()
+        }
+
+        p.InvalidParams = append(p.InvalidParams, response.InvalidParam{Name: fe.Field(), Reason: reason})
+    }
+
+    return send(ctx, p)
+}
+
+// New builds the problem of a domain error for the current request.
+func New(ctx *fiber.Ctx, e *entity.DomainError, detail string) response.Problem {
+    return response.Problem{
+        Type:     TypePrefix + e.Code,
+        Title:    e.Message,
+        Status:   Status(e.Kind),
+        Detail:   detail,
+        Instance: ctx.Path(),
+        Code:     e.Code,
+    }
+}
+
+// NewValidator returns a validator that names invalid fields as they are sent: by their json, query or params tag.
+func NewValidator() *validator.Validate {
+    v := validator.New(validator.WithRequiredStructEnabled())
+
+    v.RegisterTagNameFunc(func(field reflect.StructField) string {
+        for _, key := range []string{"json", "query", "params"} {
+            name, _, _ := strings.Cut(field.Tag.Get(key), ",")
+            if name == "-" {
+                return ""
+            }
+
+            if name != "" {
+                return name
+            }
+        }
+
+        return ""
+    })
+
+    return v
+}
+
+func send(ctx *fiber.Ctx, p response.Problem) error {
+    return ctx.Status(p.Status).JSON(p, ContentType)
+}
@@ -5,13 +5,18 @@ import (
 	"net/http"
 
 	"github.com/deadnotxaa/education-platform/backend/config"
+	"github.com/deadnotxaa/education-platform/backend/internal/controller/http/graphql"
 	"github.com/deadnotxaa/education-platform/backend/internal/controller/http/middleware"
+	"github.com/deadnotxaa/education-platform/backend/internal/controller/http/problem"
 	v1 "github.com/deadnotxaa/education-platform/backend/internal/controller/http/v1"
+	v2 "github.com/deadnotxaa/education-platform/backend/internal/controller/http/v2"
+	"github.com/deadnotxaa/education-platform/backend/internal/entity"
 	"github.com/deadnotxaa/education-platform/backend/internal/usecase"
 	"github.com/deadnotxaa/education-platform/backend/pkg/logger"
 
 	"github.com/gofiber/adaptor/v2"
 	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/etag"
 	"github.com/gofiber/swagger"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
@@ -23,6 +28,9 @@ import (
 // @version     1.0
 // @host        localhost:8080
 // @BasePath    /v1
+// @securityDefinitions.apikey BearerAuth
+// @in          header
+// @name        Authorization
 func NewRouter(app *fiber.App, cfg *config.Config, t usecase.Platform, l logger.Interface) {
     // Options
     app.Use(middleware.Logger(l))
@@ -42,11 +50,47 @@ func NewRouter(app *fiber.App, cfg *config.Config, t usecase.Platform, l logger.
     // K8s probe
     app.Get("/healthz", func(ctx *fiber.Ctx) error { return ctx.SendStatus(http.StatusOK) })
 
+    // Error catalog, problem types of error responses point to its entries
+    problem.NewCatalogRoutes(app)
+
     // Routers
     apiV1Group := app.Group("/v1")
     {
         v1.NewCourseRoutes(apiV1Group, t, l)
         v1.NewUserRoutes(apiV1Group, t, l)
         v1.NewReportRoutes(apiV1Group, t, l)
+        v1.NewSearchRoutes(apiV1Group, t, l)
+        v1.NewAuthRoutes(apiV1Group, t, l)
+        v1.NewPurchaseRoutes(apiV1Group, t, l)
+        v1.NewPaymentRoutes(apiV1Group, t, l)
+        v1.NewReviewRoutes(apiV1Group, t, l)
+        v1.NewCertificateRoutes(apiV1Group, t, l)
+        v1.NewSyllabusRoutes(apiV1Group, t, l)
+        v1.NewProgressRoutes(apiV1Group, t, l)
+        v1.NewCalendarRoutes(apiV1Group, t, l)
+        v1.NewCareerRoutes(apiV1Group, t, l)
+        v1.NewBlogRoutes(apiV1Group, t, l)
+        v1.NewTeacherRoutes(apiV1Group, t, l)
+    }
+
+    // Resource-style paths with path and query parameters, responses carry an ETag for conditional requests
+    apiV2Group := app.Group("/v2", etag.New())
+    {
+        v2.NewCourseRoutes(apiV2Group, t, l)
+        v2.NewUserRoutes(apiV2Group, t, l)
+        v2.NewReportRoutes(apiV2Group, t, l)
     }
+
+    // Read-only GraphQL gateway
+    if cfg.GraphQL.Enabled {
+        graphql.NewRoutes(app, t, l,
+            graphql.MaxDepth(cfg.GraphQL.MaxDepth),
+            graphql.MaxComplexity(cfg.GraphQL.MaxComplexity),
+            graphql.MaxQueryLength(cfg.GraphQL.MaxQueryLength),
+            graphql.BatchWait(cfg.GraphQL.BatchWait),
+        )
+    }
+
+    // Unknown paths get a problem response as well
+    app.Use(func(ctx *fiber.Ctx) error { return problem.Write(ctx, entity.ErrRouteNotFound) })
 }
@@ -0,0 +1,162 @@
+package v1
+
+import (
+    "net/http"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/controller/http/v1/request"
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+    "github.com/gofiber/fiber/v2"
+)
+
+// @Summary     Register
+// @Description Create a new account and get a token pair
+// @ID          register
+// @Tags  	    auth
+// @Accept      json
+// @Produce     json
+// @Param       request body request.Register true "Account data"
+// @Success     201 {object} entity.TokenPair
+// @Failure     400 {object} response.Problem
+// @Failure     409 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /auth/register [post]
+func (r *V1) register(ctx *fiber.Ctx) error {
+    var body request.Register
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - register")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - register")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    tokens, err := r.p.Register(ctx.UserContext(), entity.User{
+        Name:        body.Name,
+        Surname:     body.Surname,
+        Email:       body.Email,
+        BirthDate:   body.BirthDate,
+        PhoneNumber: body.PhoneNumber,
+    }, body.Password)
+    if err != nil {
+        r.l.Error(err, "http - v1 - register")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusCreated).JSON(tokens)
+}
+
+// @Summary     Login
+// @Description Exchange email and password for a token pair
+// @ID          login
+// @Tags  	    auth
+// @Accept      json
+// @Produce     json
+// @Param       request body request.Login true "Credentials"
+// @Success     200 {object} entity.TokenPair
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /auth/login [post]
+func (r *V1) login(ctx *fiber.Ctx) error {
+    var body request.Login
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - login")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - login")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    tokens, err := r.p.Login(ctx.UserContext(), body.Email, body.Password)
+    if err != nil {
+        r.l.Error(err, "http - v1 - login")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusOK).JSON(tokens)
+}
+
+// @Summary     Refresh
+// @Description Exchange a refresh token for a new token pair, the old refresh token is revoked
+// @ID          refresh
+// @Tags  	    auth
+// @Accept      json
+// @Produce     json
+// @Param       request body request.RefreshToken true "Refresh token"
+// @Success     200 {object} entity.TokenPair
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /auth/refresh [post]
+func (r *V1) refresh(ctx *fiber.Ctx) error {
+    var body request.RefreshToken
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - refresh")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - refresh")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    tokens, err := r.p.RefreshTokens(ctx.UserContext(), body.RefreshToken)
+    if err != nil {
+        r.l.Error(err, "http - v1 - refresh")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusOK).JSON(tokens)
+}
+
+// @Summary     Logout
+// @Description Revoke a refresh token
+// @ID          logout
+// @Tags  	    auth
+// @Accept      json
+// @Produce     json
+// @Param       request body request.RefreshToken true "Refresh token"
+// @Success     204
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /auth/logout [post]
+func (r *V1) logout(ctx *fiber.Ctx) error {
+    var body request.RefreshToken
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - logout")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - logout")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    if err := r.p.Logout(ctx.UserContext(), body.RefreshToken); err != nil {
+        r.l.Error(err, "http - v1 - logout")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.SendStatus(http.StatusNoContent)
+}
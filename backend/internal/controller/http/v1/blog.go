@@ -0,0 +1,291 @@
+package v1
+
+import (
+    "net/http"
+    "time"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/controller/http/middleware"
+    "github.com/deadnotxaa/education-platform/backend/internal/controller/http/v1/request"
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+    "github.com/gofiber/fiber/v2"
+)
+
+// @Summary     List Blog Posts
+// @Description Get published blog posts, newest first, optionally filtered by tag and topic
+// @ID          listBlogPosts
+// @Tags  	    blog
+// @Produce     json
+// @Param       tag    query string false "Tag name"
+// @Param       topic  query string false "Topic"
+// @Param       cursor query string false "next_cursor from the previous page"
+// @Param       limit  query int    false "Page size, 20 by default"
+// @Success     200 {object} entity.BlogPostPage
+// @Failure     400 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /blog/posts [get]
+func (r *V1) listBlogPosts(ctx *fiber.Ctx) error {
+    var query request.ListBlogPosts
+
+    if err := ctx.QueryParser(&query); err != nil {
+        r.l.Error(err, "http - v1 - listBlogPosts")
+
+        return invalidRequestResponse(ctx, "invalid query parameters")
+    }
+
+    if err := r.v.Struct(query); err != nil {
+        r.l.Error(err, "http - v1 - listBlogPosts")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    page, err := r.p.ListBlogPosts(ctx.UserContext(), entity.BlogFilter{
+        Tag:    query.Tag,
+        Topic:  query.Topic,
+        Cursor: query.Cursor,
+        Limit:  query.Limit,
+    })
+    if err != nil {
+        r.l.Error(err, "http - v1 - listBlogPosts")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusOK).JSON(page)
+}
+
+// @Summary     Get Blog Post
+// @Description Get a published blog post by its ID
+// @ID          getBlogPost
+// @Tags  	    blog
+// @Produce     json
+// @Param       id query int true "Post ID"
+// @Success     200 {object} entity.BlogPost
+// @Failure     400 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /blog/get-post [get]
+func (r *V1) getBlogPost(ctx *fiber.Ctx) error {
+    var query request.GetBlogPost
+
+    if err := ctx.QueryParser(&query); err != nil {
+        r.l.Error(err, "http - v1 - getBlogPost")
+
+        return invalidRequestResponse(ctx, "invalid post id")
+    }
+
+    if err := r.v.Struct(query); err != nil {
+        r.l.Error(err, "http - v1 - getBlogPost")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    post, err := r.p.GetBlogPost(ctx.UserContext(), query.ID)
+    if err != nil {
+        r.l.Error(err, "http - v1 - getBlogPost")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusOK).JSON(post)
+}
+
+// @Summary     Create Blog Post
+// @Description Create a draft, the reading time is computed from the content
+// @ID          createBlogPost
+// @Tags  	    blog
+// @Accept      json
+// @Produce     json
+// @Param       request body request.CreateBlogPost true "Post to create"
+// @Security    BearerAuth
+// @Success     201 {object} entity.BlogPost
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /blog/create-post [post]
+func (r *V1) createBlogPost(ctx *fiber.Ctx) error {
+    var body request.CreateBlogPost
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - createBlogPost")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - createBlogPost")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    accountID, _ := middleware.AccountID(ctx)
+
+    post, err := r.p.CreateBlogPost(ctx.UserContext(), accountID, entity.BlogPost{
+        Title:         body.Title,
+        Topic:         body.Topic,
+        CoverImageUrl: body.CoverImageUrl,
+        Content:       body.Content,
+        Tags:          body.Tags,
+    })
+    if err != nil {
+        r.l.Error(err, "http - v1 - createBlogPost")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusCreated).JSON(post)
+}
+
+// @Summary     Update Blog Post
+// @Description Edit a post and replace its tags, the reading time is recomputed
+// @ID          updateBlogPost
+// @Tags  	    blog
+// @Accept      json
+// @Produce     json
+// @Param       request body request.UpdateBlogPost true "Post to update"
+// @Security    BearerAuth
+// @Success     200 {object} entity.BlogPost
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /blog/update-post [put]
+func (r *V1) updateBlogPost(ctx *fiber.Ctx) error {
+    var body request.UpdateBlogPost
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - updateBlogPost")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - updateBlogPost")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    post, err := r.p.UpdateBlogPost(ctx.UserContext(), entity.BlogPost{
+        PostID:        body.ID,
+        Title:         body.Title,
+        Topic:         body.Topic,
+        CoverImageUrl: body.CoverImageUrl,
+        Content:       body.Content,
+        Tags:          body.Tags,
+    })
+    if err != nil {
+        r.l.Error(err, "http - v1 - updateBlogPost")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusOK).JSON(post)
+}
+
+// @Summary     Schedule Blog Post
+// @Description Schedule an unpublished post for publication at a future date, it is published by a background job
+// @ID          scheduleBlogPost
+// @Tags  	    blog
+// @Accept      json
+// @Produce     json
+// @Param       request body request.ScheduleBlogPost true "Publication date"
+// @Security    BearerAuth
+// @Success     200 {object} entity.BlogPost
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     409 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /blog/schedule-post [put]
+func (r *V1) scheduleBlogPost(ctx *fiber.Ctx) error {
+    var body request.ScheduleBlogPost
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - scheduleBlogPost")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - scheduleBlogPost")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    // Validated as RFC 3339 above
+    publicationDate, _ := time.Parse(time.RFC3339, body.PublicationDate)
+
+    post, err := r.p.ScheduleBlogPost(ctx.UserContext(), body.ID, publicationDate)
+    if err != nil {
+        r.l.Error(err, "http - v1 - scheduleBlogPost")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusOK).JSON(post)
+}
+
+// @Summary     Publish Blog Post
+// @Description Publish a draft or a scheduled post right away
+// @ID          publishBlogPost
+// @Tags  	    blog
+// @Accept      json
+// @Produce     json
+// @Param       request body request.PublishBlogPost true "Post to publish"
+// @Security    BearerAuth
+// @Success     200 {object} entity.BlogPost
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     409 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /blog/publish-post [put]
+func (r *V1) publishBlogPost(ctx *fiber.Ctx) error {
+    var body request.PublishBlogPost
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - publishBlogPost")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - publishBlogPost")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    post, err := r.p.PublishBlogPost(ctx.UserContext(), body.ID)
+    if err != nil {
+        r.l.Error(err, "http - v1 - publishBlogPost")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusOK).JSON(post)
+}
+
+// @Summary     Unpublished Blog Posts
+// @Description Get drafts and scheduled posts
+// @ID          listUnpublishedBlogPosts
+// @Tags  	    blog
+// @Produce     json
+// @Security    BearerAuth
+// @Success     200 {array}  entity.BlogPost
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /blog/unpublished [get]
+func (r *V1) listUnpublishedBlogPosts(ctx *fiber.Ctx) error {
+    posts, err := r.p.ListUnpublishedBlogPosts(ctx.UserContext())
+    if err != nil {
+        r.l.Error(err, "http - v1 - listUnpublishedBlogPosts")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusOK).JSON(posts)
+}
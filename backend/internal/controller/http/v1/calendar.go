@@ -0,0 +1,241 @@
+package v1
+
+import (
+    "net/http"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/controller/http/middleware"
+    "github.com/deadnotxaa/education-platform/backend/internal/controller/http/v1/request"
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+    "github.com/gofiber/fiber/v2"
+)
+
+// @Summary     Create Cohort
+// @Description Schedule a new cohort of a course
+// @ID          createCourseCalendar
+// @Tags  	    calendar
+// @Accept      json
+// @Produce     json
+// @Param       request body request.CreateCourseCalendar true "Cohort to schedule"
+// @Security    BearerAuth
+// @Success     201 {object} entity.CourseCalendar
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /calendar/create-calendar [post]
+func (r *V1) createCourseCalendar(ctx *fiber.Ctx) error {
+    var body request.CreateCourseCalendar
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - createCourseCalendar")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - createCourseCalendar")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    calendar, err := r.p.CreateCourseCalendar(ctx.UserContext(), entity.CourseCalendar{
+        CourseID:        body.CourseID,
+        StartDate:       body.StartDate,
+        EndSalesDate:    body.EndSalesDate,
+        RemainingPlaces: body.RemainingPlaces,
+    })
+    if err != nil {
+        r.l.Error(err, "http - v1 - createCourseCalendar")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusCreated).JSON(calendar)
+}
+
+// @Summary     Update Cohort
+// @Description Change the dates and the free places of a cohort, free places are offered to the waitlist first
+// @ID          updateCourseCalendar
+// @Tags  	    calendar
+// @Accept      json
+// @Produce     json
+// @Param       request body request.UpdateCourseCalendar true "Cohort"
+// @Security    BearerAuth
+// @Success     200 {object} entity.CourseCalendar
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /calendar/update-calendar [put]
+func (r *V1) updateCourseCalendar(ctx *fiber.Ctx) error {
+    var body request.UpdateCourseCalendar
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - updateCourseCalendar")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - updateCourseCalendar")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    calendar, err := r.p.UpdateCourseCalendar(ctx.UserContext(), entity.CourseCalendar{
+        ID:              body.ID,
+        StartDate:       body.StartDate,
+        EndSalesDate:    body.EndSalesDate,
+        RemainingPlaces: body.RemainingPlaces,
+    })
+    if err != nil {
+        r.l.Error(err, "http - v1 - updateCourseCalendar")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusOK).JSON(calendar)
+}
+
+// @Summary     Upcoming Cohorts
+// @Description Get cohorts of a course that haven't started yet, soonest first
+// @ID          listUpcomingCourseCalendars
+// @Tags  	    calendar
+// @Produce     json
+// @Param       course_id query int true "Course ID"
+// @Success     200 {array}  entity.CourseCalendar
+// @Failure     400 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /calendar/upcoming [get]
+func (r *V1) listUpcomingCourseCalendars(ctx *fiber.Ctx) error {
+    var query request.UpcomingCourseCalendars
+
+    if err := ctx.QueryParser(&query); err != nil {
+        r.l.Error(err, "http - v1 - listUpcomingCourseCalendars")
+
+        return invalidRequestResponse(ctx, "invalid query parameters")
+    }
+
+    if err := r.v.Struct(query); err != nil {
+        r.l.Error(err, "http - v1 - listUpcomingCourseCalendars")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    calendars, err := r.p.ListUpcomingCourseCalendars(ctx.UserContext(), query.CourseID)
+    if err != nil {
+        r.l.Error(err, "http - v1 - listUpcomingCourseCalendars")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusOK).JSON(calendars)
+}
+
+// @Summary     Join Waitlist
+// @Description Queue for a seat in a sold out cohort, freed seats are held for waiting users in FIFO order
+// @ID          joinWaitlist
+// @Tags  	    calendar
+// @Accept      json
+// @Produce     json
+// @Param       request body request.Waitlist true "Cohort"
+// @Security    BearerAuth
+// @Success     201 {object} entity.WaitlistEntry
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     409 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /calendar/join-waitlist [post]
+func (r *V1) joinWaitlist(ctx *fiber.Ctx) error {
+    var body request.Waitlist
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - joinWaitlist")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - joinWaitlist")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    accountID, _ := middleware.AccountID(ctx)
+
+    entry, err := r.p.JoinWaitlist(ctx.UserContext(), accountID, body.CalendarID)
+    if err != nil {
+        r.l.Error(err, "http - v1 - joinWaitlist")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusCreated).JSON(entry)
+}
+
+// @Summary     Leave Waitlist
+// @Description Leave the waitlist of a cohort, a seat held for the user goes to the next one
+// @ID          leaveWaitlist
+// @Tags  	    calendar
+// @Accept      json
+// @Produce     json
+// @Param       request body request.Waitlist true "Cohort"
+// @Security    BearerAuth
+// @Success     204
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /calendar/leave-waitlist [post]
+func (r *V1) leaveWaitlist(ctx *fiber.Ctx) error {
+    var body request.Waitlist
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - leaveWaitlist")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - leaveWaitlist")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    accountID, _ := middleware.AccountID(ctx)
+
+    if err := r.p.LeaveWaitlist(ctx.UserContext(), accountID, body.CalendarID); err != nil {
+        r.l.Error(err, "http - v1 - leaveWaitlist")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.SendStatus(http.StatusNoContent)
+}
+
+// @Summary     My Waitlist
+// @Description Get the cohorts the user waits for with the place in the queue or the held seat
+// @ID          listMyWaitlist
+// @Tags  	    calendar
+// @Produce     json
+// @Security    BearerAuth
+// @Success     200 {array}  entity.WaitlistEntry
+// @Failure     401 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /calendar/my-waitlist [get]
+func (r *V1) listMyWaitlist(ctx *fiber.Ctx) error {
+    accountID, _ := middleware.AccountID(ctx)
+
+    entries, err := r.p.ListMyWaitlist(ctx.UserContext(), accountID)
+    if err != nil {
+        r.l.Error(err, "http - v1 - listMyWaitlist")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusOK).JSON(entries)
+}
@@ -0,0 +1,327 @@
+package v1
+
+import (
+    "net/http"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/controller/http/middleware"
+    "github.com/deadnotxaa/education-platform/backend/internal/controller/http/v1/request"
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+    "github.com/gofiber/fiber/v2"
+)
+
+// @Summary     Enroll Student
+// @Description Enroll a graduate into career support, only graduates with a certificate for the course are accepted
+// @ID          enrollCareerStudent
+// @Tags  	    career
+// @Accept      json
+// @Produce     json
+// @Param       request body request.EnrollCareerStudent true "Graduate to enroll"
+// @Security    BearerAuth
+// @Success     201 {object} entity.CareerCenterStudent
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     409 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /career/enroll-student [post]
+func (r *V1) enrollCareerStudent(ctx *fiber.Ctx) error {
+    var body request.EnrollCareerStudent
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - enrollCareerStudent")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - enrollCareerStudent")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    student, err := r.p.EnrollCareerStudent(ctx.UserContext(), entity.CareerCenterStudent{
+        UserID:             body.UserID,
+        CourseID:           body.CourseID,
+        CVUrl:              body.CVUrl,
+        CareerSupportStart: body.CareerSupportStart,
+        SupportPeriod:      body.SupportPeriod,
+    })
+    if err != nil {
+        r.l.Error(err, "http - v1 - enrollCareerStudent")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusCreated).JSON(student)
+}
+
+// @Summary     Upload CV
+// @Description Upload or replace own CV for the career support of a course
+// @ID          uploadCV
+// @Tags  	    career
+// @Accept      json
+// @Produce     json
+// @Param       request body request.UploadCV true "CV link"
+// @Security    BearerAuth
+// @Success     200 {object} entity.CareerCenterStudent
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /career/upload-cv [put]
+func (r *V1) uploadCV(ctx *fiber.Ctx) error {
+    var body request.UploadCV
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - uploadCV")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - uploadCV")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    accountID, _ := middleware.AccountID(ctx)
+
+    student, err := r.p.UploadCV(ctx.UserContext(), accountID, body.CourseID, body.CVUrl)
+    if err != nil {
+        r.l.Error(err, "http - v1 - uploadCV")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusOK).JSON(student)
+}
+
+// @Summary     Expiring Support
+// @Description Get students whose career support ends within the number of days from today
+// @ID          listExpiringCareerSupport
+// @Tags  	    career
+// @Produce     json
+// @Param       days     query int false "Days from today, 30 by default"
+// @Param       after_id query int false "next_after_id from the previous page"
+// @Param       limit    query int false "Page size, 20 by default"
+// @Security    BearerAuth
+// @Success     200 {object} entity.CareerStudentPage
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /career/expiring-support [get]
+func (r *V1) listExpiringCareerSupport(ctx *fiber.Ctx) error {
+    var query request.ExpiringCareerSupport
+
+    if err := ctx.QueryParser(&query); err != nil {
+        r.l.Error(err, "http - v1 - listExpiringCareerSupport")
+
+        return invalidRequestResponse(ctx, "invalid query parameters")
+    }
+
+    if err := r.v.Struct(query); err != nil {
+        r.l.Error(err, "http - v1 - listExpiringCareerSupport")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    page, err := r.p.ListExpiringCareerSupport(ctx.UserContext(), query.Days, query.AfterID, query.Limit)
+    if err != nil {
+        r.l.Error(err, "http - v1 - listExpiringCareerSupport")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusOK).JSON(page)
+}
+
+// @Summary     Extend Support
+// @Description Add days to the career support period of a student
+// @ID          extendCareerSupport
+// @Tags  	    career
+// @Accept      json
+// @Produce     json
+// @Param       request body request.ExtendCareerSupport true "Extension"
+// @Security    BearerAuth
+// @Success     200 {object} entity.CareerCenterStudent
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /career/extend-support [put]
+func (r *V1) extendCareerSupport(ctx *fiber.Ctx) error {
+    var body request.ExtendCareerSupport
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - extendCareerSupport")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - extendCareerSupport")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    student, err := r.p.ExtendCareerSupport(ctx.UserContext(), body.ID, body.Days)
+    if err != nil {
+        r.l.Error(err, "http - v1 - extendCareerSupport")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusOK).JSON(student)
+}
+
+// @Summary     Apply for Job
+// @Description Send a job application to a partner company, requires active career support for the course, one open application per company
+// @ID          applyForJob
+// @Tags  	    career
+// @Accept      json
+// @Produce     json
+// @Param       request body request.ApplyForJob true "Application"
+// @Security    BearerAuth
+// @Success     201 {object} entity.JobApplication
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     409 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /career/apply-job [post]
+func (r *V1) applyForJob(ctx *fiber.Ctx) error {
+    var body request.ApplyForJob
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - applyForJob")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - applyForJob")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    accountID, _ := middleware.AccountID(ctx)
+
+    application, err := r.p.ApplyForJob(ctx.UserContext(), accountID, body.CourseID, body.CompanyID)
+    if err != nil {
+        r.l.Error(err, "http - v1 - applyForJob")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusCreated).JSON(application)
+}
+
+// @Summary     My Job Applications
+// @Description Get own job applications, newest first
+// @ID          listMyJobApplications
+// @Tags  	    career
+// @Produce     json
+// @Security    BearerAuth
+// @Success     200 {array}  entity.JobApplication
+// @Failure     401 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /career/my-applications [get]
+func (r *V1) listMyJobApplications(ctx *fiber.Ctx) error {
+    accountID, _ := middleware.AccountID(ctx)
+
+    applications, err := r.p.ListMyJobApplications(ctx.UserContext(), accountID)
+    if err != nil {
+        r.l.Error(err, "http - v1 - listMyJobApplications")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusOK).JSON(applications)
+}
+
+// @Summary     Change Application Status
+// @Description Move a job application to the next stage (Submitted, Screening, Interview, Offer, Hired) or reject it
+// @ID          changeJobApplicationStatus
+// @Tags  	    career
+// @Accept      json
+// @Produce     json
+// @Param       request body request.ChangeJobApplicationStatus true "New status"
+// @Security    BearerAuth
+// @Success     200 {object} entity.JobApplication
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     409 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /career/change-application-status [put]
+func (r *V1) changeJobApplicationStatus(ctx *fiber.Ctx) error {
+    var body request.ChangeJobApplicationStatus
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - changeJobApplicationStatus")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - changeJobApplicationStatus")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    accountID, _ := middleware.AccountID(ctx)
+
+    application, err := r.p.ChangeJobApplicationStatus(ctx.UserContext(), accountID, body.ID,
+        entity.JobApplicationStatus(body.Status), body.Comment)
+    if err != nil {
+        r.l.Error(err, "http - v1 - changeJobApplicationStatus")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusOK).JSON(application)
+}
+
+// @Summary     Application History
+// @Description Get status changes of a job application, own applications or any with career:manage
+// @ID          getJobApplicationHistory
+// @Tags  	    career
+// @Produce     json
+// @Param       id query int true "Job application ID"
+// @Security    BearerAuth
+// @Success     200 {array}  entity.JobApplicationTransition
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /career/application-history [get]
+func (r *V1) getJobApplicationHistory(ctx *fiber.Ctx) error {
+    var query request.JobApplicationHistory
+
+    if err := ctx.QueryParser(&query); err != nil {
+        r.l.Error(err, "http - v1 - getJobApplicationHistory")
+
+        return invalidRequestResponse(ctx, "invalid query parameters")
+    }
+
+    if err := r.v.Struct(query); err != nil {
+        r.l.Error(err, "http - v1 - getJobApplicationHistory")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    accountID, _ := middleware.AccountID(ctx)
+
+    history, err := r.p.GetJobApplicationHistory(ctx.UserContext(), accountID, query.ID)
+    if err != nil {
+        r.l.Error(err, "http - v1 - getJobApplicationHistory")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusOK).JSON(history)
+}
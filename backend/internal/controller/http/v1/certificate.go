@@ -0,0 +1,138 @@
+package v1
+
+import (
+    "fmt"
+    "net/http"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/controller/http/middleware"
+    "github.com/deadnotxaa/education-platform/backend/internal/controller/http/v1/request"
+    "github.com/gofiber/fiber/v2"
+)
+
+// @Summary     Issue Certificate
+// @Description Manually mark a purchased course as completed by the student and issue the certificate (certificates are issued automatically at 100% progress), returns the existing one if already issued
+// @ID          issueCertificate
+// @Tags  	    certificates
+// @Accept      json
+// @Produce     json
+// @Param       request body request.IssueCertificate true "Student and course"
+// @Security    BearerAuth
+// @Success     201 {object} entity.Certificate
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     409 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /certificates/issue-certificate [post]
+func (r *V1) issueCertificate(ctx *fiber.Ctx) error {
+    var body request.IssueCertificate
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - issueCertificate")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - issueCertificate")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    certificate, err := r.p.IssueCertificate(ctx.UserContext(), body.UserID, body.CourseID)
+    if err != nil {
+        r.l.Error(err, "http - v1 - issueCertificate")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusCreated).JSON(certificate)
+}
+
+// @Summary     My Certificates
+// @Description Get certificates of the current account
+// @ID          listMyCertificates
+// @Tags  	    certificates
+// @Produce     json
+// @Security    BearerAuth
+// @Success     200 {array}  entity.Certificate
+// @Failure     401 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /certificates/my-certificates [get]
+func (r *V1) listMyCertificates(ctx *fiber.Ctx) error {
+    accountID, _ := middleware.AccountID(ctx)
+
+    certificates, err := r.p.ListUserCertificates(ctx.UserContext(), accountID)
+    if err != nil {
+        r.l.Error(err, "http - v1 - listMyCertificates")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusOK).JSON(certificates)
+}
+
+// @Summary     Download Certificate
+// @Description Download own certificate (or any certificate with user:read) as PDF
+// @ID          downloadCertificate
+// @Tags  	    certificates
+// @Produce     application/pdf
+// @Param       id query int true "Certificate ID"
+// @Security    BearerAuth
+// @Success     200 {file}   binary
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /certificates/download-certificate [get]
+func (r *V1) downloadCertificate(ctx *fiber.Ctx) error {
+    var query request.DownloadCertificate
+
+    if err := ctx.QueryParser(&query); err != nil {
+        r.l.Error(err, "http - v1 - downloadCertificate")
+
+        return invalidRequestResponse(ctx, "invalid query parameters")
+    }
+
+    if err := r.v.Struct(query); err != nil {
+        r.l.Error(err, "http - v1 - downloadCertificate")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    accountID, _ := middleware.AccountID(ctx)
+
+    document, err := r.p.GetCertificatePDF(ctx.UserContext(), accountID, query.ID)
+    if err != nil {
+        r.l.Error(err, "http - v1 - downloadCertificate")
+
+        return errorResponse(ctx, err)
+    }
+
+    ctx.Set(fiber.HeaderContentType, "application/pdf")
+    ctx.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="certificate-%d.pdf"`, query.ID))
+
+    return ctx.Status(http.StatusOK).Send(document)
+}
+
+// @Summary     Verify Certificate
+// @Description Confirm that a certificate was issued by the platform, personal data of the student is not exposed
+// @ID          verifyCertificate
+// @Tags  	    certificates
+// @Produce     json
+// @Param       code path string true "Verification code printed on the certificate"
+// @Success     200 {object} entity.CertificateVerification
+// @Failure     404 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /certificates/verify/{code} [get]
+func (r *V1) verifyCertificate(ctx *fiber.Ctx) error {
+    verification, err := r.p.VerifyCertificate(ctx.UserContext(), ctx.Params("code"))
+    if err != nil {
+        r.l.Error(err, "http - v1 - verifyCertificate")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusOK).JSON(verification)
+}
@@ -4,6 +4,7 @@ import (
     "net/http"
 
     "github.com/deadnotxaa/education-platform/backend/internal/controller/http/v1/request"
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
     "github.com/gofiber/fiber/v2"
 )
 
@@ -14,7 +15,8 @@ import (
 // @Accept      json
 // @Produce     json
 // @Success     200 {object} entity.Course
-// @Failure     400 {object} response.Error
+// @Failure     400 {object} response.Problem
+// @Failure     404 {object} response.Problem
 // @Router      /course/getcourse [get]
 func (r *V1) getCourse(ctx *fiber.Ctx) error {
     var body request.Course
@@ -22,21 +24,154 @@ func (r *V1) getCourse(ctx *fiber.Ctx) error {
     if err := ctx.BodyParser(&body); err != nil {
         r.l.Error(err, "http - v1 - getCourse")
 
-        return errorResponse(ctx, http.StatusBadRequest, "invalid request body")
+        return invalidRequestResponse(ctx, "invalid request body")
     }
 
     if err := r.v.Struct(body); err != nil {
         r.l.Error(err, "http - v1 - getCourse")
 
-        return errorResponse(ctx, http.StatusBadRequest, "invalid request body")
+        return validationErrorResponse(ctx, err)
     }
 
     course, err := r.p.GetCourseById(ctx.UserContext(), body.ID)
     if err != nil {
         r.l.Error(err, "http - v1 - getCourse")
 
-        return errorResponse(ctx, http.StatusInternalServerError, "database problems")
+        return errorResponse(ctx, err)
     }
 
     return ctx.Status(http.StatusOK).JSON(course)
 }
+
+// @Summary     Create Course
+// @Description Add a new course to the catalog
+// @ID          createCourse
+// @Tags  	    course
+// @Accept      json
+// @Produce     json
+// @Param       request body request.CreateCourse true "Course to create"
+// @Security    BearerAuth
+// @Success     201 {object} entity.Course
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /course/create-course [post]
+func (r *V1) createCourse(ctx *fiber.Ctx) error {
+    var body request.CreateCourse
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - createCourse")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - createCourse")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    course, err := r.p.CreateCourse(ctx.UserContext(), entity.Course{
+        Name:              body.Name,
+        Description:       body.Description,
+        SpecializationID:  body.SpecializationID,
+        Duration:          body.Duration,
+        Price:             body.Price,
+        DifficultyLevelID: body.DifficultyLevelID,
+    })
+    if err != nil {
+        r.l.Error(err, "http - v1 - createCourse")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusCreated).JSON(course)
+}
+
+// @Summary     Update Course
+// @Description Replace editable fields of an existing course
+// @ID          updateCourse
+// @Tags  	    course
+// @Accept      json
+// @Produce     json
+// @Param       request body request.UpdateCourse true "Course to update"
+// @Security    BearerAuth
+// @Success     200 {object} entity.Course
+// @Failure     400 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /course/update-course [put]
+func (r *V1) updateCourse(ctx *fiber.Ctx) error {
+    var body request.UpdateCourse
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - updateCourse")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - updateCourse")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    course, err := r.p.UpdateCourse(ctx.UserContext(), entity.Course{
+        CourseID:          body.ID,
+        Name:              body.Name,
+        Description:       body.Description,
+        SpecializationID:  body.SpecializationID,
+        Duration:          body.Duration,
+        Price:             body.Price,
+        DifficultyLevelID: body.DifficultyLevelID,
+    })
+    if err != nil {
+        r.l.Error(err, "http - v1 - updateCourse")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusOK).JSON(course)
+}
+
+// @Summary     Delete Course
+// @Description Soft-delete a course by ID
+// @ID          deleteCourse
+// @Tags  	    course
+// @Accept      json
+// @Produce     json
+// @Param       request body request.Course true "Course to delete"
+// @Security    BearerAuth
+// @Success     204
+// @Failure     400 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /course/delete-course [delete]
+func (r *V1) deleteCourse(ctx *fiber.Ctx) error {
+    var body request.Course
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - deleteCourse")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - deleteCourse")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    if err := r.p.DeleteCourse(ctx.UserContext(), body.ID); err != nil {
+        r.l.Error(err, "http - v1 - deleteCourse")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.SendStatus(http.StatusNoContent)
+}
@@ -0,0 +1,64 @@
+package v1
+
+import (
+    "net/http"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/controller/http/v1/request"
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+    "github.com/gofiber/fiber/v2"
+)
+
+// @Summary     List Courses
+// @Description Get a filtered, sorted page of the course catalog using cursor pagination
+// @ID          listCourses
+// @Tags  	    course
+// @Produce     json
+// @Param       specialization_id   query int    false "Specialization ID"
+// @Param       difficulty_level_id query int    false "Difficulty level ID"
+// @Param       min_price           query int    false "Minimal price"
+// @Param       max_price           query int    false "Maximal price"
+// @Param       min_duration        query int    false "Minimal duration"
+// @Param       max_duration        query int    false "Maximal duration"
+// @Param       sort_by             query string false "Sort field" Enums(id, price, rating, created_at)
+// @Param       order               query string false "Sort order" Enums(asc, desc)
+// @Param       cursor              query string false "next_cursor from the previous page"
+// @Param       limit               query int    false "Page size, 20 by default"
+// @Success     200 {object} entity.CoursePage
+// @Failure     400 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /courses [get]
+func (r *V1) listCourses(ctx *fiber.Ctx) error {
+    var query request.ListCourses
+
+    if err := ctx.QueryParser(&query); err != nil {
+        r.l.Error(err, "http - v1 - listCourses")
+
+        return invalidRequestResponse(ctx, "invalid query parameters")
+    }
+
+    if err := r.v.Struct(query); err != nil {
+        r.l.Error(err, "http - v1 - listCourses")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    page, err := r.p.ListCourses(ctx.UserContext(), entity.CourseFilter{
+        SpecializationID:  query.SpecializationID,
+        DifficultyLevelID: query.DifficultyLevelID,
+        MinPrice:          query.MinPrice,
+        MaxPrice:          query.MaxPrice,
+        MinDuration:       query.MinDuration,
+        MaxDuration:       query.MaxDuration,
+        SortBy:            entity.CourseSortField(query.SortBy),
+        Descending:        query.Order == "desc",
+        Cursor:            query.Cursor,
+        Limit:             query.Limit,
+    })
+    if err != nil {
+        r.l.Error(err, "http - v1 - listCourses")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusOK).JSON(page)
+}
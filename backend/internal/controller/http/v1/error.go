@@ -1,10 +1,22 @@
 package v1
 
 import (
-    "github.com/deadnotxaa/education-platform/backend/internal/controller/http/v1/response"
+    "github.com/deadnotxaa/education-platform/backend/internal/controller/http/problem"
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
     "github.com/gofiber/fiber/v2"
 )
 
-func errorResponse(ctx *fiber.Ctx, code int, msg string) error {
-    return ctx.Status(code).JSON(response.Error{Error: msg})
-}
\ No newline at end of file
+// errorResponse reports a use case error, domain errors keep their code and other errors become internal_error.
+func errorResponse(ctx *fiber.Ctx, err error) error {
+    return problem.Write(ctx, err)
+}
+
+// invalidRequestResponse reports a request that can't be parsed.
+func invalidRequestResponse(ctx *fiber.Ctx, detail string) error {
+    return problem.WriteDetail(ctx, entity.ErrInvalidRequest, detail)
+}
+
+// validationErrorResponse reports the request fields rejected by the validator.
+func validationErrorResponse(ctx *fiber.Ctx, err error) error {
+    return problem.Validation(ctx, err)
+}
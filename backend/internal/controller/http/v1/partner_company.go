@@ -0,0 +1,256 @@
+package v1
+
+import (
+    "net/http"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/controller/http/v1/request"
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+    "github.com/gofiber/fiber/v2"
+)
+
+// @Summary     Partner Companies
+// @Description Get a page of partner companies
+// @ID          listPartnerCompanies
+// @Tags  	    career
+// @Produce     json
+// @Param       after_id query int false "next_after_id from the previous page"
+// @Param       limit    query int false "Page size, 20 by default"
+// @Security    BearerAuth
+// @Success     200 {object} entity.PartnerCompanyPage
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /career/companies [get]
+func (r *V1) listPartnerCompanies(ctx *fiber.Ctx) error {
+    var query request.ListPartnerCompanies
+
+    if err := ctx.QueryParser(&query); err != nil {
+        r.l.Error(err, "http - v1 - listPartnerCompanies")
+
+        return invalidRequestResponse(ctx, "invalid query parameters")
+    }
+
+    if err := r.v.Struct(query); err != nil {
+        r.l.Error(err, "http - v1 - listPartnerCompanies")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    page, err := r.p.ListPartnerCompanies(ctx.UserContext(), query.AfterID, query.Limit)
+    if err != nil {
+        r.l.Error(err, "http - v1 - listPartnerCompanies")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusOK).JSON(page)
+}
+
+// @Summary     Get Partner Company
+// @Description Get a partner company by ID
+// @ID          getPartnerCompany
+// @Tags  	    career
+// @Produce     json
+// @Param       id query int true "Company ID"
+// @Security    BearerAuth
+// @Success     200 {object} entity.PartnerCompany
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /career/get-company [get]
+func (r *V1) getPartnerCompany(ctx *fiber.Ctx) error {
+    var query request.GetPartnerCompany
+
+    if err := ctx.QueryParser(&query); err != nil {
+        r.l.Error(err, "http - v1 - getPartnerCompany")
+
+        return invalidRequestResponse(ctx, "invalid query parameters")
+    }
+
+    if err := r.v.Struct(query); err != nil {
+        r.l.Error(err, "http - v1 - getPartnerCompany")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    company, err := r.p.GetPartnerCompany(ctx.UserContext(), query.ID)
+    if err != nil {
+        r.l.Error(err, "http - v1 - getPartnerCompany")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusOK).JSON(company)
+}
+
+// @Summary     Create Partner Company
+// @Description Add a partner company, its hired graduates are counted by the job application pipeline
+// @ID          createPartnerCompany
+// @Tags  	    career
+// @Accept      json
+// @Produce     json
+// @Param       request body request.PartnerCompany true "Company to create"
+// @Security    BearerAuth
+// @Success     201 {object} entity.PartnerCompany
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /career/create-company [post]
+func (r *V1) createPartnerCompany(ctx *fiber.Ctx) error {
+    var body request.PartnerCompany
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - createPartnerCompany")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - createPartnerCompany")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    company, err := r.p.CreatePartnerCompany(ctx.UserContext(), entity.PartnerCompany{
+        ShortName:       body.ShortName,
+        FullName:        body.FullName,
+        Requirements:    body.Requirements,
+        AgreementStatus: body.AgreementStatus,
+    })
+    if err != nil {
+        r.l.Error(err, "http - v1 - createPartnerCompany")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusCreated).JSON(company)
+}
+
+// @Summary     Update Partner Company
+// @Description Edit a partner company, deactivating the agreement stops new job applications
+// @ID          updatePartnerCompany
+// @Tags  	    career
+// @Accept      json
+// @Produce     json
+// @Param       request body request.UpdatePartnerCompany true "Company"
+// @Security    BearerAuth
+// @Success     200 {object} entity.PartnerCompany
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /career/update-company [put]
+func (r *V1) updatePartnerCompany(ctx *fiber.Ctx) error {
+    var body request.UpdatePartnerCompany
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - updatePartnerCompany")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - updatePartnerCompany")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    company, err := r.p.UpdatePartnerCompany(ctx.UserContext(), entity.PartnerCompany{
+        CompanyID:       body.ID,
+        ShortName:       body.ShortName,
+        FullName:        body.FullName,
+        Requirements:    body.Requirements,
+        AgreementStatus: body.AgreementStatus,
+    })
+    if err != nil {
+        r.l.Error(err, "http - v1 - updatePartnerCompany")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusOK).JSON(company)
+}
+
+// @Summary     Delete Partner Company
+// @Description Delete a partner company without job applications
+// @ID          deletePartnerCompany
+// @Tags  	    career
+// @Accept      json
+// @Produce     json
+// @Param       request body request.DeletePartnerCompany true "Company"
+// @Security    BearerAuth
+// @Success     204
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     409 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /career/delete-company [delete]
+func (r *V1) deletePartnerCompany(ctx *fiber.Ctx) error {
+    var body request.DeletePartnerCompany
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - deletePartnerCompany")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - deletePartnerCompany")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    if err := r.p.DeletePartnerCompany(ctx.UserContext(), body.ID); err != nil {
+        r.l.Error(err, "http - v1 - deletePartnerCompany")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.SendStatus(http.StatusNoContent)
+}
+
+// @Summary     Match Students
+// @Description Rank students with active career support by the share of company requirement keywords
+// @Description found in the technologies of the course topics they have completed, every score is explained
+// @ID          matchStudents
+// @Tags  	    career
+// @Produce     json
+// @Param       company_id query int true  "Company ID"
+// @Param       limit      query int false "Number of students, 20 by default"
+// @Security    BearerAuth
+// @Success     200 {object} entity.CompanyMatches
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /career/match-students [get]
+func (r *V1) matchStudents(ctx *fiber.Ctx) error {
+    var query request.MatchStudents
+
+    if err := ctx.QueryParser(&query); err != nil {
+        r.l.Error(err, "http - v1 - matchStudents")
+
+        return invalidRequestResponse(ctx, "invalid query parameters")
+    }
+
+    if err := r.v.Struct(query); err != nil {
+        r.l.Error(err, "http - v1 - matchStudents")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    matches, err := r.p.MatchStudents(ctx.UserContext(), query.CompanyID, query.Limit)
+    if err != nil {
+        r.l.Error(err, "http - v1 - matchStudents")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusOK).JSON(matches)
+}
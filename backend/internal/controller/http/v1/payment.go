@@ -0,0 +1,164 @@
+package v1
+
+import (
+    "net/http"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/controller/http/middleware"
+    "github.com/deadnotxaa/education-platform/backend/internal/controller/http/v1/request"
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+    "github.com/gofiber/fiber/v2"
+)
+
+// @Summary     Create Payment
+// @Description Create a payment intent at the provider for own pending purchase, once per purchase
+// @ID          createPayment
+// @Tags  	    payments
+// @Accept      json
+// @Produce     json
+// @Param       request body request.Payment true "Purchase to pay"
+// @Security    BearerAuth
+// @Success     201 {object} entity.PaymentIntent
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     409 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /payments/create-payment [post]
+func (r *V1) createPayment(ctx *fiber.Ctx) error {
+    var body request.Payment
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - createPayment")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - createPayment")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    accountID, _ := middleware.AccountID(ctx)
+
+    intent, err := r.p.CreatePayment(ctx.UserContext(), accountID, body.PurchaseID)
+    if err != nil {
+        r.l.Error(err, "http - v1 - createPayment")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusCreated).JSON(intent)
+}
+
+// @Summary     Confirm Payment
+// @Description Capture the payment of own pending purchase, the purchase is completed by the provider's webhook
+// @ID          confirmPayment
+// @Tags  	    payments
+// @Accept      json
+// @Produce     json
+// @Param       request body request.Payment true "Purchase to pay"
+// @Security    BearerAuth
+// @Success     202
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     409 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /payments/confirm-payment [post]
+func (r *V1) confirmPayment(ctx *fiber.Ctx) error {
+    var body request.Payment
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - confirmPayment")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - confirmPayment")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    accountID, _ := middleware.AccountID(ctx)
+
+    if err := r.p.ConfirmPayment(ctx.UserContext(), accountID, body.PurchaseID); err != nil {
+        r.l.Error(err, "http - v1 - confirmPayment")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.SendStatus(http.StatusAccepted)
+}
+
+// @Summary     Refund Payment
+// @Description Refund own completed purchase (or any purchase with purchase:manage), the purchase is cancelled by the provider's webhook
+// @ID          refundPayment
+// @Tags  	    payments
+// @Accept      json
+// @Produce     json
+// @Param       request body request.Payment true "Purchase to refund"
+// @Security    BearerAuth
+// @Success     202
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     409 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /payments/refund-payment [post]
+func (r *V1) refundPayment(ctx *fiber.Ctx) error {
+    var body request.Payment
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - refundPayment")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - refundPayment")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    accountID, _ := middleware.AccountID(ctx)
+
+    if err := r.p.RefundPurchase(ctx.UserContext(), accountID, body.PurchaseID); err != nil {
+        r.l.Error(err, "http - v1 - refundPayment")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.SendStatus(http.StatusAccepted)
+}
+
+// @Summary     Payment Webhook
+// @Description Receive a signed notification of the payment provider, redelivered events are ignored
+// @ID          paymentWebhook
+// @Tags  	    payments
+// @Accept      json
+// @Produce     json
+// @Param       X-Payment-Signature header string true "sha256=<hex HMAC of the body>"
+// @Param       request body entity.PaymentEvent true "Payment event"
+// @Success     204
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     409 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /payments/webhook [post]
+func (r *V1) paymentWebhook(ctx *fiber.Ctx) error {
+    // Signature is computed over the raw body, so it must not be parsed before verification
+    err := r.p.HandlePaymentWebhook(ctx.UserContext(), ctx.Body(), ctx.Get(entity.PaymentSignatureHeader))
+    if err != nil {
+        r.l.Error(err, "http - v1 - paymentWebhook")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.SendStatus(http.StatusNoContent)
+}
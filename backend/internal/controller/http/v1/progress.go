@@ -0,0 +1,230 @@
+package v1
+
+import (
+    "net/http"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/controller/http/middleware"
+    "github.com/deadnotxaa/education-platform/backend/internal/controller/http/v1/request"
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+    "github.com/gofiber/fiber/v2"
+)
+
+// @Summary     Get User Progress
+// @Description Get progress through every purchased course with the completion percentage,
+// @Description users may read their own progress, reading others requires user:read
+// @ID          getUserProgress
+// @Tags  	    progress
+// @Produce     json
+// @Param       id path int true "User ID"
+// @Security    BearerAuth
+// @Success     200 {object} entity.UserProgress
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /user/{id}/progress [get]
+func (r *V1) getUserProgress(ctx *fiber.Ctx) error {
+    var params request.UserProgress
+
+    if err := ctx.ParamsParser(&params); err != nil {
+        r.l.Error(err, "http - v1 - getUserProgress")
+
+        return invalidRequestResponse(ctx, "invalid user id")
+    }
+
+    if err := r.v.Struct(params); err != nil {
+        r.l.Error(err, "http - v1 - getUserProgress")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    accountID, _ := middleware.AccountID(ctx)
+
+    progress, err := r.p.GetUserProgress(ctx.UserContext(), accountID, params.ID)
+    if err != nil {
+        r.l.Error(err, "http - v1 - getUserProgress")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusOK).JSON(progress)
+}
+
+// @Summary     Update Topic Progress
+// @Description Mark a topic of a purchased course as started or completed
+// @ID          updateTopicProgress
+// @Tags  	    progress
+// @Accept      json
+// @Produce     json
+// @Param       request body request.UpdateTopicProgress true "Topic progress"
+// @Security    BearerAuth
+// @Success     204
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /progress/update-topic [post]
+func (r *V1) updateTopicProgress(ctx *fiber.Ctx) error {
+    var body request.UpdateTopicProgress
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - updateTopicProgress")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - updateTopicProgress")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    accountID, _ := middleware.AccountID(ctx)
+
+    err := r.p.UpdateTopicProgress(ctx.UserContext(), accountID, body.CourseID, body.TopicID,
+        entity.TopicStatus(body.Status))
+    if err != nil {
+        r.l.Error(err, "http - v1 - updateTopicProgress")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.SendStatus(http.StatusNoContent)
+}
+
+// @Summary     Submit Project
+// @Description Submit a solution of a project of a purchased course for review, a rejected project may be resubmitted
+// @ID          submitProject
+// @Tags  	    progress
+// @Accept      json
+// @Produce     json
+// @Param       request body request.SubmitProject true "Project solution"
+// @Security    BearerAuth
+// @Success     201 {object} entity.ProjectSubmission
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     409 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /progress/submit-project [post]
+func (r *V1) submitProject(ctx *fiber.Ctx) error {
+    var body request.SubmitProject
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - submitProject")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - submitProject")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    accountID, _ := middleware.AccountID(ctx)
+
+    submission, err := r.p.SubmitProject(ctx.UserContext(), entity.ProjectSubmission{
+        UserID:      accountID,
+        CourseID:    body.CourseID,
+        ProjectID:   body.ProjectID,
+        SolutionURL: body.SolutionURL,
+    })
+    if err != nil {
+        r.l.Error(err, "http - v1 - submitProject")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusCreated).JSON(submission)
+}
+
+// @Summary     Review Queue
+// @Description Get project submissions waiting for review, oldest first
+// @ID          listPendingSubmissions
+// @Tags  	    progress
+// @Produce     json
+// @Param       cursor query string false "next_cursor from the previous page"
+// @Param       limit  query int    false "Page size, 20 by default"
+// @Security    BearerAuth
+// @Success     200 {object} entity.SubmissionPage
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /progress/review-queue [get]
+func (r *V1) listPendingSubmissions(ctx *fiber.Ctx) error {
+    var query request.ListPendingSubmissions
+
+    if err := ctx.QueryParser(&query); err != nil {
+        r.l.Error(err, "http - v1 - listPendingSubmissions")
+
+        return invalidRequestResponse(ctx, "invalid query parameters")
+    }
+
+    if err := r.v.Struct(query); err != nil {
+        r.l.Error(err, "http - v1 - listPendingSubmissions")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    page, err := r.p.ListPendingSubmissions(ctx.UserContext(), query.Cursor, query.Limit)
+    if err != nil {
+        r.l.Error(err, "http - v1 - listPendingSubmissions")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusOK).JSON(page)
+}
+
+// @Summary     Grade Project
+// @Description Accept or reject a submitted project with a grade from 0 to 100
+// @ID          gradeSubmission
+// @Tags  	    progress
+// @Accept      json
+// @Produce     json
+// @Param       request body request.GradeSubmission true "Grade"
+// @Security    BearerAuth
+// @Success     200 {object} entity.ProjectSubmission
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     409 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /progress/grade-project [post]
+func (r *V1) gradeSubmission(ctx *fiber.Ctx) error {
+    var body request.GradeSubmission
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - gradeSubmission")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - gradeSubmission")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    accountID, _ := middleware.AccountID(ctx)
+
+    submission, err := r.p.GradeSubmission(ctx.UserContext(), accountID, entity.ProjectSubmission{
+        SubmissionID:  body.ID,
+        Status:        entity.SubmissionStatus(body.Status),
+        Grade:         &body.Grade,
+        ReviewComment: body.Comment,
+    })
+    if err != nil {
+        r.l.Error(err, "http - v1 - gradeSubmission")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusOK).JSON(submission)
+}
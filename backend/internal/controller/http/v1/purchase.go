@@ -0,0 +1,135 @@
+package v1
+
+import (
+    "net/http"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/controller/http/middleware"
+    "github.com/deadnotxaa/education-platform/backend/internal/controller/http/v1/request"
+    "github.com/gofiber/fiber/v2"
+)
+
+// @Summary     Purchase Course
+// @Description Reserve a seat in a course cohort and create a pending purchase with the course type discount
+// @ID          purchaseCourse
+// @Tags  	    purchase
+// @Accept      json
+// @Produce     json
+// @Param       request body request.PurchaseCourse true "Cohort and discount"
+// @Security    BearerAuth
+// @Success     201 {object} entity.Purchase
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     409 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /purchase/purchase-course [post]
+func (r *V1) purchaseCourse(ctx *fiber.Ctx) error {
+    var body request.PurchaseCourse
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - purchaseCourse")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - purchaseCourse")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    accountID, _ := middleware.AccountID(ctx)
+
+    purchase, err := r.p.PurchaseCourse(ctx.UserContext(), accountID, body.CalendarID, body.CourseTypeID)
+    if err != nil {
+        r.l.Error(err, "http - v1 - purchaseCourse")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusCreated).JSON(purchase)
+}
+
+// @Summary     Complete Purchase
+// @Description Mark a pending purchase as completed
+// @ID          completePurchase
+// @Tags  	    purchase
+// @Accept      json
+// @Produce     json
+// @Param       request body request.Purchase true "Purchase to complete"
+// @Security    BearerAuth
+// @Success     200 {object} entity.Purchase
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     409 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /purchase/complete-purchase [post]
+func (r *V1) completePurchase(ctx *fiber.Ctx) error {
+    var body request.Purchase
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - completePurchase")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - completePurchase")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    purchase, err := r.p.CompletePurchase(ctx.UserContext(), body.ID)
+    if err != nil {
+        r.l.Error(err, "http - v1 - completePurchase")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusOK).JSON(purchase)
+}
+
+// @Summary     Cancel Purchase
+// @Description Cancel own purchase (or any purchase with purchase:manage) and release the reserved seat, paid purchases are cancelled by refunding the payment, other completed ones only with purchase:manage
+// @ID          cancelPurchase
+// @Tags  	    purchase
+// @Accept      json
+// @Produce     json
+// @Param       request body request.Purchase true "Purchase to cancel"
+// @Security    BearerAuth
+// @Success     200 {object} entity.Purchase
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     409 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /purchase/cancel-purchase [post]
+func (r *V1) cancelPurchase(ctx *fiber.Ctx) error {
+    var body request.Purchase
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - cancelPurchase")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - cancelPurchase")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    accountID, _ := middleware.AccountID(ctx)
+
+    purchase, err := r.p.CancelPurchase(ctx.UserContext(), accountID, body.ID)
+    if err != nil {
+        r.l.Error(err, "http - v1 - cancelPurchase")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusOK).JSON(purchase)
+}
@@ -2,8 +2,10 @@ package v1
 
 import (
     "net/http"
+    "time"
 
     "github.com/deadnotxaa/education-platform/backend/internal/controller/http/v1/request"
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
     "github.com/gofiber/fiber/v2"
 )
 
@@ -13,8 +15,11 @@ import (
 // @Tags  	    report
 // @Accept      json
 // @Produce     json
+// @Security    BearerAuth
 // @Success     200 {object} entity.TopCoursesReport
-// @Failure     400 {object} response.Error
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
 // @Router      /report/get-top-courses-report [get]
 func (r *V1) getTopCoursesReport(ctx *fiber.Ctx) error {
     var body request.TopCoursesReport
@@ -22,20 +27,76 @@ func (r *V1) getTopCoursesReport(ctx *fiber.Ctx) error {
     if err := ctx.BodyParser(&body); err != nil {
         r.l.Error(err, "http - v1 - getTopCoursesReport")
 
-        return errorResponse(ctx, http.StatusBadRequest, "invalid request body")
+        return invalidRequestResponse(ctx, "invalid request body")
     }
 
     if err := r.v.Struct(body); err != nil {
         r.l.Error(err, "http - v1 - getTopCoursesReport")
 
-        return errorResponse(ctx, http.StatusBadRequest, "invalid request body")
+        return validationErrorResponse(ctx, err)
     }
 
     report, err := r.p.GetTopCoursesReport(ctx.UserContext(), body.LimitNumber)
     if err != nil {
         r.l.Error(err, "http - v1 - getTopCoursesReport")
 
-        return errorResponse(ctx, http.StatusInternalServerError, "database problems")
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusOK).JSON(report)
+}
+
+// @Summary     Get DetailedPurchaseReport
+// @Description Get the latest purchases with buyer, course, specialization, course type and teachers' work places
+// @ID          getDetailedPurchaseReport
+// @Tags  	    report
+// @Accept      json
+// @Produce     json
+// @Param       request body request.DetailedPurchaseReport true "Limit, purchase date range and specialization"
+// @Security    BearerAuth
+// @Success     200 {object} entity.DetailedPurchaseReport
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /report/get-detailed-purchase-report [get]
+func (r *V1) getDetailedPurchaseReport(ctx *fiber.Ctx) error {
+    var body request.DetailedPurchaseReport
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - getDetailedPurchaseReport")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - getDetailedPurchaseReport")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    filter := entity.DetailedPurchaseReportFilter{
+        SpecializationID: body.SpecializationID,
+        Limit:            body.LimitNumber,
+    }
+
+    // Formats are already checked by the validator
+    if body.DateFrom != "" {
+        filter.From, _ = time.Parse(time.DateOnly, body.DateFrom)
+    }
+    if body.DateTo != "" {
+        filter.To, _ = time.Parse(time.DateOnly, body.DateTo)
+    }
+
+    if !filter.From.IsZero() && !filter.To.IsZero() && filter.From.After(filter.To) {
+        return invalidRequestResponse(ctx, "date_from is after date_to")
+    }
+
+    report, err := r.p.GetDetailedPurchaseReport(ctx.UserContext(), filter)
+    if err != nil {
+        r.l.Error(err, "http - v1 - getDetailedPurchaseReport")
+
+        return errorResponse(ctx, err)
     }
 
     return ctx.Status(http.StatusOK).JSON(report)
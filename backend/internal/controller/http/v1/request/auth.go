@@ -0,0 +1,21 @@
+package request
+
+type (
+    Register struct {
+        Name        string `json:"name"         validate:"required,max=255"         example:"John"`
+        Surname     string `json:"surname"      validate:"required,max=255"         example:"Doe"`
+        Email       string `json:"email"        validate:"required,email,max=255"   example:"mail@example.com"`
+        Password    string `json:"password"     validate:"required,min=8,max=72"    example:"correct-horse-battery"`
+        BirthDate   string `json:"birth_date"   validate:"omitempty,datetime=2006-01-02" example:"2000-01-01"`
+        PhoneNumber string `json:"phone_number" validate:"omitempty,e164"           example:"+1234567890"`
+    }
+
+    Login struct {
+        Email    string `json:"email"    validate:"required,email"  example:"mail@example.com"`
+        Password string `json:"password" validate:"required"        example:"correct-horse-battery"`
+    }
+
+    RefreshToken struct {
+        RefreshToken string `json:"refresh_token" validate:"required" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
+    }
+)
@@ -0,0 +1,40 @@
+package request
+
+type (
+    CreateBlogPost struct {
+        Title         string   `json:"title"           validate:"required,max=255"             example:"Understanding Go Generics"`
+        Topic         string   `json:"topic"           validate:"max=255"                      example:"Go Programming"`
+        CoverImageUrl string   `json:"cover_image_url" validate:"omitempty,http_url,max=255"   example:"https://example.com/cover.jpg"`
+        Content       string   `json:"content"         validate:"required"                     example:"This blog post explains the concept of ..."`
+        Tags          []string `json:"tags"            validate:"max=20,dive,required,max=100" example:"go,generics"`
+    }
+
+    UpdateBlogPost struct {
+        ID            int      `json:"id"              validate:"required,gt=0"                example:"1"`
+        Title         string   `json:"title"           validate:"required,max=255"             example:"Understanding Go Generics"`
+        Topic         string   `json:"topic"           validate:"max=255"                      example:"Go Programming"`
+        CoverImageUrl string   `json:"cover_image_url" validate:"omitempty,http_url,max=255"   example:"https://example.com/cover.jpg"`
+        Content       string   `json:"content"         validate:"required"                     example:"This blog post explains the concept of ..."`
+        Tags          []string `json:"tags"            validate:"max=20,dive,required,max=100" example:"go,generics"`
+    }
+
+    ScheduleBlogPost struct {
+        ID              int    `json:"id"               validate:"required,gt=0"                               example:"1"`
+        PublicationDate string `json:"publication_date" validate:"required,datetime=2006-01-02T15:04:05Z07:00" example:"2030-01-02T10:00:00Z"`
+    }
+
+    PublishBlogPost struct {
+        ID int `json:"id" validate:"required,gt=0" example:"1"`
+    }
+)
+
+type ListBlogPosts struct {
+    Tag    string `query:"tag"    validate:"max=100" example:"go"`
+    Topic  string `query:"topic"  validate:"max=255" example:"Go Programming"`
+    Cursor string `query:"cursor"                    example:"eyJ0IjoiMjAyMy0wMS0yMFQxMjowMDowMFoiLCJpZCI6NDJ9"`
+    Limit  uint32 `query:"limit"  validate:"lte=100" example:"20"`
+}
+
+type GetBlogPost struct {
+    ID int `query:"id" validate:"required,gt=0" example:"1"`
+}
@@ -0,0 +1,27 @@
+package request
+
+type (
+    CreateCourseCalendar struct {
+        CourseID int `json:"course_id" validate:"required,gt=0" example:"1"`
+        CourseCalendar
+    }
+
+    CourseCalendar struct {
+        StartDate       string `json:"start_date"       validate:"required,datetime=2006-01-02" example:"2023-02-01"`
+        EndSalesDate    string `json:"end_sales_date"   validate:"required,datetime=2006-01-02" example:"2023-01-30"`
+        RemainingPlaces int    `json:"remaining_places" validate:"gte=0"                        example:"20"`
+    }
+
+    UpdateCourseCalendar struct {
+        ID int `json:"id" validate:"required,gt=0" example:"1"`
+        CourseCalendar
+    }
+
+    Waitlist struct {
+        CalendarID int `json:"course_calendar_id" validate:"required,gt=0" example:"1"`
+    }
+)
+
+type UpcomingCourseCalendars struct {
+    CourseID int `query:"course_id" validate:"required,gt=0" example:"1"`
+}
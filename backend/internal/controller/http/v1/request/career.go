@@ -0,0 +1,42 @@
+package request
+
+type (
+    EnrollCareerStudent struct {
+        UserID             int    `json:"user_id"                   validate:"required,gt=0"                 example:"42"`
+        CourseID           int    `json:"course_id"                 validate:"required,gt=0"                 example:"1"`
+        CareerSupportStart string `json:"career_support_start_date" validate:"omitempty,datetime=2006-01-02" example:"2023-02-01"` // Today by default
+        SupportPeriod      int    `json:"support_period"            validate:"required,gt=0,lte=3650"        example:"180"`        // In days
+        CVUrl              string `json:"cv_url"                    validate:"omitempty,http_url,max=255"    example:"https://example.com/cv.pdf"`
+    }
+
+    UploadCV struct {
+        CourseID int    `json:"course_id" validate:"required,gt=0"              example:"1"`
+        CVUrl    string `json:"cv_url"    validate:"required,http_url,max=255" example:"https://example.com/cv.pdf"`
+    }
+
+    ExtendCareerSupport struct {
+        ID   int `json:"id"   validate:"required,gt=0"                                           example:"1"`
+        Days int `json:"days" validate:"required,gt=0,lte=3650" example:"30"`
+    }
+
+    ApplyForJob struct {
+        CourseID  int `json:"course_id"  validate:"required,gt=0" example:"1"` // Course of the career support enrollment
+        CompanyID int `json:"company_id" validate:"required,gt=0" example:"3"`
+    }
+
+    ChangeJobApplicationStatus struct {
+        ID      int    `json:"id"      validate:"required,gt=0"                                           example:"1"`
+        Status  string `json:"status"  validate:"required,oneof=Screening Interview Offer Hired Rejected" example:"Interview"`
+        Comment string `json:"comment" validate:"max=5000"                                                example:"Interview on Monday"`
+    }
+)
+
+type ExpiringCareerSupport struct {
+    Days    int    `query:"days"     validate:"gte=0,lte=3650" example:"30"`
+    AfterID int    `query:"after_id" validate:"gte=0"          example:"42"`
+    Limit   uint32 `query:"limit"    validate:"lte=100"        example:"20"`
+}
+
+type JobApplicationHistory struct {
+    ID int `query:"id" validate:"required,gt=0"                                           example:"1"`
+}
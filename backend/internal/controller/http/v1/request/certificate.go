@@ -0,0 +1,12 @@
+package request
+
+type (
+    IssueCertificate struct {
+        UserID   int `json:"user_id"   validate:"required,gt=0" example:"42"`
+        CourseID int `json:"course_id" validate:"required,gt=0" example:"1"`
+    }
+)
+
+type DownloadCertificate struct {
+    ID int `query:"id" validate:"required,gt=0" example:"1"`
+}
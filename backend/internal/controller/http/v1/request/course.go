@@ -1,5 +1,34 @@
 package request
 
-type Course struct {
-    ID               int    `json:"id" validate:"required" example:"1"`
+type (
+    Course struct {
+        ID               int    `json:"id" validate:"required" example:"1"`
+    }
+
+    CreateCourse struct {
+        Name              string `json:"name"                validate:"required,max=255" example:"Introduction to Go"`
+        Description       string `json:"description"                                     example:"A beginner's course on Go programming language"`
+        SpecializationID  int    `json:"specialization_id"   validate:"required,gt=0"    example:"1"`
+        Duration          int    `json:"duration"            validate:"gte=0"            example:"30"`
+        Price             int    `json:"price"               validate:"gte=0"            example:"19999"`
+        DifficultyLevelID int    `json:"difficulty_level_id" validate:"required,gt=0"    example:"3"`
+    }
+
+    UpdateCourse struct {
+        ID int `json:"id" validate:"required" example:"1"`
+        CreateCourse
+    }
+)
+
+type ListCourses struct {
+    SpecializationID  int    `query:"specialization_id"   validate:"gte=0"                                      example:"1"`
+    DifficultyLevelID int    `query:"difficulty_level_id" validate:"gte=0"                                      example:"3"`
+    MinPrice          *int   `query:"min_price"           validate:"omitempty,gte=0"                            example:"1000"`
+    MaxPrice          *int   `query:"max_price"           validate:"omitempty,gte=0"                            example:"50000"`
+    MinDuration       *int   `query:"min_duration"        validate:"omitempty,gte=0"                            example:"10"`
+    MaxDuration       *int   `query:"max_duration"        validate:"omitempty,gte=0"                            example:"120"`
+    SortBy            string `query:"sort_by"             validate:"omitempty,oneof=id price rating created_at" example:"price"`
+    Order             string `query:"order"               validate:"omitempty,oneof=asc desc"                   example:"desc"`
+    Cursor            string `query:"cursor"                                                                    example:"eyJzIjoicHJpY2UiLCJ2IjoxOTk5OSwiaWQiOjQyfQ"`
+    Limit             uint32 `query:"limit"               validate:"lte=100"                                    example:"20"`
 }
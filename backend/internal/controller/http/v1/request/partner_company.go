@@ -0,0 +1,33 @@
+package request
+
+type (
+    PartnerCompany struct {
+        ShortName       string `json:"short_name"       validate:"required,max=255" example:"BigTech"`
+        FullName        string `json:"full_name"        validate:"required,max=255" example:"BigTech Company LLC"`
+        Requirements    string `json:"requirements"     validate:"max=5000"         example:"Go, PostgreSQL, Docker, Kubernetes"`
+        AgreementStatus bool   `json:"agreement_status"                             example:"true"`
+    }
+
+    UpdatePartnerCompany struct {
+        ID int `json:"id" validate:"required,gt=0" example:"1"`
+        PartnerCompany
+    }
+
+    DeletePartnerCompany struct {
+        ID int `json:"id" validate:"required,gt=0" example:"1"`
+    }
+)
+
+type GetPartnerCompany struct {
+    ID int `query:"id" validate:"required,gt=0" example:"1"`
+}
+
+type ListPartnerCompanies struct {
+    AfterID int    `query:"after_id" validate:"gte=0"   example:"42"`
+    Limit   uint32 `query:"limit"    validate:"lte=100" example:"20"`
+}
+
+type MatchStudents struct {
+    CompanyID int    `query:"company_id" validate:"required,gt=0" example:"1"`
+    Limit     uint32 `query:"limit"      validate:"lte=100"       example:"20"`
+}
@@ -0,0 +1,31 @@
+package request
+
+type (
+    UpdateTopicProgress struct {
+        CourseID int    `json:"course_id" validate:"required,gt=0"                        example:"1"`
+        TopicID  int    `json:"topic_id"  validate:"required,gt=0"                        example:"3"`
+        Status   string `json:"status"    validate:"required,oneof=InProgress Completed" example:"Completed"`
+    }
+
+    SubmitProject struct {
+        CourseID    int    `json:"course_id"    validate:"required,gt=0"              example:"1"`
+        ProjectID   int    `json:"project_id"   validate:"required,gt=0"              example:"3"`
+        SolutionURL string `json:"solution_url" validate:"required,http_url,max=2048" example:"https://github.com/student/go-basics"`
+    }
+
+    GradeSubmission struct {
+        ID      int    `json:"id"      validate:"required,gt=0"                     example:"1"`
+        Status  string `json:"status"  validate:"required,oneof=Accepted Rejected" example:"Accepted"`
+        Grade   int    `json:"grade"   validate:"min=0,max=100"                     example:"90"`
+        Comment string `json:"comment" validate:"max=5000"                          example:"Clean code, well tested"`
+    }
+)
+
+type UserProgress struct {
+    ID int `params:"id" validate:"required,gt=0" example:"42"`
+}
+
+type ListPendingSubmissions struct {
+    Cursor string `query:"cursor"                    example:"eyJ0IjoiMjAyMy0wMS0yMFQxMjowMDowMC4xMjM0NTZaIiwiaWQiOjQyfQ"`
+    Limit  uint32 `query:"limit"  validate:"lte=100" example:"20"`
+}
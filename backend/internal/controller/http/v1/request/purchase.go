@@ -0,0 +1,16 @@
+package request
+
+type (
+    PurchaseCourse struct {
+        CalendarID   int `json:"course_calendar_id" validate:"required,gt=0" example:"1"`
+        CourseTypeID int `json:"course_type_id"     validate:"gte=0"         example:"1"` // 0 - no discount
+    }
+
+    Purchase struct {
+        ID int `json:"id" validate:"required" example:"1"`
+    }
+
+    Payment struct {
+        PurchaseID int `json:"purchase_id" validate:"required" example:"1"`
+    }
+)
@@ -6,6 +6,9 @@ type (
     }
 
     DetailedPurchaseReport struct {
-        LimitNumber uint32 `json:"limit_number" validate:"required" example:"10"`
+        LimitNumber      uint32 `json:"limit_number"      validate:"required"                       example:"10"`
+        DateFrom         string `json:"date_from"         validate:"omitempty,datetime=2006-01-02" example:"2024-01-01"`
+        DateTo           string `json:"date_to"           validate:"omitempty,datetime=2006-01-02" example:"2024-12-31"`
+        SpecializationID int    `json:"specialization_id" validate:"gte=0"                          example:"1"` // 0 - any
     }
 )
@@ -0,0 +1,24 @@
+package request
+
+type (
+    CreateReview struct {
+        CourseID int    `json:"course_id" validate:"required,gt=0"        example:"1"`
+        Rating   int    `json:"rating"    validate:"required,min=1,max=5" example:"5"`
+        Comment  string `json:"comment"   validate:"max=5000"             example:"Great course, learned a lot!"`
+    }
+
+    UpdateReview struct {
+        ID      int    `json:"id"      validate:"required,gt=0"        example:"1"`
+        Rating  int    `json:"rating"  validate:"required,min=1,max=5" example:"4"`
+        Comment string `json:"comment" validate:"max=5000"             example:"Good course, but the last module is too short"`
+    }
+
+    Review struct {
+        ID int `json:"id" validate:"required,gt=0" example:"1"`
+    }
+)
+
+type ListPendingReviews struct {
+    AfterID int    `query:"after_id" validate:"gte=0"   example:"42"`
+    Limit   uint32 `query:"limit"    validate:"lte=100" example:"20"`
+}
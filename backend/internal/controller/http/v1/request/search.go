@@ -0,0 +1,7 @@
+package request
+
+type Search struct {
+    Query string `query:"q"     validate:"required,max=256"                     example:"go concurrency"`
+    Scope string `query:"scope" validate:"omitempty,oneof=all courses blog"     example:"all"`
+    Limit uint32 `query:"limit" validate:"lte=100"                              example:"20"`
+}
@@ -0,0 +1,49 @@
+package request
+
+type (
+    CreateCourseTopic struct {
+        CourseID int `json:"course_id" validate:"required,gt=0" example:"1"`
+        CourseTopic
+    }
+
+    CourseTopic struct {
+        Name                string `json:"name"                  validate:"required,max=255" example:"Introduction to Go"`
+        Description         string `json:"description"                                       example:"An overview of Go programming language"`
+        Technologies        string `json:"technologies"                                      example:"Go syntax, Go tools"`
+        LaborIntensityHours int    `json:"labor_intensity_hours" validate:"gte=0,lte=32767"  example:"10"`
+    }
+
+    UpdateCourseTopic struct {
+        ID int `json:"id" validate:"required,gt=0" example:"1"`
+        CourseTopic
+    }
+
+    RemoveCourseTopic struct {
+        CourseID int `json:"course_id" validate:"required,gt=0" example:"1"`
+        TopicID  int `json:"topic_id"  validate:"required,gt=0" example:"3"`
+    }
+
+    ReorderCourseTopics struct {
+        CourseID int   `json:"course_id" validate:"required,gt=0"                    example:"1"`
+        TopicIDs []int `json:"topic_ids" validate:"required,min=1,dive,required,gt=0" example:"3,1,2"`
+    }
+
+    CreateProject struct {
+        TopicID     int    `json:"topic_id"    validate:"required,gt=0"    example:"1"`
+        Name        string `json:"name"        validate:"required,max=255" example:"Go Basics Project"`
+        Description string `json:"description"                             example:"A project to apply basic Go concepts"`
+    }
+
+    UpdateProject struct {
+        ID int `json:"id" validate:"required,gt=0" example:"1"`
+        CreateProject
+    }
+
+    Project struct {
+        ID int `json:"id" validate:"required,gt=0" example:"1"`
+    }
+)
+
+type Syllabus struct {
+    CourseID int `query:"course_id" validate:"required,gt=0" example:"1"`
+}
@@ -0,0 +1,31 @@
+package request
+
+type (
+    UpdateTeacherProfile struct {
+        ID                       int    `json:"id"                        validate:"required,gt=0"              example:"1"`
+        WorkPlace                string `json:"work_place"                validate:"max=255"                    example:"Some BigTech Company"`
+        OverallExperience        int    `json:"overall_experience"        validate:"gte=0,lte=80"               example:"5"`
+        SpecializationExperience int    `json:"specialization_experience" validate:"gte=0,lte=80"               example:"3"`
+        PhotoURL                 string `json:"photo_url"                 validate:"omitempty,http_url,max=255" example:"https://example.com/photo.jpg"`
+        Bio                      string `json:"bio"                       validate:"max=5000"                   example:"Backend engineer teaching Go for 3 years"`
+    }
+
+    CourseStaff struct {
+        CourseID   int    `json:"course_id"   validate:"required,gt=0"                          example:"1"`
+        EmployeeID int    `json:"employee_id" validate:"required,gt=0"                          example:"3"`
+        StaffRole  string `json:"staff_role"  validate:"required,oneof=Teacher Mentor Reviewer" example:"Mentor"`
+    }
+)
+
+type GetTeacherProfile struct {
+    ID int `query:"id" validate:"required,gt=0" example:"1"`
+}
+
+type ListTeachers struct {
+    AfterID int    `query:"after_id" validate:"gte=0"   example:"42"`
+    Limit   uint32 `query:"limit"    validate:"lte=100" example:"20"`
+}
+
+type ListCourseStaff struct {
+    CourseID int `query:"course_id" validate:"required,gt=0" example:"1"`
+}
@@ -1,5 +1,27 @@
 package response
 
-type Error struct {
-    Error string `json:"error" example:"message"`
+// Problem - RFC 7807 problem details, sent as application/problem+json.
+type Problem struct {
+    Type          string         `json:"type"                     example:"/errors/course_not_found"` // Entry of the error catalog
+    Title         string         `json:"title"                    example:"course not found"`
+    Status        int            `json:"status"                   example:"404"`
+    Detail        string         `json:"detail,omitempty"         example:"course not found"`
+    Instance      string         `json:"instance,omitempty"       example:"/v2/courses/42"`
+    Code          string         `json:"code"                     example:"course_not_found"`
+    InvalidParams []InvalidParam `json:"invalid_params,omitempty"`
+}
+
+// InvalidParam - request field that broke a validation rule.
+type InvalidParam struct {
+    Name   string `json:"name"   example:"limit"`
+    Reason string `json:"reason" example:"lte=100"` // Failed validation rule with its parameter
+}
+
+// ErrorCatalogEntry - error code clients can rely on.
+type ErrorCatalogEntry struct {
+    Type   string `json:"type"   example:"/errors/course_not_found"`
+    Code   string `json:"code"   example:"course_not_found"`
+    Kind   string `json:"kind"   example:"not_found"`
+    Status int    `json:"status" example:"404"`
+    Title  string `json:"title"  example:"course not found"`
 }
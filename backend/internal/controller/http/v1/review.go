@@ -0,0 +1,250 @@
+package v1
+
+import (
+    "net/http"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/controller/http/middleware"
+    "github.com/deadnotxaa/education-platform/backend/internal/controller/http/v1/request"
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+    "github.com/gofiber/fiber/v2"
+)
+
+// @Summary     Create Review
+// @Description Review a course with a completed purchase, the review is published after moderation
+// @ID          createReview
+// @Tags  	    review
+// @Accept      json
+// @Produce     json
+// @Param       request body request.CreateReview true "Review"
+// @Security    BearerAuth
+// @Success     201 {object} entity.CourseReview
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     409 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /review/create-review [post]
+func (r *V1) createReview(ctx *fiber.Ctx) error {
+    var body request.CreateReview
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - createReview")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - createReview")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    accountID, _ := middleware.AccountID(ctx)
+
+    review, err := r.p.CreateReview(ctx.UserContext(), entity.CourseReview{
+        CourseID: body.CourseID,
+        UserID:   accountID,
+        Rating:   body.Rating,
+        Comment:  body.Comment,
+    })
+    if err != nil {
+        r.l.Error(err, "http - v1 - createReview")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusCreated).JSON(review)
+}
+
+// @Summary     Update Review
+// @Description Edit own review, the edited review goes back to moderation
+// @ID          updateReview
+// @Tags  	    review
+// @Accept      json
+// @Produce     json
+// @Param       request body request.UpdateReview true "Review"
+// @Security    BearerAuth
+// @Success     200 {object} entity.CourseReview
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /review/update-review [put]
+func (r *V1) updateReview(ctx *fiber.Ctx) error {
+    var body request.UpdateReview
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - updateReview")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - updateReview")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    accountID, _ := middleware.AccountID(ctx)
+
+    review, err := r.p.UpdateReview(ctx.UserContext(), entity.CourseReview{
+        ReviewID: body.ID,
+        UserID:   accountID,
+        Rating:   body.Rating,
+        Comment:  body.Comment,
+    })
+    if err != nil {
+        r.l.Error(err, "http - v1 - updateReview")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusOK).JSON(review)
+}
+
+// @Summary     Delete Review
+// @Description Delete own review (or any review with review:moderate)
+// @ID          deleteReview
+// @Tags  	    review
+// @Accept      json
+// @Produce     json
+// @Param       request body request.Review true "Review to delete"
+// @Security    BearerAuth
+// @Success     204
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /review/delete-review [delete]
+func (r *V1) deleteReview(ctx *fiber.Ctx) error {
+    var body request.Review
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - deleteReview")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - deleteReview")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    accountID, _ := middleware.AccountID(ctx)
+
+    if err := r.p.DeleteReview(ctx.UserContext(), accountID, body.ID); err != nil {
+        r.l.Error(err, "http - v1 - deleteReview")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.SendStatus(http.StatusNoContent)
+}
+
+// @Summary     Moderation Queue
+// @Description Get reviews waiting for moderation, oldest first
+// @ID          listPendingReviews
+// @Tags  	    review
+// @Produce     json
+// @Param       after_id query int false "next_after_id from the previous page"
+// @Param       limit    query int false "Page size, 20 by default"
+// @Security    BearerAuth
+// @Success     200 {object} entity.ReviewPage
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /review/moderation-queue [get]
+func (r *V1) listPendingReviews(ctx *fiber.Ctx) error {
+    var query request.ListPendingReviews
+
+    if err := ctx.QueryParser(&query); err != nil {
+        r.l.Error(err, "http - v1 - listPendingReviews")
+
+        return invalidRequestResponse(ctx, "invalid query parameters")
+    }
+
+    if err := r.v.Struct(query); err != nil {
+        r.l.Error(err, "http - v1 - listPendingReviews")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    page, err := r.p.ListPendingReviews(ctx.UserContext(), query.AfterID, query.Limit)
+    if err != nil {
+        r.l.Error(err, "http - v1 - listPendingReviews")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusOK).JSON(page)
+}
+
+// @Summary     Approve Review
+// @Description Publish a pending review
+// @ID          approveReview
+// @Tags  	    review
+// @Accept      json
+// @Produce     json
+// @Param       request body request.Review true "Review to approve"
+// @Security    BearerAuth
+// @Success     204
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     409 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /review/approve-review [post]
+func (r *V1) approveReview(ctx *fiber.Ctx) error {
+    return r.moderateReview(ctx, entity.ReviewStatusApproved, "http - v1 - approveReview")
+}
+
+// @Summary     Reject Review
+// @Description Reject a pending review, it is never published
+// @ID          rejectReview
+// @Tags  	    review
+// @Accept      json
+// @Produce     json
+// @Param       request body request.Review true "Review to reject"
+// @Security    BearerAuth
+// @Success     204
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     409 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /review/reject-review [post]
+func (r *V1) rejectReview(ctx *fiber.Ctx) error {
+    return r.moderateReview(ctx, entity.ReviewStatusRejected, "http - v1 - rejectReview")
+}
+
+func (r *V1) moderateReview(ctx *fiber.Ctx, status entity.ReviewStatus, name string) error {
+    var body request.Review
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, name)
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, name)
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    accountID, _ := middleware.AccountID(ctx)
+
+    if err := r.p.ModerateReview(ctx.UserContext(), accountID, body.ID, status); err != nil {
+        r.l.Error(err, name)
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.SendStatus(http.StatusNoContent)
+}
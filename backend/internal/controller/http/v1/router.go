@@ -1,36 +1,246 @@
 package v1
 
 import (
+    "github.com/deadnotxaa/education-platform/backend/internal/controller/http/middleware"
+    "github.com/deadnotxaa/education-platform/backend/internal/controller/http/problem"
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
     "github.com/deadnotxaa/education-platform/backend/internal/usecase"
     "github.com/deadnotxaa/education-platform/backend/pkg/logger"
-    "github.com/go-playground/validator/v10"
     "github.com/gofiber/fiber/v2"
 )
 
+// authenticated -.
+func (r *V1) authenticated() fiber.Handler {
+    return middleware.Authenticate(r.p)
+}
+
+// require -.
+func (r *V1) require(permission entity.Permission) fiber.Handler {
+    return middleware.RequirePermission(r.p, r.l, permission)
+}
+
 // NewCourseRoutes -.
 func NewCourseRoutes(apiV1Group fiber.Router, p usecase.Platform, l logger.Interface) {
-    r := &V1{p: p, l: l, v: validator.New(validator.WithRequiredStructEnabled())}
+    r := &V1{p: p, l: l, v: problem.NewValidator()}
 
     courseGroup := apiV1Group.Group("/course")
     {
-        courseGroup.Get("/getcourse", r.getCourse)
+        courseGroup.Get("/getcourse", middleware.Deprecated("/v2/courses/{id}"), r.getCourse)
+        courseGroup.Post("/create-course", r.authenticated(), r.require(entity.PermissionCourseWrite), r.createCourse)
+        courseGroup.Put("/update-course", r.authenticated(), r.require(entity.PermissionCourseWrite), r.updateCourse)
+        courseGroup.Delete("/delete-course", r.authenticated(), r.require(entity.PermissionCourseWrite),
+            r.deleteCourse)
     }
+
+    apiV1Group.Get("/courses", r.listCourses)
 }
 
 func NewUserRoutes(apiV1Group fiber.Router, p usecase.Platform, l logger.Interface) {
-    r := &V1{p: p, l: l, v: validator.New(validator.WithRequiredStructEnabled())}
+    r := &V1{p: p, l: l, v: problem.NewValidator()}
 
     userGroup := apiV1Group.Group("/user")
     {
-        userGroup.Get("/getuser", r.getUser)
+        // Users may read their own data, reading others requires entity.PermissionUserRead
+        userGroup.Get("/getuser", middleware.Deprecated("/v2/users/{id}"), r.authenticated(), r.getUser)
+        userGroup.Get("/:id/progress", r.authenticated(), r.getUserProgress)
     }
 }
 
 func NewReportRoutes(apiV1Group fiber.Router, p usecase.Platform, l logger.Interface) {
-    r := &V1{p: p, l: l, v: validator.New(validator.WithRequiredStructEnabled())}
+    r := &V1{p: p, l: l, v: problem.NewValidator()}
+
+    reportGroup := apiV1Group.Group("/report", r.authenticated(), r.require(entity.PermissionReportRead))
+    {
+        reportGroup.Get("/get-top-courses-report", middleware.Deprecated("/v2/reports/top-courses"),
+            r.getTopCoursesReport)
+        reportGroup.Get("/get-detailed-purchase-report", middleware.Deprecated("/v2/reports/purchases"),
+            r.getDetailedPurchaseReport)
+    }
+}
+
+func NewSearchRoutes(apiV1Group fiber.Router, p usecase.Platform, l logger.Interface) {
+    r := &V1{p: p, l: l, v: problem.NewValidator()}
+
+    apiV1Group.Get("/search", r.search)
+}
+
+func NewAuthRoutes(apiV1Group fiber.Router, p usecase.Platform, l logger.Interface) {
+    r := &V1{p: p, l: l, v: problem.NewValidator()}
+
+    authGroup := apiV1Group.Group("/auth")
+    {
+        authGroup.Post("/register", r.register)
+        authGroup.Post("/login", r.login)
+        authGroup.Post("/refresh", r.refresh)
+        authGroup.Post("/logout", r.logout)
+    }
+}
+
+func NewPurchaseRoutes(apiV1Group fiber.Router, p usecase.Platform, l logger.Interface) {
+    r := &V1{p: p, l: l, v: problem.NewValidator()}
+
+    purchaseGroup := apiV1Group.Group("/purchase", r.authenticated())
+    {
+        purchaseGroup.Post("/purchase-course", r.purchaseCourse)
+        purchaseGroup.Post("/complete-purchase", r.require(entity.PermissionPurchaseManage), r.completePurchase)
+        purchaseGroup.Post("/cancel-purchase", r.cancelPurchase)
+    }
+}
+
+func NewPaymentRoutes(apiV1Group fiber.Router, p usecase.Platform, l logger.Interface) {
+    r := &V1{p: p, l: l, v: problem.NewValidator()}
+
+    paymentsGroup := apiV1Group.Group("/payments")
+    {
+        paymentsGroup.Post("/create-payment", r.authenticated(), r.createPayment)
+        paymentsGroup.Post("/confirm-payment", r.authenticated(), r.confirmPayment)
+        paymentsGroup.Post("/refund-payment", r.authenticated(), r.refundPayment)
+
+        // Called by the payment provider, authenticated by the request signature
+        paymentsGroup.Post("/webhook", r.paymentWebhook)
+    }
+}
+
+func NewReviewRoutes(apiV1Group fiber.Router, p usecase.Platform, l logger.Interface) {
+    r := &V1{p: p, l: l, v: problem.NewValidator()}
+
+    reviewGroup := apiV1Group.Group("/review", r.authenticated())
+    {
+        reviewGroup.Post("/create-review", r.createReview)
+        reviewGroup.Put("/update-review", r.updateReview)
+        reviewGroup.Delete("/delete-review", r.deleteReview)
+
+        reviewGroup.Get("/moderation-queue", r.require(entity.PermissionReviewModerate), r.listPendingReviews)
+        reviewGroup.Post("/approve-review", r.require(entity.PermissionReviewModerate), r.approveReview)
+        reviewGroup.Post("/reject-review", r.require(entity.PermissionReviewModerate), r.rejectReview)
+    }
+}
+
+func NewCertificateRoutes(apiV1Group fiber.Router, p usecase.Platform, l logger.Interface) {
+    r := &V1{p: p, l: l, v: problem.NewValidator()}
+
+    certificatesGroup := apiV1Group.Group("/certificates")
+    {
+        certificatesGroup.Post("/issue-certificate", r.authenticated(), r.require(entity.PermissionCertificateIssue),
+            r.issueCertificate)
+        certificatesGroup.Get("/my-certificates", r.authenticated(), r.listMyCertificates)
+        certificatesGroup.Get("/download-certificate", r.authenticated(), r.downloadCertificate)
+
+        // Public, linked from the printed certificate
+        certificatesGroup.Get("/verify/:code", r.verifyCertificate)
+    }
+}
+
+func NewSyllabusRoutes(apiV1Group fiber.Router, p usecase.Platform, l logger.Interface) {
+    r := &V1{p: p, l: l, v: problem.NewValidator()}
+
+    syllabusGroup := apiV1Group.Group("/syllabus")
+    {
+        syllabusGroup.Get("/get-syllabus", r.getSyllabus)
+
+        syllabusGroup.Post("/create-topic", r.authenticated(), r.require(entity.PermissionCourseWrite), r.createCourseTopic)
+        syllabusGroup.Put("/update-topic", r.authenticated(), r.require(entity.PermissionCourseWrite), r.updateCourseTopic)
+        syllabusGroup.Delete("/delete-topic", r.authenticated(), r.require(entity.PermissionCourseWrite),
+            r.removeCourseTopic)
+        syllabusGroup.Put("/reorder-topics", r.authenticated(), r.require(entity.PermissionCourseWrite),
+            r.reorderCourseTopics)
+
+        syllabusGroup.Post("/create-project", r.authenticated(), r.require(entity.PermissionCourseWrite), r.createProject)
+        syllabusGroup.Put("/update-project", r.authenticated(), r.require(entity.PermissionCourseWrite), r.updateProject)
+        syllabusGroup.Delete("/delete-project", r.authenticated(), r.require(entity.PermissionCourseWrite),
+            r.deleteProject)
+    }
+}
+
+func NewProgressRoutes(apiV1Group fiber.Router, p usecase.Platform, l logger.Interface) {
+    r := &V1{p: p, l: l, v: problem.NewValidator()}
+
+    progressGroup := apiV1Group.Group("/progress", r.authenticated())
+    {
+        progressGroup.Post("/update-topic", r.updateTopicProgress)
+        progressGroup.Post("/submit-project", r.submitProject)
+
+        progressGroup.Get("/review-queue", r.require(entity.PermissionProjectReview), r.listPendingSubmissions)
+        progressGroup.Post("/grade-project", r.require(entity.PermissionProjectReview), r.gradeSubmission)
+    }
+}
+
+func NewCalendarRoutes(apiV1Group fiber.Router, p usecase.Platform, l logger.Interface) {
+    r := &V1{p: p, l: l, v: problem.NewValidator()}
 
-    userGroup := apiV1Group.Group("/report")
+    calendarGroup := apiV1Group.Group("/calendar")
     {
-        userGroup.Get("/get-top-courses-report", r.getTopCoursesReport)
+        calendarGroup.Get("/upcoming", r.listUpcomingCourseCalendars)
+
+        calendarGroup.Post("/create-calendar", r.authenticated(), r.require(entity.PermissionCourseWrite),
+            r.createCourseCalendar)
+        calendarGroup.Put("/update-calendar", r.authenticated(), r.require(entity.PermissionCourseWrite),
+            r.updateCourseCalendar)
+
+        calendarGroup.Post("/join-waitlist", r.authenticated(), r.joinWaitlist)
+        calendarGroup.Post("/leave-waitlist", r.authenticated(), r.leaveWaitlist)
+        calendarGroup.Get("/my-waitlist", r.authenticated(), r.listMyWaitlist)
+    }
+}
+
+func NewCareerRoutes(apiV1Group fiber.Router, p usecase.Platform, l logger.Interface) {
+    r := &V1{p: p, l: l, v: problem.NewValidator()}
+
+    careerGroup := apiV1Group.Group("/career", r.authenticated())
+    {
+        careerGroup.Put("/upload-cv", r.uploadCV)
+        careerGroup.Post("/apply-job", r.applyForJob)
+        careerGroup.Get("/my-applications", r.listMyJobApplications)
+        careerGroup.Get("/application-history", r.getJobApplicationHistory)
+        careerGroup.Get("/companies", r.listPartnerCompanies)
+        careerGroup.Get("/get-company", r.getPartnerCompany)
+
+        careerGroup.Post("/enroll-student", r.require(entity.PermissionCareerManage), r.enrollCareerStudent)
+        careerGroup.Get("/expiring-support", r.require(entity.PermissionCareerManage), r.listExpiringCareerSupport)
+        careerGroup.Put("/extend-support", r.require(entity.PermissionCareerManage), r.extendCareerSupport)
+        careerGroup.Put("/change-application-status", r.require(entity.PermissionCareerManage),
+            r.changeJobApplicationStatus)
+
+        careerGroup.Post("/create-company", r.require(entity.PermissionCareerManage), r.createPartnerCompany)
+        careerGroup.Put("/update-company", r.require(entity.PermissionCareerManage), r.updatePartnerCompany)
+        careerGroup.Delete("/delete-company", r.require(entity.PermissionCareerManage), r.deletePartnerCompany)
+        careerGroup.Get("/match-students", r.require(entity.PermissionCareerManage), r.matchStudents)
+    }
+}
+
+func NewBlogRoutes(apiV1Group fiber.Router, p usecase.Platform, l logger.Interface) {
+    r := &V1{p: p, l: l, v: problem.NewValidator()}
+
+    blogGroup := apiV1Group.Group("/blog")
+    {
+        blogGroup.Get("/posts", r.listBlogPosts)
+        blogGroup.Get("/get-post", r.getBlogPost)
+
+        blogGroup.Post("/create-post", r.authenticated(), r.require(entity.PermissionBlogWrite), r.createBlogPost)
+        blogGroup.Put("/update-post", r.authenticated(), r.require(entity.PermissionBlogWrite), r.updateBlogPost)
+        blogGroup.Put("/schedule-post", r.authenticated(), r.require(entity.PermissionBlogWrite), r.scheduleBlogPost)
+        blogGroup.Put("/publish-post", r.authenticated(), r.require(entity.PermissionBlogWrite), r.publishBlogPost)
+        blogGroup.Get("/unpublished", r.authenticated(), r.require(entity.PermissionBlogWrite),
+            r.listUnpublishedBlogPosts)
+    }
+}
+
+func NewTeacherRoutes(apiV1Group fiber.Router, p usecase.Platform, l logger.Interface) {
+    r := &V1{p: p, l: l, v: problem.NewValidator()}
+
+    teacherGroup := apiV1Group.Group("/teacher")
+    {
+        teacherGroup.Get("/teachers", r.listTeachers)
+        teacherGroup.Get("/get-teacher", r.getTeacherProfile)
+        teacherGroup.Put("/update-profile", r.authenticated(), r.updateTeacherProfile)
+    }
+
+    staffGroup := apiV1Group.Group("/staff")
+    {
+        staffGroup.Get("/course-staff", r.listCourseStaff)
+
+        staffGroup.Post("/assign", r.authenticated(), r.require(entity.PermissionStaffManage), r.assignCourseStaff)
+        staffGroup.Delete("/unassign", r.authenticated(), r.require(entity.PermissionStaffManage),
+            r.unassignCourseStaff)
     }
 }
@@ -0,0 +1,51 @@
+package v1
+
+import (
+    "net/http"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/controller/http/v1/request"
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+    "github.com/gofiber/fiber/v2"
+)
+
+// @Summary     Search
+// @Description Full-text search across courses (including their topics) and blog posts
+// @ID          search
+// @Tags  	    search
+// @Produce     json
+// @Param       q     query string true  "Search query, supports web search syntax"
+// @Param       scope query string false "Where to search" Enums(all, courses, blog)
+// @Param       limit query int    false "Maximal number of hits, 20 by default"
+// @Success     200 {array}  entity.SearchHit
+// @Failure     400 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /search [get]
+func (r *V1) search(ctx *fiber.Ctx) error {
+    var query request.Search
+
+    if err := ctx.QueryParser(&query); err != nil {
+        r.l.Error(err, "http - v1 - search")
+
+        return invalidRequestResponse(ctx, "invalid query parameters")
+    }
+
+    if err := r.v.Struct(query); err != nil {
+        r.l.Error(err, "http - v1 - search")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    hits, err := r.p.Search(ctx.UserContext(), entity.SearchQuery{
+        Text:      query.Query,
+        Courses:   query.Scope == "courses",
+        BlogPosts: query.Scope == "blog",
+        Limit:     query.Limit,
+    })
+    if err != nil {
+        r.l.Error(err, "http - v1 - search")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusOK).JSON(hits)
+}
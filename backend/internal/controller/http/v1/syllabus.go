@@ -0,0 +1,342 @@
+package v1
+
+import (
+    "net/http"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/controller/http/v1/request"
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+    "github.com/gofiber/fiber/v2"
+)
+
+// @Summary     Get Syllabus
+// @Description Get the learning path of a course: ordered topics with their projects
+// @ID          getSyllabus
+// @Tags  	    syllabus
+// @Produce     json
+// @Param       course_id query int true "Course ID"
+// @Success     200 {object} entity.Syllabus
+// @Failure     400 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /syllabus/get-syllabus [get]
+func (r *V1) getSyllabus(ctx *fiber.Ctx) error {
+    var query request.Syllabus
+
+    if err := ctx.QueryParser(&query); err != nil {
+        r.l.Error(err, "http - v1 - getSyllabus")
+
+        return invalidRequestResponse(ctx, "invalid query parameters")
+    }
+
+    if err := r.v.Struct(query); err != nil {
+        r.l.Error(err, "http - v1 - getSyllabus")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    syllabus, err := r.p.GetSyllabus(ctx.UserContext(), query.CourseID)
+    if err != nil {
+        r.l.Error(err, "http - v1 - getSyllabus")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusOK).JSON(syllabus)
+}
+
+// @Summary     Create Topic
+// @Description Add a topic to the end of the course learning path
+// @ID          createCourseTopic
+// @Tags  	    syllabus
+// @Accept      json
+// @Produce     json
+// @Param       request body request.CreateCourseTopic true "Topic to create"
+// @Security    BearerAuth
+// @Success     201 {object} entity.SyllabusTopic
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /syllabus/create-topic [post]
+func (r *V1) createCourseTopic(ctx *fiber.Ctx) error {
+    var body request.CreateCourseTopic
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - createCourseTopic")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - createCourseTopic")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    topic, err := r.p.CreateCourseTopic(ctx.UserContext(), body.CourseID, entity.CourseTopic{
+        Name:                body.Name,
+        Description:         body.Description,
+        Technologies:        body.Technologies,
+        LaborIntensityHours: body.LaborIntensityHours,
+    })
+    if err != nil {
+        r.l.Error(err, "http - v1 - createCourseTopic")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusCreated).JSON(topic)
+}
+
+// @Summary     Update Topic
+// @Description Edit a topic, the change applies to every course that uses it
+// @ID          updateCourseTopic
+// @Tags  	    syllabus
+// @Accept      json
+// @Produce     json
+// @Param       request body request.UpdateCourseTopic true "Topic to update"
+// @Security    BearerAuth
+// @Success     200 {object} entity.CourseTopic
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /syllabus/update-topic [put]
+func (r *V1) updateCourseTopic(ctx *fiber.Ctx) error {
+    var body request.UpdateCourseTopic
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - updateCourseTopic")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - updateCourseTopic")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    topic, err := r.p.UpdateCourseTopic(ctx.UserContext(), entity.CourseTopic{
+        ID:                  body.ID,
+        Name:                body.Name,
+        Description:         body.Description,
+        Technologies:        body.Technologies,
+        LaborIntensityHours: body.LaborIntensityHours,
+    })
+    if err != nil {
+        r.l.Error(err, "http - v1 - updateCourseTopic")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusOK).JSON(topic)
+}
+
+// @Summary     Remove Topic
+// @Description Remove a topic from the course, the topic and its projects are deleted if no other course uses it
+// @ID          removeCourseTopic
+// @Tags  	    syllabus
+// @Accept      json
+// @Produce     json
+// @Param       request body request.RemoveCourseTopic true "Topic to remove"
+// @Security    BearerAuth
+// @Success     204
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /syllabus/delete-topic [delete]
+func (r *V1) removeCourseTopic(ctx *fiber.Ctx) error {
+    var body request.RemoveCourseTopic
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - removeCourseTopic")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - removeCourseTopic")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    if err := r.p.RemoveCourseTopic(ctx.UserContext(), body.CourseID, body.TopicID); err != nil {
+        r.l.Error(err, "http - v1 - removeCourseTopic")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.SendStatus(http.StatusNoContent)
+}
+
+// @Summary     Reorder Topics
+// @Description Set the order of the course topics, every topic of the course must be listed exactly once
+// @ID          reorderCourseTopics
+// @Tags  	    syllabus
+// @Accept      json
+// @Produce     json
+// @Param       request body request.ReorderCourseTopics true "New topic order"
+// @Security    BearerAuth
+// @Success     204
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /syllabus/reorder-topics [put]
+func (r *V1) reorderCourseTopics(ctx *fiber.Ctx) error {
+    var body request.ReorderCourseTopics
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - reorderCourseTopics")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - reorderCourseTopics")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    if err := r.p.ReorderCourseTopics(ctx.UserContext(), body.CourseID, body.TopicIDs); err != nil {
+        r.l.Error(err, "http - v1 - reorderCourseTopics")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.SendStatus(http.StatusNoContent)
+}
+
+// @Summary     Create Project
+// @Description Add a project to a topic
+// @ID          createProject
+// @Tags  	    syllabus
+// @Accept      json
+// @Produce     json
+// @Param       request body request.CreateProject true "Project to create"
+// @Security    BearerAuth
+// @Success     201 {object} entity.Project
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /syllabus/create-project [post]
+func (r *V1) createProject(ctx *fiber.Ctx) error {
+    var body request.CreateProject
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - createProject")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - createProject")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    project, err := r.p.CreateProject(ctx.UserContext(), entity.Project{
+        TopicID:     body.TopicID,
+        Name:        body.Name,
+        Description: body.Description,
+    })
+    if err != nil {
+        r.l.Error(err, "http - v1 - createProject")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusCreated).JSON(project)
+}
+
+// @Summary     Update Project
+// @Description Edit a project or move it to another topic
+// @ID          updateProject
+// @Tags  	    syllabus
+// @Accept      json
+// @Produce     json
+// @Param       request body request.UpdateProject true "Project to update"
+// @Security    BearerAuth
+// @Success     200 {object} entity.Project
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /syllabus/update-project [put]
+func (r *V1) updateProject(ctx *fiber.Ctx) error {
+    var body request.UpdateProject
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - updateProject")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - updateProject")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    project, err := r.p.UpdateProject(ctx.UserContext(), entity.Project{
+        ProjectID:   body.ID,
+        TopicID:     body.TopicID,
+        Name:        body.Name,
+        Description: body.Description,
+    })
+    if err != nil {
+        r.l.Error(err, "http - v1 - updateProject")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusOK).JSON(project)
+}
+
+// @Summary     Delete Project
+// @Description Delete a project
+// @ID          deleteProject
+// @Tags  	    syllabus
+// @Accept      json
+// @Produce     json
+// @Param       request body request.Project true "Project to delete"
+// @Security    BearerAuth
+// @Success     204
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /syllabus/delete-project [delete]
+func (r *V1) deleteProject(ctx *fiber.Ctx) error {
+    var body request.Project
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - deleteProject")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - deleteProject")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    if err := r.p.DeleteProject(ctx.UserContext(), body.ID); err != nil {
+        r.l.Error(err, "http - v1 - deleteProject")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.SendStatus(http.StatusNoContent)
+}
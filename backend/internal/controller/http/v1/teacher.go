@@ -0,0 +1,257 @@
+package v1
+
+import (
+    "net/http"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/controller/http/middleware"
+    "github.com/deadnotxaa/education-platform/backend/internal/controller/http/v1/request"
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+    "github.com/gofiber/fiber/v2"
+)
+
+// @Summary     List Teachers
+// @Description Get teacher profiles with the rating of their courses
+// @ID          listTeachers
+// @Tags  	    teacher
+// @Produce     json
+// @Param       after_id query int false "next_after_id from the previous page"
+// @Param       limit    query int false "Page size, 20 by default"
+// @Success     200 {object} entity.TeacherPage
+// @Failure     400 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /teacher/teachers [get]
+func (r *V1) listTeachers(ctx *fiber.Ctx) error {
+    var query request.ListTeachers
+
+    if err := ctx.QueryParser(&query); err != nil {
+        r.l.Error(err, "http - v1 - listTeachers")
+
+        return invalidRequestResponse(ctx, "invalid query parameters")
+    }
+
+    if err := r.v.Struct(query); err != nil {
+        r.l.Error(err, "http - v1 - listTeachers")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    page, err := r.p.ListTeachers(ctx.UserContext(), query.AfterID, query.Limit)
+    if err != nil {
+        r.l.Error(err, "http - v1 - listTeachers")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusOK).JSON(page)
+}
+
+// @Summary     Get Teacher
+// @Description Get a teacher profile with the courses the teacher works on and their aggregated rating
+// @ID          getTeacherProfile
+// @Tags  	    teacher
+// @Produce     json
+// @Param       id query int true "Employee ID of the teacher"
+// @Success     200 {object} entity.TeacherProfile
+// @Failure     400 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /teacher/get-teacher [get]
+func (r *V1) getTeacherProfile(ctx *fiber.Ctx) error {
+    var query request.GetTeacherProfile
+
+    if err := ctx.QueryParser(&query); err != nil {
+        r.l.Error(err, "http - v1 - getTeacherProfile")
+
+        return invalidRequestResponse(ctx, "invalid teacher id")
+    }
+
+    if err := r.v.Struct(query); err != nil {
+        r.l.Error(err, "http - v1 - getTeacherProfile")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    profile, err := r.p.GetTeacherProfile(ctx.UserContext(), query.ID)
+    if err != nil {
+        r.l.Error(err, "http - v1 - getTeacherProfile")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusOK).JSON(profile)
+}
+
+// @Summary     Update Teacher Profile
+// @Description Create or edit a teacher profile, teachers edit their own profile, others require staff:manage
+// @ID          updateTeacherProfile
+// @Tags  	    teacher
+// @Accept      json
+// @Produce     json
+// @Param       request body request.UpdateTeacherProfile true "Teacher profile"
+// @Security    BearerAuth
+// @Success     200 {object} entity.TeacherProfile
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     409 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /teacher/update-profile [put]
+func (r *V1) updateTeacherProfile(ctx *fiber.Ctx) error {
+    var body request.UpdateTeacherProfile
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - updateTeacherProfile")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - updateTeacherProfile")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    accountID, _ := middleware.AccountID(ctx)
+
+    profile, err := r.p.UpdateTeacherProfile(ctx.UserContext(), accountID, entity.Teacher{
+        EmployeeID:               body.ID,
+        WorkPlace:                body.WorkPlace,
+        OverallExperience:        body.OverallExperience,
+        SpecializationExperience: body.SpecializationExperience,
+        PhotoURL:                 body.PhotoURL,
+        Bio:                      body.Bio,
+    })
+    if err != nil {
+        r.l.Error(err, "http - v1 - updateTeacherProfile")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusOK).JSON(profile)
+}
+
+// @Summary     Course Staff
+// @Description Get the teachers, mentors and reviewers of a course
+// @ID          listCourseStaff
+// @Tags  	    teacher
+// @Produce     json
+// @Param       course_id query int true "Course ID"
+// @Success     200 {array}  entity.CourseStaffMember
+// @Failure     400 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /staff/course-staff [get]
+func (r *V1) listCourseStaff(ctx *fiber.Ctx) error {
+    var query request.ListCourseStaff
+
+    if err := ctx.QueryParser(&query); err != nil {
+        r.l.Error(err, "http - v1 - listCourseStaff")
+
+        return invalidRequestResponse(ctx, "invalid course id")
+    }
+
+    if err := r.v.Struct(query); err != nil {
+        r.l.Error(err, "http - v1 - listCourseStaff")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    staff, err := r.p.ListCourseStaff(ctx.UserContext(), query.CourseID)
+    if err != nil {
+        r.l.Error(err, "http - v1 - listCourseStaff")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.Status(http.StatusOK).JSON(staff)
+}
+
+// @Summary     Assign Course Staff
+// @Description Assign a teacher, mentor or reviewer to a course, the employee's role must match the staff role
+// @ID          assignCourseStaff
+// @Tags  	    teacher
+// @Accept      json
+// @Produce     json
+// @Param       request body request.CourseStaff true "Assignment"
+// @Security    BearerAuth
+// @Success     204
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     409 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /staff/assign [post]
+func (r *V1) assignCourseStaff(ctx *fiber.Ctx) error {
+    var body request.CourseStaff
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - assignCourseStaff")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - assignCourseStaff")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    err := r.p.AssignCourseStaff(ctx.UserContext(), entity.CourseStaffMember{
+        CourseID:   body.CourseID,
+        EmployeeID: body.EmployeeID,
+        StaffRole:  entity.StaffRole(body.StaffRole),
+    })
+    if err != nil {
+        r.l.Error(err, "http - v1 - assignCourseStaff")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.SendStatus(http.StatusNoContent)
+}
+
+// @Summary     Unassign Course Staff
+// @Description Remove a teacher, mentor or reviewer from a course
+// @ID          unassignCourseStaff
+// @Tags  	    teacher
+// @Accept      json
+// @Produce     json
+// @Param       request body request.CourseStaff true "Assignment to remove"
+// @Security    BearerAuth
+// @Success     204
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /staff/unassign [delete]
+func (r *V1) unassignCourseStaff(ctx *fiber.Ctx) error {
+    var body request.CourseStaff
+
+    if err := ctx.BodyParser(&body); err != nil {
+        r.l.Error(err, "http - v1 - unassignCourseStaff")
+
+        return invalidRequestResponse(ctx, "invalid request body")
+    }
+
+    if err := r.v.Struct(body); err != nil {
+        r.l.Error(err, "http - v1 - unassignCourseStaff")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    err := r.p.UnassignCourseStaff(ctx.UserContext(), entity.CourseStaffMember{
+        CourseID:   body.CourseID,
+        EmployeeID: body.EmployeeID,
+        StaffRole:  entity.StaffRole(body.StaffRole),
+    })
+    if err != nil {
+        r.l.Error(err, "http - v1 - unassignCourseStaff")
+
+        return errorResponse(ctx, err)
+    }
+
+    return ctx.SendStatus(http.StatusNoContent)
+}
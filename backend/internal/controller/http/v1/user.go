@@ -3,7 +3,9 @@ package v1
 import (
     "net/http"
 
+    "github.com/deadnotxaa/education-platform/backend/internal/controller/http/middleware"
     "github.com/deadnotxaa/education-platform/backend/internal/controller/http/v1/request"
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
     "github.com/gofiber/fiber/v2"
 )
 
@@ -13,8 +15,12 @@ import (
 // @Tags  	    user
 // @Accept      json
 // @Produce     json
+// @Security    BearerAuth
 // @Success     200 {object} entity.User
-// @Failure     400 {object} response.Error
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     404 {object} response.Problem
 // @Router      /user/getuser [get]
 func (r *V1) getUser(ctx *fiber.Ctx) error {
     var body request.User
@@ -22,20 +28,33 @@ func (r *V1) getUser(ctx *fiber.Ctx) error {
     if err := ctx.BodyParser(&body); err != nil {
         r.l.Error(err, "http - v1 - getUser")
 
-        return errorResponse(ctx, http.StatusBadRequest, "invalid request body")
+        return invalidRequestResponse(ctx, "invalid request body")
     }
 
     if err := r.v.Struct(body); err != nil {
         r.l.Error(err, "http - v1 - getUser")
 
-        return errorResponse(ctx, http.StatusBadRequest, "invalid request body")
+        return validationErrorResponse(ctx, err)
+    }
+
+    if accountID, _ := middleware.AccountID(ctx); accountID != body.ID {
+        allowed, err := r.p.HasPermission(ctx.UserContext(), accountID, entity.PermissionUserRead)
+        if err != nil {
+            r.l.Error(err, "http - v1 - getUser")
+
+            return errorResponse(ctx, err)
+        }
+
+        if !allowed {
+            return errorResponse(ctx, entity.ErrForbidden)
+        }
     }
 
     user, err := r.p.GetUserById(ctx.UserContext(), body.ID)
     if err != nil {
         r.l.Error(err, "http - v1 - getUser")
 
-        return errorResponse(ctx, http.StatusInternalServerError, "database problems")
+        return errorResponse(ctx, err)
     }
 
     return ctx.Status(http.StatusOK).JSON(user)
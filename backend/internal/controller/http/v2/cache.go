@@ -0,0 +1,27 @@
+package v2
+
+import (
+    "net/http"
+    "time"
+
+    "github.com/gofiber/fiber/v2"
+)
+
+// notModified sets Last-Modified and tells whether the client's copy from If-Modified-Since is still fresh.
+// HTTP dates have a one second precision, so the time is truncated before comparing.
+func notModified(ctx *fiber.Ctx, lastModified time.Time) bool {
+    lastModified = lastModified.UTC().Truncate(time.Second)
+    ctx.Set(fiber.HeaderLastModified, lastModified.Format(http.TimeFormat))
+
+    // If-None-Match takes precedence and is checked by the ETag middleware
+    if ctx.Get(fiber.HeaderIfNoneMatch) != "" {
+        return false
+    }
+
+    since, err := http.ParseTime(ctx.Get(fiber.HeaderIfModifiedSince))
+    if err != nil {
+        return false
+    }
+
+    return !lastModified.After(since)
+}
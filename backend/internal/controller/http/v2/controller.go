@@ -0,0 +1,14 @@
+package v2
+
+import (
+    "github.com/deadnotxaa/education-platform/backend/internal/usecase"
+    "github.com/deadnotxaa/education-platform/backend/pkg/logger"
+
+    "github.com/go-playground/validator/v10"
+)
+
+type V2 struct {
+    p usecase.Platform
+    l logger.Interface
+    v *validator.Validate
+}
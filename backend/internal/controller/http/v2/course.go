@@ -0,0 +1,50 @@
+package v2
+
+import (
+    "net/http"
+    "time"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/controller/http/v2/request"
+    "github.com/gofiber/fiber/v2"
+)
+
+// @Summary     Get Course
+// @Description Get a course by ID, supports ETag/If-None-Match and Last-Modified/If-Modified-Since
+// @ID          getCourseV2
+// @Tags  	    course
+// @Produce     json
+// @Param       id path int true "Course ID"
+// @Success     200 {object} entity.Course
+// @Success     304
+// @Failure     400 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /courses/{id} [get]
+func (r *V2) getCourse(ctx *fiber.Ctx) error {
+    var params request.Course
+
+    if err := ctx.ParamsParser(&params); err != nil {
+        r.l.Error(err, "http - v2 - getCourse")
+
+        return invalidRequestResponse(ctx, "invalid course id")
+    }
+
+    if err := r.v.Struct(params); err != nil {
+        r.l.Error(err, "http - v2 - getCourse")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    course, err := r.p.GetCourseById(ctx.UserContext(), params.ID)
+    if err != nil {
+        r.l.Error(err, "http - v2 - getCourse")
+
+        return errorResponse(ctx, err)
+    }
+
+    if updatedAt, err := time.Parse(time.RFC3339, course.UpdatedAt); err == nil && notModified(ctx, updatedAt) {
+        return ctx.SendStatus(http.StatusNotModified)
+    }
+
+    return ctx.Status(http.StatusOK).JSON(course)
+}
@@ -0,0 +1,22 @@
+package v2
+
+import (
+    "github.com/deadnotxaa/education-platform/backend/internal/controller/http/problem"
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+    "github.com/gofiber/fiber/v2"
+)
+
+// errorResponse reports a use case error, domain errors keep their code and other errors become internal_error.
+func errorResponse(ctx *fiber.Ctx, err error) error {
+    return problem.Write(ctx, err)
+}
+
+// invalidRequestResponse reports a request that can't be parsed.
+func invalidRequestResponse(ctx *fiber.Ctx, detail string) error {
+    return problem.WriteDetail(ctx, entity.ErrInvalidRequest, detail)
+}
+
+// validationErrorResponse reports the request fields rejected by the validator.
+func validationErrorResponse(ctx *fiber.Ctx, err error) error {
+    return problem.Validation(ctx, err)
+}
@@ -0,0 +1,112 @@
+package v2
+
+import (
+    "net/http"
+    "time"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/controller/http/v2/request"
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+    "github.com/gofiber/fiber/v2"
+)
+
+// @Summary     Top Courses Report
+// @Description Get the best rated courses with their teachers' work places
+// @ID          getTopCoursesReportV2
+// @Tags  	    report
+// @Produce     json
+// @Param       limit query int false "Number of courses, 10 by default"
+// @Security    BearerAuth
+// @Success     200 {array}  entity.TopCoursesReport
+// @Success     304
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /reports/top-courses [get]
+func (r *V2) getTopCoursesReport(ctx *fiber.Ctx) error {
+    var query request.TopCoursesReport
+
+    if err := ctx.QueryParser(&query); err != nil {
+        r.l.Error(err, "http - v2 - getTopCoursesReport")
+
+        return invalidRequestResponse(ctx, "invalid query parameters")
+    }
+
+    if err := r.v.Struct(query); err != nil {
+        r.l.Error(err, "http - v2 - getTopCoursesReport")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    report, err := r.p.GetTopCoursesReport(ctx.UserContext(), query.Limit)
+    if err != nil {
+        r.l.Error(err, "http - v2 - getTopCoursesReport")
+
+        return errorResponse(ctx, err)
+    }
+
+    ctx.Set(fiber.HeaderCacheControl, "private")
+
+    return ctx.Status(http.StatusOK).JSON(report)
+}
+
+// @Summary     Purchase Report
+// @Description Get the latest purchases with buyer, course, specialization, course type and teachers' work places
+// @ID          getPurchaseReportV2
+// @Tags  	    report
+// @Produce     json
+// @Param       limit             query int    false "Number of purchases, 10 by default"
+// @Param       date_from         query string false "First purchase date, YYYY-MM-DD"
+// @Param       date_to           query string false "Last purchase date, YYYY-MM-DD"
+// @Param       specialization_id query int    false "Course specialization, any by default"
+// @Security    BearerAuth
+// @Success     200 {array}  entity.DetailedPurchaseReport
+// @Success     304
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /reports/purchases [get]
+func (r *V2) getPurchaseReport(ctx *fiber.Ctx) error {
+    var query request.PurchaseReport
+
+    if err := ctx.QueryParser(&query); err != nil {
+        r.l.Error(err, "http - v2 - getPurchaseReport")
+
+        return invalidRequestResponse(ctx, "invalid query parameters")
+    }
+
+    if err := r.v.Struct(query); err != nil {
+        r.l.Error(err, "http - v2 - getPurchaseReport")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    filter := entity.DetailedPurchaseReportFilter{
+        SpecializationID: query.SpecializationID,
+        Limit:            query.Limit,
+    }
+
+    // Formats are already checked by the validator
+    if query.DateFrom != "" {
+        filter.From, _ = time.Parse(time.DateOnly, query.DateFrom)
+    }
+    if query.DateTo != "" {
+        filter.To, _ = time.Parse(time.DateOnly, query.DateTo)
+    }
+
+    if !filter.From.IsZero() && !filter.To.IsZero() && filter.From.After(filter.To) {
+        return invalidRequestResponse(ctx, "date_from is after date_to")
+    }
+
+    report, err := r.p.GetDetailedPurchaseReport(ctx.UserContext(), filter)
+    if err != nil {
+        r.l.Error(err, "http - v2 - getPurchaseReport")
+
+        return errorResponse(ctx, err)
+    }
+
+    ctx.Set(fiber.HeaderCacheControl, "private")
+
+    return ctx.Status(http.StatusOK).JSON(report)
+}
@@ -0,0 +1,22 @@
+package request
+
+type (
+    Course struct {
+        ID int `params:"id" validate:"required,gt=0" example:"1"`
+    }
+
+    User struct {
+        ID int `params:"id" validate:"required,gt=0" example:"42"`
+    }
+)
+
+type TopCoursesReport struct {
+    Limit uint32 `query:"limit" validate:"lte=100" example:"10"` // 10 by default
+}
+
+type PurchaseReport struct {
+    Limit            uint32 `query:"limit"             validate:"lte=100"                        example:"10"` // 10 by default
+    DateFrom         string `query:"date_from"         validate:"omitempty,datetime=2006-01-02" example:"2024-01-01"`
+    DateTo           string `query:"date_to"           validate:"omitempty,datetime=2006-01-02" example:"2024-12-31"`
+    SpecializationID int    `query:"specialization_id" validate:"gte=0"                          example:"1"` // 0 - any
+}
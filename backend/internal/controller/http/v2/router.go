@@ -0,0 +1,52 @@
+package v2
+
+import (
+    "github.com/deadnotxaa/education-platform/backend/internal/controller/http/middleware"
+    "github.com/deadnotxaa/education-platform/backend/internal/controller/http/problem"
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+    "github.com/deadnotxaa/education-platform/backend/internal/usecase"
+    "github.com/deadnotxaa/education-platform/backend/pkg/logger"
+    "github.com/gofiber/fiber/v2"
+)
+
+// authenticated -.
+func (r *V2) authenticated() fiber.Handler {
+    return middleware.Authenticate(r.p)
+}
+
+// require -.
+func (r *V2) require(permission entity.Permission) fiber.Handler {
+    return middleware.RequirePermission(r.p, r.l, permission)
+}
+
+// NewCourseRoutes -.
+func NewCourseRoutes(apiV2Group fiber.Router, p usecase.Platform, l logger.Interface) {
+    r := &V2{p: p, l: l, v: problem.NewValidator()}
+
+    courseGroup := apiV2Group.Group("/courses")
+    {
+        courseGroup.Get("/:id", r.getCourse)
+    }
+}
+
+// NewUserRoutes -.
+func NewUserRoutes(apiV2Group fiber.Router, p usecase.Platform, l logger.Interface) {
+    r := &V2{p: p, l: l, v: problem.NewValidator()}
+
+    userGroup := apiV2Group.Group("/users", r.authenticated())
+    {
+        // Users may read their own data, reading others requires entity.PermissionUserRead
+        userGroup.Get("/:id", r.getUser)
+    }
+}
+
+// NewReportRoutes -.
+func NewReportRoutes(apiV2Group fiber.Router, p usecase.Platform, l logger.Interface) {
+    r := &V2{p: p, l: l, v: problem.NewValidator()}
+
+    reportGroup := apiV2Group.Group("/reports", r.authenticated(), r.require(entity.PermissionReportRead))
+    {
+        reportGroup.Get("/top-courses", r.getTopCoursesReport)
+        reportGroup.Get("/purchases", r.getPurchaseReport)
+    }
+}
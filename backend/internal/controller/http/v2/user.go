@@ -0,0 +1,66 @@
+package v2
+
+import (
+    "net/http"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/controller/http/middleware"
+    "github.com/deadnotxaa/education-platform/backend/internal/controller/http/v2/request"
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+    "github.com/gofiber/fiber/v2"
+)
+
+// @Summary     Get User
+// @Description Get a user by ID, users may read their own data, reading others requires user:read
+// @ID          getUserV2
+// @Tags  	    user
+// @Produce     json
+// @Param       id path int true "User ID"
+// @Security    BearerAuth
+// @Success     200 {object} entity.User
+// @Success     304
+// @Failure     400 {object} response.Problem
+// @Failure     401 {object} response.Problem
+// @Failure     403 {object} response.Problem
+// @Failure     404 {object} response.Problem
+// @Failure     500 {object} response.Problem
+// @Router      /users/{id} [get]
+func (r *V2) getUser(ctx *fiber.Ctx) error {
+    var params request.User
+
+    if err := ctx.ParamsParser(&params); err != nil {
+        r.l.Error(err, "http - v2 - getUser")
+
+        return invalidRequestResponse(ctx, "invalid user id")
+    }
+
+    if err := r.v.Struct(params); err != nil {
+        r.l.Error(err, "http - v2 - getUser")
+
+        return validationErrorResponse(ctx, err)
+    }
+
+    if accountID, _ := middleware.AccountID(ctx); accountID != params.ID {
+        allowed, err := r.p.HasPermission(ctx.UserContext(), accountID, entity.PermissionUserRead)
+        if err != nil {
+            r.l.Error(err, "http - v2 - getUser")
+
+            return errorResponse(ctx, err)
+        }
+
+        if !allowed {
+            return errorResponse(ctx, entity.ErrForbidden)
+        }
+    }
+
+    user, err := r.p.GetUserById(ctx.UserContext(), params.ID)
+    if err != nil {
+        r.l.Error(err, "http - v2 - getUser")
+
+        return errorResponse(ctx, err)
+    }
+
+    // The response depends on the caller, shared caches must not store it
+    ctx.Set(fiber.HeaderCacheControl, "private")
+
+    return ctx.Status(http.StatusOK).JSON(user)
+}
@@ -0,0 +1,12 @@
+// Package entity defines main entities for business logic (services), database mapping, and
+// HTTP response objects if suitable. Each logic group entity in its own file.
+package entity
+
+type (
+    // TokenPair - access and refresh JWTs issued on login, registration and refresh.
+    TokenPair struct {
+        AccessToken  string `json:"access_token"  example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
+        RefreshToken string `json:"refresh_token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
+        ExpiresIn    int64  `json:"expires_in"    example:"900"` // Access token lifetime in seconds
+    }
+)
@@ -2,17 +2,28 @@
 // HTTP response objects if suitable. Each logic group entity in its own file.
 package entity
 
+// BlogPostStatus - publication state of a blog post.
+type BlogPostStatus string
+
+const (
+    BlogPostStatusDraft     BlogPostStatus = "Draft"     // Visible to blog writers only
+    BlogPostStatusScheduled BlogPostStatus = "Scheduled" // Published by a background job at publication_date
+    BlogPostStatusPublished BlogPostStatus = "Published" // Visible to readers
+)
+
 type (
     // BlogPost -.
     BlogPost struct {
-        PostID             int    `json:"post_id"               example:"1"`
-        AuthorID           int    `json:"author_id"             example:"1"`
-        Title              string `json:"title"                 example:"Understanding Go Generics"`
-        PublicationDate    string `json:"publication_date"      example:"2022-01-02"`
-        Topic              string `json:"topic"                 example:"Go Programming"`
-        ReadingTimeMinutes int    `json:"reading_time_minutes"  example:"5"`
-        CoverImageUrl      string `json:"cover_image_url"       example:"https://example.com/cover.jpg"`
-        Content            string `json:"content"               example:"This blog post explains the concept of ..."`
+        PostID             int            `json:"post_id"               example:"1"`
+        AuthorID           int            `json:"author_id"             example:"1"` // Employee ID of the author
+        Title              string         `json:"title"                 example:"Understanding Go Generics"`
+        PublicationDate    string         `json:"publication_date"      example:"2022-01-02T10:00:00Z"`
+        Topic              string         `json:"topic"                 example:"Go Programming"`
+        ReadingTimeMinutes int            `json:"reading_time_minutes"  example:"5"` // Computed from the content
+        CoverImageUrl      string         `json:"cover_image_url"       example:"https://example.com/cover.jpg"`
+        Content            string         `json:"content"               example:"This blog post explains the concept of ..."`
+        Status             BlogPostStatus `json:"status"                example:"Published"`
+        Tags               []string       `json:"tags"                  example:"go,generics"`
     }
 
     // Tag -.
@@ -20,4 +31,18 @@ type (
         TagID int    `json:"id"     example:"1"`
         Name  string `json:"name"   example:"Go Generics"`
     }
+
+    // BlogFilter - filtering and keyset pagination parameters of the published posts listing.
+    BlogFilter struct {
+        Tag    string
+        Topic  string
+        Cursor string // Opaque cursor returned as NextCursor by the previous page
+        Limit  uint32
+    }
+
+    // BlogPostPage - one page of published posts, newest first.
+    BlogPostPage struct {
+        Posts      []BlogPost `json:"posts"`
+        NextCursor string     `json:"next_cursor,omitempty" example:"eyJ0IjoiMjAyMy0wMS0yMFQxMjowMDowMFoiLCJpZCI6NDJ9"`
+    }
 )
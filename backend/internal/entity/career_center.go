@@ -11,6 +11,13 @@ type (
         CVUrl              string `json:"cv_url"                    example:"https://example.com/cv.pdf"`
         CareerSupportStart string `json:"career_support_start_date" example:"2022-01-01"`
         SupportPeriod      int    `json:"support_period"            example:"6"` // Support period in days
+        SupportEndDate     string `json:"career_support_end_date"   example:"2022-01-07"` // Last day of support
+    }
+
+    // CareerStudentPage - page of students in the career center.
+    CareerStudentPage struct {
+        Students []CareerCenterStudent `json:"students"`
+        NextID   int                   `json:"next_after_id,omitempty" example:"42"` // Pass as after_id to get the next page
     }
 
     // PartnerCompany - represents a company that partners with the career center.
@@ -20,14 +27,94 @@ type (
         FullName            string `json:"full_name"               example:"BigTech Company LLC"`
         HiredGraduatesCount int    `json:"hired_graduates_count"   example:"10"`
         Requirements        string `json:"requirements"            example:"Good communication skills, knowledge of Go"`
-        AgreementStatus     bool   `json:"agreement_status"        example:"active"` // Status of the partnership
+        AgreementStatus     bool   `json:"agreement_status"        example:"true"` // Status of the partnership
+    }
+
+    // PartnerCompanyPage - page of partner companies.
+    PartnerCompanyPage struct {
+        Companies []PartnerCompany `json:"companies"`
+        NextID    int              `json:"next_after_id,omitempty" example:"42"` // Pass as after_id to get the next page
+    }
+
+    // CareerStudentSkills - technologies of the course topics a career center student has completed.
+    CareerStudentSkills struct {
+        Student         CareerCenterStudent
+        CompletedTopics int
+        Technologies    []string // Raw technologies text of every completed topic
+    }
+
+    // StudentMatch - how well a career center student fits the requirements of a partner company.
+    StudentMatch struct {
+        Student         CareerCenterStudent `json:"student"`
+        Score           int                 `json:"score"            example:"75"` // Percent of requirement keywords covered
+        CompletedTopics int                 `json:"completed_topics" example:"8"`
+        MatchedKeywords []string            `json:"matched_keywords" example:"go,postgresql,docker"`
+        MissingKeywords []string            `json:"missing_keywords" example:"kubernetes"`
+        Explanation     string              `json:"explanation"      example:"Covers 3 of 4 requirement keywords (go, postgresql, docker), missing: kubernetes"`
+    }
+
+    // CompanyMatches - career center students ranked for a partner company.
+    CompanyMatches struct {
+        CompanyID int            `json:"company_id" example:"1"`
+        Keywords  []string       `json:"keywords"   example:"go,postgresql,docker,kubernetes"` // Extracted from the requirements
+        Matches   []StudentMatch `json:"matches"`
     }
 
     // JobApplication - represents a job application submitted by a student to a partner company.
     JobApplication struct {
-        ID              int    `json:"id"                        example:"1"`
-        StudentID       int    `json:"student_id"                example:"1"` // ID of the student who applied
-        ApplicationDate string `json:"application_date"          example:"2022-01-01"`
-        Status          string `json:"status"                    example:"active"`
+        ID              int                  `json:"id"                        example:"1"`
+        StudentID       int                  `json:"student_id"                example:"1"` // ID of the student who applied
+        CompanyID       int                  `json:"company_id"                example:"1"`
+        ApplicationDate string               `json:"application_date"          example:"2022-01-01"`
+        Status          JobApplicationStatus `json:"status"                    example:"Submitted"`
     }
+
+    // JobApplicationTransition - status change of a job application.
+    JobApplicationTransition struct {
+        ID            int                  `json:"id"                    example:"1"`
+        ApplicationID int                  `json:"application_id"        example:"1"`
+        FromStatus    JobApplicationStatus `json:"from_status,omitempty" example:"Interview"` // Empty for the first status
+        ToStatus      JobApplicationStatus `json:"to_status"             example:"Offer"`
+        ActorID       int                  `json:"actor_id,omitempty"    example:"7"` // Account that changed the status
+        Comment       string               `json:"comment,omitempty"     example:"Offer sent by email"`
+        ChangedAt     string               `json:"changed_at"            example:"2023-01-20T12:00:00Z"`
+    }
+)
+
+// SupportActiveOn reports whether day (YYYY-MM-DD) is within the support period, both ends included.
+func (s CareerCenterStudent) SupportActiveOn(day string) bool {
+    return s.CareerSupportStart != "" && s.CareerSupportStart <= day && day <= s.SupportEndDate
+}
+
+// JobApplicationStatus - stage of a job application in the hiring pipeline.
+type JobApplicationStatus string
+
+const (
+    JobApplicationStatusSubmitted JobApplicationStatus = "Submitted" // Sent by the student
+    JobApplicationStatusScreening JobApplicationStatus = "Screening" // CV is reviewed by the company
+    JobApplicationStatusInterview JobApplicationStatus = "Interview" // Interviews are in progress
+    JobApplicationStatusOffer     JobApplicationStatus = "Offer"     // The company made an offer
+    JobApplicationStatusHired     JobApplicationStatus = "Hired"     // The student accepted the offer
+    JobApplicationStatusRejected  JobApplicationStatus = "Rejected"  // Closed by either side at any open stage
 )
+
+// CanTransitionTo reports whether an application may move from s to next.
+// Applications move one stage forward at a time or get rejected, Hired and Rejected are final.
+func (s JobApplicationStatus) CanTransitionTo(next JobApplicationStatus) bool {
+    if next == JobApplicationStatusRejected {
+        return s != JobApplicationStatusHired && s != JobApplicationStatusRejected
+    }
+
+    switch s {
+    case JobApplicationStatusSubmitted:
+        return next == JobApplicationStatusScreening
+    case JobApplicationStatusScreening:
+        return next == JobApplicationStatusInterview
+    case JobApplicationStatusInterview:
+        return next == JobApplicationStatusOffer
+    case JobApplicationStatusOffer:
+        return next == JobApplicationStatusHired
+    default:
+        return false
+    }
+}
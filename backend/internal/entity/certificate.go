@@ -0,0 +1,21 @@
+// Package entity defines main entities for business logic (services), database mapping, and
+// HTTP response objects if suitable. Each logic group entity in its own file.
+package entity
+
+type (
+    // CertificateDetails - everything printed on a certificate.
+    CertificateDetails struct {
+        Certificate
+        StudentName string
+        CourseName  string
+        Teachers    []string
+    }
+
+    // CertificateVerification - public confirmation of a certificate, without personal data of the student.
+    CertificateVerification struct {
+        Valid      bool     `json:"valid"       example:"true"`
+        CourseName string   `json:"course_name" example:"Introduction to Go"`
+        IssueDate  string   `json:"issue_date"  example:"2023-01-20"`
+        Teachers   []string `json:"teachers"    example:"Ivan Petrov"`
+    }
+)
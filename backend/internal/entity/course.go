@@ -32,20 +32,22 @@ type (
 
     // CourseReview -.
     CourseReview struct {
-        ReviewID   int    `json:"id"              example:"1"`
-        CourseID   int    `json:"course_id"       example:"1"`
-        UserID     int    `json:"user_id"         example:"42"`
-        Rating     int    `json:"rating"          example:"5"` // Rating from 1 to 5
-        Comment    string `json:"comment"         example:"Great course, learned a lot!"`
-        ReviewDate string `json:"review_date"     example:"2023-01-15T00:00:00Z"`
+        ReviewID   int          `json:"id"              example:"1"`
+        CourseID   int          `json:"course_id"       example:"1"`
+        UserID     int          `json:"user_id"         example:"42"`
+        Rating     int          `json:"rating"          example:"5"` // Rating from 1 to 5
+        Comment    string       `json:"comment"         example:"Great course, learned a lot!"`
+        ReviewDate string       `json:"review_date"     example:"2023-01-15T00:00:00Z"`
+        Status     ReviewStatus `json:"status"          example:"Pending"`
     }
 
     // Certificate -.
     Certificate struct {
-        CertificateID int    `json:"id"               example:"1"`
-        UserID        int    `json:"user_id"          example:"42"`
-        CourseID      int    `json:"course_id"        example:"1"`
-        IssueDate     string `json:"issue_date"       example:"2023-01-20T00:00:00Z"`
+        CertificateID    int    `json:"id"                example:"1"`
+        UserID           int    `json:"user_id"           example:"42"`
+        CourseID         int    `json:"course_id"         example:"1"`
+        IssueDate        string `json:"issue_date"        example:"2023-01-20T00:00:00Z"`
+        VerificationCode string `json:"verification_code" example:"aaaaaaaaaaaaaaaqmnb3ry2zf6lhyzhvbi"` // Public, signed by the server
     }
 
     // CourseCalendar -.
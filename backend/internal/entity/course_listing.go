@@ -0,0 +1,41 @@
+// Package entity defines main entities for business logic (services), database mapping, and
+// HTTP response objects if suitable. Each logic group entity in its own file.
+package entity
+
+// CourseSortField - column used to order a course listing.
+type CourseSortField string
+
+const (
+    CourseSortByID        CourseSortField = "id"
+    CourseSortByPrice     CourseSortField = "price"
+    CourseSortByRating    CourseSortField = "rating"
+    CourseSortByCreatedAt CourseSortField = "created_at"
+)
+
+type (
+    // CourseFilter - filtering, sorting and keyset pagination parameters of a course listing.
+    CourseFilter struct {
+        SpecializationID  int
+        DifficultyLevelID int
+        MinPrice          *int
+        MaxPrice          *int
+        MinDuration       *int
+        MaxDuration       *int
+        SortBy            CourseSortField
+        Descending        bool
+        Cursor            string // Opaque cursor returned as NextCursor by the previous page
+        Limit             uint32
+    }
+
+    // CourseListItem - course with aggregated data used in the catalog.
+    CourseListItem struct {
+        Course
+        AverageRating float64 `json:"average_rating" example:"4.5"`
+    }
+
+    // CoursePage - one page of a course listing.
+    CoursePage struct {
+        Courses    []CourseListItem `json:"courses"`
+        NextCursor string           `json:"next_cursor,omitempty" example:"eyJ2IjoxOTk5OSwiaWQiOjQyfQ"`
+    }
+)
@@ -20,4 +20,20 @@ type (
         Name        string `json:"name"                    example:"Go Basics Project"`
         Description string `json:"description"             example:"A project to apply basic Go concepts"`
     }
+
+    // SyllabusTopic - topic of a course at its position in the learning path.
+    SyllabusTopic struct {
+        CourseTopic
+        Position int       `json:"position"                 example:"1"`
+        Projects []Project `json:"projects"`
+    }
+
+    // Syllabus - ordered topics of a course with their projects.
+    Syllabus struct {
+        CourseID                 int             `json:"course_id"                   example:"1"`
+        CourseName               string          `json:"course_name"                 example:"Introduction to Go"`
+        TotalLaborIntensityHours int             `json:"total_labor_intensity_hours" example:"120"`
+        TotalProjects            int             `json:"total_projects"              example:"8"`
+        Topics                   []SyllabusTopic `json:"topics"`
+    }
 )
@@ -2,6 +2,15 @@
 // HTTP response objects if suitable. Each logic group entity in its own file.
 package entity
 
+// StaffRole - role of an employee in a course, an employee is assigned only to the role matching own role.
+type StaffRole string
+
+const (
+    StaffRoleTeacher  StaffRole = RoleTeacher
+    StaffRoleMentor   StaffRole = RoleMentor
+    StaffRoleReviewer StaffRole = RoleReviewer
+)
+
 type (
     // Role -.
     Role struct {
@@ -11,9 +20,10 @@ type (
 
     // Employee -.
     Employee struct {
-        ID     int `json:"id"           example:"1"`
-        UserID int `json:"user_id"      example:"1"`
-        RoleID int `json:"role_id"      example:"2"`
+        ID       int    `json:"id"           example:"1"`
+        UserID   int    `json:"user_id"      example:"1"`
+        RoleID   int    `json:"role_id"      example:"2"`
+        RoleName string `json:"role_name"    example:"Teacher"`
     }
 
     // Teacher -.
@@ -22,5 +32,40 @@ type (
         WorkPlace                string `json:"work_place"                  example:"Some BigTech Company"`
         OverallExperience        int    `json:"overall_experience"          example:"5"`
         SpecializationExperience int    `json:"specialization_experience"   example:"3"`
+        PhotoURL                 string `json:"photo_url"                   example:"https://example.com/photo.jpg"`
+        Bio                      string `json:"bio"                         example:"Backend engineer teaching Go for 3 years"`
+    }
+
+    // TeacherProfile - public teacher page with the rating of the courses the teacher teaches.
+    TeacherProfile struct {
+        Teacher
+        Name          string          `json:"name"           example:"Ivan"`
+        Surname       string          `json:"surname"        example:"Petrov"`
+        AverageRating float64         `json:"average_rating" example:"4.5"` // Approved reviews of the taught courses
+        ReviewsCount  int             `json:"reviews_count"  example:"120"`
+        Courses       []TeacherCourse `json:"courses,omitempty"`
+        UserID        int             `json:"-"`
+    }
+
+    // TeacherCourse - course the employee is assigned to.
+    TeacherCourse struct {
+        CourseID  int       `json:"course_id"  example:"1"`
+        Name      string    `json:"name"       example:"Go Developer"`
+        StaffRole StaffRole `json:"staff_role" example:"Teacher"`
+    }
+
+    // TeacherPage - one page of teacher profiles without their courses.
+    TeacherPage struct {
+        Teachers []TeacherProfile `json:"teachers"`
+        NextID   int              `json:"next_after_id,omitempty" example:"42"`
+    }
+
+    // CourseStaffMember - employee assigned to a course.
+    CourseStaffMember struct {
+        CourseID   int       `json:"course_id"   example:"1"`
+        EmployeeID int       `json:"employee_id" example:"3"`
+        Name       string    `json:"name"        example:"Ivan"`
+        Surname    string    `json:"surname"     example:"Petrov"`
+        StaffRole  StaffRole `json:"staff_role"  example:"Mentor"`
     }
 )
@@ -0,0 +1,231 @@
+// Package entity defines main entities for business logic (services), database mapping, and
+// HTTP response objects if suitable. Each logic group entity in its own file.
+package entity
+
+import "errors"
+
+// ErrorKind - category of a domain error, tells clients how to react to it.
+type ErrorKind string
+
+const (
+    ErrorKindNotFound     ErrorKind = "not_found"    // Requested entity doesn't exist
+    ErrorKindConflict     ErrorKind = "conflict"     // Current state of the entity doesn't allow the action
+    ErrorKindValidation   ErrorKind = "validation"   // Request is malformed or references unknown entities
+    ErrorKindForbidden    ErrorKind = "forbidden"    // Account isn't allowed to perform the action
+    ErrorKindUnauthorized ErrorKind = "unauthorized" // Credentials or tokens are missing or invalid
+    ErrorKindInternal     ErrorKind = "internal"     // Unexpected failure, the details are only logged
+)
+
+// DomainError - business rule violation with a stable machine-readable code, compared with errors.Is
+// and extracted from wrapped errors with errors.As.
+type DomainError struct {
+    Kind    ErrorKind `json:"kind"    example:"not_found"`
+    Code    string    `json:"code"    example:"course_not_found"`
+    Message string    `json:"message" example:"course not found"`
+}
+
+func (e *DomainError) Error() string {
+    return e.Message
+}
+
+// _errorCatalog - every domain error in declaration order, see ErrorCatalog.
+var _errorCatalog []*DomainError
+
+func newError(kind ErrorKind, code, message string) *DomainError {
+    e := &DomainError{Kind: kind, Code: code, Message: message}
+    _errorCatalog = append(_errorCatalog, e)
+
+    return e
+}
+
+// ErrorCatalog returns every domain error the platform reports to clients.
+func ErrorCatalog() []DomainError {
+    catalog := make([]DomainError, 0, len(_errorCatalog))
+    for _, e := range _errorCatalog {
+        catalog = append(catalog, *e)
+    }
+
+    return catalog
+}
+
+var (
+    // ErrInvalidRequest - request body, path or query can't be parsed.
+    ErrInvalidRequest = newError(ErrorKindValidation, "invalid_request", "invalid request")
+
+    // ErrValidationFailed - request fields break validation rules, the fields are listed in the response.
+    ErrValidationFailed = newError(ErrorKindValidation, "validation_failed", "request validation failed")
+
+    // ErrRouteNotFound - no route or catalog entry matches the request path.
+    ErrRouteNotFound = newError(ErrorKindNotFound, "route_not_found", "resource not found")
+
+    // ErrUnauthenticated - access token is missing.
+    ErrUnauthenticated = newError(ErrorKindUnauthorized, "unauthenticated", "missing access token")
+
+    // ErrInvalidQuery - GraphQL query can't be parsed or doesn't match the schema.
+    ErrInvalidQuery = newError(ErrorKindValidation, "invalid_query", "invalid GraphQL query")
+
+    // ErrQueryTooComplex - GraphQL query would resolve more fields than allowed.
+    ErrQueryTooComplex = newError(ErrorKindValidation, "query_too_complex", "GraphQL query is too complex")
+
+    // ErrInternal - unexpected failure of the platform or its dependencies.
+    ErrInternal = newError(ErrorKindInternal, "internal_error", "internal server error")
+
+    // ErrCourseNotFound - course doesn't exist or was deleted.
+    ErrCourseNotFound = newError(ErrorKindNotFound, "course_not_found", "course not found")
+
+    // ErrSpecializationNotFound - referenced course specialization doesn't exist.
+    ErrSpecializationNotFound = newError(ErrorKindValidation, "specialization_not_found", "course specialization not found")
+
+    // ErrDifficultyLevelNotFound - referenced difficulty level doesn't exist.
+    ErrDifficultyLevelNotFound = newError(ErrorKindValidation, "difficulty_level_not_found", "difficulty level not found")
+
+    // ErrInvalidCursor - pagination cursor is malformed or doesn't match the requested sorting.
+    ErrInvalidCursor = newError(ErrorKindValidation, "invalid_cursor", "invalid pagination cursor")
+
+    // ErrUserNotFound - user account doesn't exist.
+    ErrUserNotFound = newError(ErrorKindNotFound, "user_not_found", "user not found")
+
+    // ErrEmailTaken - another account is already registered with this email.
+    ErrEmailTaken = newError(ErrorKindConflict, "email_taken", "email is already registered")
+
+    // ErrInvalidCredentials - email or password doesn't match.
+    ErrInvalidCredentials = newError(ErrorKindUnauthorized, "invalid_credentials", "invalid email or password")
+
+    // ErrInvalidToken - token is malformed, expired, revoked or of the wrong type.
+    ErrInvalidToken = newError(ErrorKindUnauthorized, "invalid_token", "invalid token")
+
+    // ErrForbidden - account isn't allowed to perform the action.
+    ErrForbidden = newError(ErrorKindForbidden, "forbidden", "permission denied")
+
+    // ErrCourseCalendarNotFound - course cohort doesn't exist.
+    ErrCourseCalendarNotFound = newError(ErrorKindNotFound, "course_calendar_not_found", "course calendar not found")
+
+    // ErrCourseTypeNotFound - referenced course type (discount) doesn't exist.
+    ErrCourseTypeNotFound = newError(ErrorKindValidation, "course_type_not_found", "course type not found")
+
+    // ErrSalesClosed - end of sales date of the cohort has passed.
+    ErrSalesClosed = newError(ErrorKindConflict, "sales_closed", "sales for the course are closed")
+
+    // ErrNoPlacesLeft - the cohort has no remaining places.
+    ErrNoPlacesLeft = newError(ErrorKindConflict, "no_places_left", "no places left")
+
+    // ErrPurchaseNotFound - purchase doesn't exist.
+    ErrPurchaseNotFound = newError(ErrorKindNotFound, "purchase_not_found", "purchase not found")
+
+    // ErrInvalidPurchaseTransition - purchase status can't be changed this way.
+    ErrInvalidPurchaseTransition = newError(ErrorKindConflict, "invalid_purchase_transition", "invalid purchase status transition")
+
+    // ErrPaymentNotFound - payment intent doesn't exist or the purchase has no payment.
+    ErrPaymentNotFound = newError(ErrorKindNotFound, "payment_not_found", "payment not found")
+
+    // ErrPaymentExists - the purchase already has a payment intent, it is confirmed instead of created again.
+    ErrPaymentExists = newError(ErrorKindConflict, "payment_exists", "purchase already has a payment")
+
+    // ErrRefundRequired - a paid purchase is cancelled by refunding its payment.
+    ErrRefundRequired = newError(ErrorKindConflict, "refund_required", "paid purchase must be refunded to be cancelled")
+
+    // ErrInvalidWebhookSignature - webhook payload isn't signed by the payment provider.
+    ErrInvalidWebhookSignature = newError(ErrorKindUnauthorized, "invalid_webhook_signature", "invalid webhook signature")
+
+    // ErrReviewNotFound - course review doesn't exist.
+    ErrReviewNotFound = newError(ErrorKindNotFound, "review_not_found", "review not found")
+
+    // ErrReviewExists - the user has already reviewed the course.
+    ErrReviewExists = newError(ErrorKindConflict, "review_exists", "course is already reviewed")
+
+    // ErrCourseNotPurchased - only students with a completed purchase can review a course.
+    ErrCourseNotPurchased = newError(ErrorKindForbidden, "course_not_purchased", "course is not purchased")
+
+    // ErrReviewNotPending - only reviews waiting for moderation can be approved or rejected.
+    ErrReviewNotPending = newError(ErrorKindConflict, "review_not_pending", "review is not pending moderation")
+
+    // ErrCertificateNotFound - certificate doesn't exist or its verification code is forged.
+    ErrCertificateNotFound = newError(ErrorKindNotFound, "certificate_not_found", "certificate not found")
+
+    // ErrTopicNotFound - course topic doesn't exist or isn't part of the course.
+    ErrTopicNotFound = newError(ErrorKindNotFound, "topic_not_found", "course topic not found")
+
+    // ErrProjectNotFound - topic project doesn't exist.
+    ErrProjectNotFound = newError(ErrorKindNotFound, "project_not_found", "project not found")
+
+    // ErrInvalidTopicOrder - new topic order must list every topic of the course exactly once.
+    ErrInvalidTopicOrder = newError(ErrorKindValidation, "invalid_topic_order", "invalid topic order")
+
+    // ErrSubmissionNotFound - project submission doesn't exist.
+    ErrSubmissionNotFound = newError(ErrorKindNotFound, "submission_not_found", "project submission not found")
+
+    // ErrSubmissionNotPending - only submissions waiting for review can be graded.
+    ErrSubmissionNotPending = newError(ErrorKindConflict, "submission_not_pending", "project submission is not waiting for review")
+
+    // ErrProjectAccepted - an accepted project can't be submitted again.
+    ErrProjectAccepted = newError(ErrorKindConflict, "project_accepted", "project is already accepted")
+
+    // ErrInvalidCalendarDates - sales of a cohort must end no later than it starts.
+    ErrInvalidCalendarDates = newError(ErrorKindValidation, "invalid_calendar_dates", "end of sales date is after the start date")
+
+    // ErrPlacesAvailable - the cohort isn't sold out, the course can be purchased without waiting.
+    ErrPlacesAvailable = newError(ErrorKindConflict, "places_available", "places are available")
+
+    // ErrAlreadyInWaitlist - the user is already waiting for a seat in the cohort.
+    ErrAlreadyInWaitlist = newError(ErrorKindConflict, "already_in_waitlist", "already in the waitlist")
+
+    // ErrWaitlistEntryNotFound - the user isn't waiting for a seat in the cohort.
+    ErrWaitlistEntryNotFound = newError(ErrorKindNotFound, "waitlist_entry_not_found", "waitlist entry not found")
+
+    // ErrCareerStudentNotFound - the graduate isn't enrolled into career support for the course.
+    ErrCareerStudentNotFound = newError(ErrorKindNotFound, "career_student_not_found", "career center student not found")
+
+    // ErrAlreadyEnrolled - the graduate is already enrolled into career support for the course.
+    ErrAlreadyEnrolled = newError(ErrorKindConflict, "already_enrolled", "already enrolled into career support")
+
+    // ErrCertificateRequired - only graduates with a certificate for the course get career support.
+    ErrCertificateRequired = newError(ErrorKindConflict, "certificate_required", "certificate for the course is required")
+
+    // ErrCareerSupportInactive - the support period of the graduate hasn't started yet or is over.
+    ErrCareerSupportInactive = newError(ErrorKindConflict, "career_support_inactive", "career support period is not active")
+
+    // ErrJobApplicationExists - the graduate already has an open application to the company.
+    ErrJobApplicationExists = newError(ErrorKindConflict, "job_application_exists", "open job application to the company already exists")
+
+    // ErrJobApplicationNotFound - job application doesn't exist.
+    ErrJobApplicationNotFound = newError(ErrorKindNotFound, "job_application_not_found", "job application not found")
+
+    // ErrInvalidJobApplicationTransition - the application can't move to the requested stage.
+    ErrInvalidJobApplicationTransition = newError(ErrorKindConflict, "invalid_job_application_transition", "invalid job application status transition")
+
+    // ErrPartnerCompanyNotFound - partner company doesn't exist.
+    ErrPartnerCompanyNotFound = newError(ErrorKindNotFound, "partner_company_not_found", "partner company not found")
+
+    // ErrAgreementInactive - the company has no active partnership agreement, students can't apply to it.
+    ErrAgreementInactive = newError(ErrorKindConflict, "agreement_inactive", "partnership agreement is inactive")
+
+    // ErrPartnerCompanyInUse - job applications reference the company, deactivate its agreement instead.
+    ErrPartnerCompanyInUse = newError(ErrorKindConflict, "partner_company_in_use", "partner company has job applications")
+
+    // ErrBlogPostNotFound - blog post doesn't exist or isn't published yet.
+    ErrBlogPostNotFound = newError(ErrorKindNotFound, "blog_post_not_found", "blog post not found")
+
+    // ErrBlogPostPublished - a published post can't be scheduled or published again.
+    ErrBlogPostPublished = newError(ErrorKindConflict, "blog_post_published", "blog post is already published")
+
+    // ErrPublicationDateInPast - a post can only be scheduled for a future date.
+    ErrPublicationDateInPast = newError(ErrorKindValidation, "publication_date_in_past", "publication date is in the past")
+
+    // ErrEmployeeNotFound -.
+    ErrEmployeeNotFound = newError(ErrorKindNotFound, "employee_not_found", "employee not found")
+
+    // ErrTeacherNotFound - employee has no teacher profile.
+    ErrTeacherNotFound = newError(ErrorKindNotFound, "teacher_not_found", "teacher not found")
+
+    // ErrStaffRoleMismatch - employee's role differs from the course staff role or the profile kind.
+    ErrStaffRoleMismatch = newError(ErrorKindConflict, "staff_role_mismatch", "employee role doesn't match the staff role")
+
+    // ErrStaffAlreadyAssigned - employee already has this role in the course.
+    ErrStaffAlreadyAssigned = newError(ErrorKindConflict, "staff_already_assigned", "employee is already assigned to the course")
+
+    // ErrStaffNotAssigned - employee doesn't have this role in the course.
+    ErrStaffNotAssigned = newError(ErrorKindConflict, "staff_not_assigned", "employee is not assigned to the course")
+
+    // ErrCacheMiss - value isn't cached, the source of truth has to be queried.
+    ErrCacheMiss = errors.New("cache miss")
+)
@@ -0,0 +1,125 @@
+// Package entity defines main entities for business logic (services), database mapping, and
+// HTTP response objects if suitable. Each logic group entity in its own file.
+package entity
+
+import (
+    "encoding/json"
+    "fmt"
+)
+
+// EventType - name of a domain event, consumers route on it.
+type EventType string
+
+const (
+    EventPurchaseCreated   EventType = "purchase.created"
+    EventPurchaseCompleted EventType = "purchase.completed"
+    EventPurchaseCancelled EventType = "purchase.cancelled"
+    EventReviewCreated     EventType = "review.created"
+    EventReviewUpdated     EventType = "review.updated"
+    EventReviewDeleted     EventType = "review.deleted"
+    EventReviewModerated   EventType = "review.moderated"
+    EventCertificateIssued EventType = "certificate.issued"
+)
+
+// Payload schema versions, a change that breaks consumers adds a new payload type and version
+// instead of editing the current one.
+const (
+    PurchaseEventVersion    = 1 // PurchaseEventV1
+    ReviewEventVersion      = 1 // ReviewEventV1
+    CertificateEventVersion = 1 // CertificateEventV1
+)
+
+type (
+    // Event - domain event written to the outbox in the transaction of the change and published later.
+    // Delivery is at least once, consumers deduplicate by ID.
+    Event struct {
+        ID          int64           `json:"id"           example:"1"`
+        Type        EventType       `json:"type"         example:"purchase.created"`
+        Version     int             `json:"version"      example:"1"`
+        AggregateID int             `json:"aggregate_id" example:"42"` // ID of the purchase, review or certificate
+        Payload     json.RawMessage `json:"payload"`
+        OccurredAt  string          `json:"occurred_at"  example:"2023-01-20T12:00:00Z"`
+        Attempts    int             `json:"-"`                          // Publishing attempts so far
+    }
+
+    // PurchaseEventV1 - payload of purchase.* events.
+    PurchaseEventV1 struct {
+        PurchaseID   int    `json:"purchase_id"        example:"1"`
+        UserID       int    `json:"user_id"            example:"42"`
+        CourseID     int    `json:"course_id"          example:"1"`
+        CalendarID   int    `json:"course_calendar_id" example:"1"`
+        CourseTypeID int    `json:"course_type_id"     example:"1"`
+        TotalPrice   int    `json:"total_price"        example:"17999"`
+        Status       string `json:"status"             example:"Pending"`
+    }
+
+    // ReviewEventV1 - payload of review.* events.
+    ReviewEventV1 struct {
+        ReviewID    int          `json:"review_id"              example:"1"`
+        CourseID    int          `json:"course_id"              example:"1"`
+        UserID      int          `json:"user_id"                example:"42"`
+        Rating      int          `json:"rating"                 example:"5"`
+        Status      ReviewStatus `json:"status"                 example:"Approved"`
+        ModeratorID int          `json:"moderator_id,omitempty" example:"7"` // Set by review.moderated and by review.deleted of a moderator
+    }
+
+    // CertificateEventV1 - payload of certificate.issued.
+    CertificateEventV1 struct {
+        CertificateID    int    `json:"certificate_id"    example:"1"`
+        UserID           int    `json:"user_id"           example:"42"`
+        CourseID         int    `json:"course_id"         example:"1"`
+        IssueDate        string `json:"issue_date"        example:"2023-01-20"`
+        VerificationCode string `json:"verification_code" example:"aaaaaaaaaaaaaaaqmnb3ry2zf6lhyzhvbi"`
+    }
+)
+
+// NewEvent encodes the payload of an event, the ID and the time are set by the outbox.
+func NewEvent(eventType EventType, version, aggregateID int, payload any) (Event, error) {
+    data, err := json.Marshal(payload)
+    if err != nil {
+        return Event{}, fmt.Errorf("entity - NewEvent - json.Marshal: %w", err)
+    }
+
+    return Event{
+        Type:        eventType,
+        Version:     version,
+        AggregateID: aggregateID,
+        Payload:     data,
+    }, nil
+}
+
+// NewPurchaseEvent -.
+func NewPurchaseEvent(eventType EventType, purchase Purchase) (Event, error) {
+    return NewEvent(eventType, PurchaseEventVersion, purchase.PurchaseID, PurchaseEventV1{
+        PurchaseID:   purchase.PurchaseID,
+        UserID:       purchase.UserID,
+        CourseID:     purchase.CourseID,
+        CalendarID:   purchase.CalendarID,
+        CourseTypeID: purchase.CourseTypeID,
+        TotalPrice:   purchase.TotalPrice,
+        Status:       purchase.PurchaseStatus.String(),
+    })
+}
+
+// NewReviewEvent -.
+func NewReviewEvent(eventType EventType, review CourseReview, moderatorID int) (Event, error) {
+    return NewEvent(eventType, ReviewEventVersion, review.ReviewID, ReviewEventV1{
+        ReviewID:    review.ReviewID,
+        CourseID:    review.CourseID,
+        UserID:      review.UserID,
+        Rating:      review.Rating,
+        Status:      review.Status,
+        ModeratorID: moderatorID,
+    })
+}
+
+// NewCertificateEvent -.
+func NewCertificateEvent(certificate Certificate) (Event, error) {
+    return NewEvent(EventCertificateIssued, CertificateEventVersion, certificate.CertificateID, CertificateEventV1{
+        CertificateID:    certificate.CertificateID,
+        UserID:           certificate.UserID,
+        CourseID:         certificate.CourseID,
+        IssueDate:        certificate.IssueDate,
+        VerificationCode: certificate.VerificationCode,
+    })
+}
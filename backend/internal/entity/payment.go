@@ -0,0 +1,34 @@
+// Package entity defines main entities for business logic (services), database mapping, and
+// HTTP response objects if suitable. Each logic group entity in its own file.
+package entity
+
+// PaymentSignatureHeader - HTTP header carrying the signature of a payment webhook.
+const PaymentSignatureHeader = "X-Payment-Signature"
+
+// PaymentEventType - kind of notification sent by a payment provider.
+type PaymentEventType string
+
+const (
+    PaymentEventSucceeded PaymentEventType = "payment.succeeded"
+    PaymentEventFailed    PaymentEventType = "payment.failed"
+    PaymentEventRefunded  PaymentEventType = "payment.refunded"
+)
+
+type (
+    // PaymentIntent - payment created at the provider for a purchase.
+    PaymentIntent struct {
+        ID           string `json:"id"            example:"pi_3f9a1c2b7d"`
+        PurchaseID   int    `json:"purchase_id"   example:"1"`
+        Amount       int    `json:"amount"        example:"17999"`
+        ClientSecret string `json:"client_secret" example:"pi_3f9a1c2b7d_secret_81bd"` // Used by the frontend to pay
+    }
+
+    // PaymentEvent - verified webhook notification of a payment provider.
+    PaymentEvent struct {
+        ID         string           `json:"id"          example:"evt_5e2d8a"`
+        Type       PaymentEventType `json:"type"        example:"payment.succeeded"`
+        IntentID   string           `json:"intent_id"   example:"pi_3f9a1c2b7d"`
+        PurchaseID int              `json:"purchase_id" example:"1"`
+        Amount     int              `json:"amount"      example:"17999"`
+    }
+)
@@ -0,0 +1,87 @@
+// Package entity defines main entities for business logic (services), database mapping, and
+// HTTP response objects if suitable. Each logic group entity in its own file.
+package entity
+
+// TopicStatus - progress of a student through a course topic.
+type TopicStatus string
+
+const (
+    TopicStatusNotStarted TopicStatus = "NotStarted"
+    TopicStatusInProgress TopicStatus = "InProgress"
+    TopicStatusCompleted  TopicStatus = "Completed"
+)
+
+// SubmissionStatus - review state of a project solution, only accepted projects count as completed.
+type SubmissionStatus string
+
+const (
+    SubmissionStatusNotSubmitted SubmissionStatus = "NotSubmitted"
+    SubmissionStatusSubmitted    SubmissionStatus = "Submitted"
+    SubmissionStatusAccepted     SubmissionStatus = "Accepted"
+    SubmissionStatusRejected     SubmissionStatus = "Rejected"
+)
+
+type (
+    // ProjectSubmission - latest solution of a project by a student and its grade.
+    ProjectSubmission struct {
+        SubmissionID  int              `json:"id"                       example:"1"`
+        UserID        int              `json:"user_id"                  example:"42"`
+        CourseID      int              `json:"course_id"                example:"1"`
+        ProjectID     int              `json:"project_id"               example:"3"`
+        Status        SubmissionStatus `json:"status"                   example:"Accepted"`
+        SolutionURL   string           `json:"solution_url"             example:"https://github.com/student/go-basics"`
+        SubmittedAt   string           `json:"submitted_at"             example:"2023-01-20T12:00:00Z"`
+        ReviewerID    int              `json:"reviewer_id,omitempty"    example:"7"`
+        Grade         *int             `json:"grade,omitempty"          example:"90"`
+        ReviewComment string           `json:"review_comment,omitempty" example:"Clean code, well tested"`
+        ReviewedAt    string           `json:"reviewed_at,omitempty"    example:"2023-01-21T09:30:00Z"`
+    }
+
+    // SubmissionPage - page of the project review queue.
+    SubmissionPage struct {
+        Submissions []ProjectSubmission `json:"submissions"`
+        NextCursor  string              `json:"next_cursor,omitempty" example:"eyJ0IjoiMjAyMy0wMS0yMFQxMjowMDowMC4xMjM0NTZaIiwiaWQiOjQyfQ"` // Pass as cursor to get the next page
+    }
+
+    // ProjectProgress - state of a topic project for a student, submission fields are empty until it is submitted.
+    ProjectProgress struct {
+        ProjectID     int              `json:"project_id"               example:"3"`
+        Name          string           `json:"name"                     example:"Go Basics Project"`
+        Status        SubmissionStatus `json:"status"                   example:"Submitted"`
+        SubmissionID  int              `json:"submission_id,omitempty"  example:"1"`
+        SubmittedAt   string           `json:"submitted_at,omitempty"   example:"2023-01-20T12:00:00Z"`
+        Grade         *int             `json:"grade,omitempty"          example:"90"`
+        ReviewComment string           `json:"review_comment,omitempty" example:"Clean code, well tested"`
+        ReviewedAt    string           `json:"reviewed_at,omitempty"    example:"2023-01-21T09:30:00Z"`
+    }
+
+    // TopicProgress - state of a course topic for a student.
+    TopicProgress struct {
+        TopicID     int               `json:"topic_id"               example:"1"`
+        Name        string            `json:"name"                   example:"Introduction to Go"`
+        Position    int               `json:"position"               example:"1"`
+        Status      TopicStatus       `json:"status"                 example:"Completed"`
+        StartedAt   string            `json:"started_at,omitempty"   example:"2023-01-10T08:00:00Z"`
+        CompletedAt string            `json:"completed_at,omitempty" example:"2023-01-18T19:00:00Z"`
+        Projects    []ProjectProgress `json:"projects"`
+    }
+
+    // CourseProgress - progress of a student through a purchased course. Every topic and every project
+    // weighs the same in the completion percentage.
+    CourseProgress struct {
+        CourseID          int             `json:"course_id"          example:"1"`
+        CourseName        string          `json:"course_name"        example:"Introduction to Go"`
+        CompletionPercent int             `json:"completion_percent" example:"75"`
+        CompletedTopics   int             `json:"completed_topics"   example:"3"`
+        TotalTopics       int             `json:"total_topics"       example:"4"`
+        AcceptedProjects  int             `json:"accepted_projects"  example:"3"`
+        TotalProjects     int             `json:"total_projects"     example:"4"`
+        Topics            []TopicProgress `json:"topics"`
+    }
+
+    // UserProgress - progress of a student through all purchased courses.
+    UserProgress struct {
+        UserID  int              `json:"user_id" example:"42"`
+        Courses []CourseProgress `json:"courses"`
+    }
+)
@@ -2,6 +2,8 @@
 // HTTP response objects if suitable. Each logic group entity in its own file.
 package entity
 
+import "fmt"
+
 type PurchaseStatus int
 
 const (
@@ -10,6 +12,42 @@ const (
     PurchaseStatusCancelled                       // Canceled purchase
 )
 
+// purchaseStatusNames - values stored in the purchase.purchase_status column.
+var purchaseStatusNames = map[PurchaseStatus]string{
+    PurchaseStatusPending:   "Pending",
+    PurchaseStatusCompleted: "Completed",
+    PurchaseStatusCancelled: "Cancelled",
+}
+
+// String returns the status as it is stored in the database.
+func (s PurchaseStatus) String() string {
+    return purchaseStatusNames[s]
+}
+
+// ParsePurchaseStatus converts a database value into PurchaseStatus.
+func ParsePurchaseStatus(name string) (PurchaseStatus, error) {
+    for status, statusName := range purchaseStatusNames {
+        if statusName == name {
+            return status, nil
+        }
+    }
+
+    return 0, fmt.Errorf("unknown purchase status %q", name)
+}
+
+// CanTransitionTo reports whether a purchase may move from s to next.
+// A cancelled purchase is final, a completed one can only be cancelled (refunded).
+func (s PurchaseStatus) CanTransitionTo(next PurchaseStatus) bool {
+    switch s {
+    case PurchaseStatusPending:
+        return next == PurchaseStatusCompleted || next == PurchaseStatusCancelled
+    case PurchaseStatusCompleted:
+        return next == PurchaseStatusCancelled
+    default:
+        return false
+    }
+}
+
 type (
     // CourseType - represents a type of course discount for purchase, e.g. "disabled person", "student", etc.
     CourseType struct {
@@ -23,9 +61,11 @@ type (
         PurchaseID     int            `json:"id"                 example:"1"`
         UserID         int            `json:"user_id"            example:"1"`
         CourseID       int            `json:"course_id"          example:"1"`
+        CalendarID     int            `json:"course_calendar_id" example:"1"`      // Cohort the seat is reserved in
         PurchaseDate   string         `json:"purchase_date"      example:"2022-01-02"`
         CourseTypeID   int            `json:"course_type_id"     example:"1"`      // ID of the course type (discount)
         TotalPrice     int            `json:"total_price"        example:"179.99"` // Total price after discount
         PurchaseStatus PurchaseStatus `json:"purchase_status"    example:"0"`
+        PaymentIntent  string         `json:"payment_intent_id"  example:"pi_3f9a1c2b7d"`
     }
 )
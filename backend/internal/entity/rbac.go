@@ -0,0 +1,29 @@
+// Package entity defines main entities for business logic (services), database mapping, and
+// HTTP response objects if suitable. Each logic group entity in its own file.
+package entity
+
+// Staff role names stored in the role table.
+const (
+    RoleAdministrator = "Administrator"
+    RoleTeacher       = "Teacher"
+    RoleMentor        = "Mentor"
+    RoleReviewer      = "Reviewer"
+    RoleSupport       = "Support"
+    RoleSMMManager    = "SMM-manager"
+)
+
+// Permission - action an employee is allowed to perform, granted to roles in the role_permission table.
+type Permission string
+
+const (
+    PermissionCourseWrite      Permission = "course:write"
+    PermissionUserRead         Permission = "user:read"
+    PermissionReportRead       Permission = "report:read"
+    PermissionPurchaseManage   Permission = "purchase:manage"
+    PermissionReviewModerate   Permission = "review:moderate"
+    PermissionCertificateIssue Permission = "certificate:issue"
+    PermissionProjectReview    Permission = "project:review"
+    PermissionCareerManage     Permission = "career:manage"
+    PermissionBlogWrite        Permission = "blog:write"
+    PermissionStaffManage      Permission = "staff:manage"
+)
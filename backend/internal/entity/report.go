@@ -2,6 +2,8 @@
 // HTTP response objects if suitable. Each logic group entity in its own file.
 package entity
 
+import "time"
+
 type (
     // TopCoursesReport - represents a report of top courses with their details.
     TopCoursesReport struct {
@@ -24,4 +26,12 @@ type (
         PurchaseDate       string  `json:"purchase_date"        example:"2022-01-02"`
         TeacherWorkPlace   string  `json:"teacher_work_place"   example:"Ozon"`
     }
+
+    // DetailedPurchaseReportFilter - parameters of the detailed purchase report.
+    DetailedPurchaseReportFilter struct {
+        From             time.Time // Zero value means no lower bound
+        To               time.Time // Inclusive day, zero value means no upper bound
+        SpecializationID int       // 0 - any specialization
+        Limit            uint32
+    }
 )
@@ -0,0 +1,18 @@
+// Package entity defines main entities for business logic (services), database mapping, and
+// HTTP response objects if suitable. Each logic group entity in its own file.
+package entity
+
+// ReviewStatus - moderation state of a course review, only approved reviews are public and counted in ratings.
+type ReviewStatus string
+
+const (
+    ReviewStatusPending  ReviewStatus = "Pending"
+    ReviewStatusApproved ReviewStatus = "Approved"
+    ReviewStatusRejected ReviewStatus = "Rejected"
+)
+
+// ReviewPage - page of the moderation queue.
+type ReviewPage struct {
+    Reviews []CourseReview `json:"reviews"`
+    NextID  int            `json:"next_after_id,omitempty" example:"42"` // Pass as after_id to get the next page
+}
@@ -0,0 +1,31 @@
+// Package entity defines main entities for business logic (services), database mapping, and
+// HTTP response objects if suitable. Each logic group entity in its own file.
+package entity
+
+// SearchHitType - kind of document found by the search.
+type SearchHitType string
+
+const (
+    SearchHitCourse   SearchHitType = "course"
+    SearchHitBlogPost SearchHitType = "blog_post"
+)
+
+type (
+    // SearchQuery - full-text search parameters.
+    SearchQuery struct {
+        Text      string
+        Courses   bool // Search in courses and their topics
+        BlogPosts bool // Search in blog posts
+        Limit     uint32
+    }
+
+    // SearchHit - single ranked search result with highlighted fragments.
+    // Highlight is HTML-escaped text with matched words in <b> tags.
+    SearchHit struct {
+        Type      SearchHitType `json:"type"       example:"course"`
+        ID        int           `json:"id"         example:"1"`
+        Title     string        `json:"title"      example:"Introduction to Go"`
+        Highlight string        `json:"highlight"  example:"A beginner's course on <b>Go</b> programming language"`
+        Rank      float64       `json:"rank"       example:"0.6079"`
+    }
+)
@@ -10,7 +10,7 @@ type (
         Surname           string `json:"surname"                example:"Doe"`
         BirthDate         string `json:"birth_date"             example:"2022-01-01"`
         Email             string `json:"email"                  example:"mail@example.com"`
-        HashedPassword    string `json:"hashed_password"        example:"$2a$10$EIXo1z..."`
+        HashedPassword    string `json:"-"`                                          // Never exposed over API
         ProfilePictureUrl string `json:"profile_picture_url"    example:"https://example.com/profile.jpg"`
         PhoneNumber       string `json:"phone_number"           example:"+1234567890"`
         SnilsNumber       string `json:"snils_number"           example:"123-456-789 01"`
@@ -0,0 +1,25 @@
+// Package entity defines main entities for business logic (services), database mapping, and
+// HTTP response objects if suitable. Each logic group entity in its own file.
+package entity
+
+// WaitlistStatus - state of a user in the waitlist of a sold out cohort.
+type WaitlistStatus string
+
+const (
+    WaitlistStatusWaiting WaitlistStatus = "Waiting" // Queued, waits for a seat
+    WaitlistStatusOffered WaitlistStatus = "Offered" // A seat is held for the user until the offer expires
+    WaitlistStatusClaimed WaitlistStatus = "Claimed" // The user purchased the course
+    WaitlistStatusExpired WaitlistStatus = "Expired" // The offer wasn't used in time
+    WaitlistStatusLeft    WaitlistStatus = "Left"    // The user left the waitlist
+)
+
+// WaitlistEntry - place of a user in the waitlist of a cohort.
+type WaitlistEntry struct {
+    EntryID        int            `json:"id"                         example:"1"`
+    CalendarID     int            `json:"course_calendar_id"         example:"1"`
+    UserID         int            `json:"user_id"                    example:"42"`
+    Status         WaitlistStatus `json:"status"                     example:"Waiting"`
+    Position       int            `json:"position,omitempty"         example:"3"` // Place in the queue while waiting
+    CreatedAt      string         `json:"created_at"                 example:"2023-01-20T12:00:00Z"`
+    OfferExpiresAt string         `json:"offer_expires_at,omitempty" example:"2023-01-21T12:00:00Z"`
+}
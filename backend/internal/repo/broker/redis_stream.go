@@ -0,0 +1,51 @@
+// Package broker implements event brokers behind repo.EventPublisher.
+package broker
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/deadnotxaa/education-platform/backend/internal/entity"
+	"github.com/deadnotxaa/education-platform/backend/pkg/redis"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// RedisStream - publishes events to a Redis stream, consumers read it with their own consumer groups.
+type RedisStream struct {
+    *redis.Redis
+
+    stream string
+    maxLen int64 // Approximate length the stream is trimmed to, 0 keeps every entry
+}
+
+// NewRedisStream -.
+func NewRedisStream(rdb *redis.Redis, stream string, maxLen int64) *RedisStream {
+    return &RedisStream{
+        Redis:  rdb,
+        stream: stream,
+        maxLen: maxLen,
+    }
+}
+
+// Publish appends the event to the stream, a retried event is appended again with the same ID.
+func (rs *RedisStream) Publish(ctx context.Context, event entity.Event) error {
+    err := rs.Client.XAdd(ctx, &goredis.XAddArgs{
+        Stream: rs.stream,
+        MaxLen: rs.maxLen,
+        Approx: rs.maxLen > 0,
+        Values: []any{
+            "id", strconv.FormatInt(event.ID, 10),
+            "type", string(event.Type),
+            "version", strconv.Itoa(event.Version),
+            "aggregate_id", strconv.Itoa(event.AggregateID),
+            "payload", string(event.Payload),
+            "occurred_at", event.OccurredAt,
+        },
+    }).Err()
+    if err != nil {
+        return fmt.Errorf("RedisStream - Publish - rs.Client.XAdd: %w", err)
+    }
+
+    return nil
+}
@@ -0,0 +1,48 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/deadnotxaa/education-platform/backend/internal/entity"
+	"github.com/redis/go-redis/v9"
+)
+
+func refreshTokenKey(tokenID string) string {
+    return fmt.Sprintf("refresh_token:%s", tokenID)
+}
+
+// SaveRefreshToken stores an active refresh token ID for the account until it expires.
+func (rr *RedisRepo) SaveRefreshToken(ctx context.Context, tokenID string, accountID int, ttl time.Duration) error {
+    if err := rr.Client.Set(ctx, refreshTokenKey(tokenID), accountID, ttl).Err(); err != nil {
+        return fmt.Errorf("RedisRepo - SaveRefreshToken - Client.Set: %w", err)
+    }
+
+    return nil
+}
+
+// ConsumeRefreshToken atomically removes a refresh token and returns its account ID,
+// so every refresh token can be used only once.
+func (rr *RedisRepo) ConsumeRefreshToken(ctx context.Context, tokenID string) (int, error) {
+    accountID, err := rr.Client.GetDel(ctx, refreshTokenKey(tokenID)).Int()
+    if err != nil {
+        if errors.Is(err, redis.Nil) {
+            return 0, fmt.Errorf("RedisRepo - ConsumeRefreshToken: %w", entity.ErrInvalidToken)
+        }
+
+        return 0, fmt.Errorf("RedisRepo - ConsumeRefreshToken - Client.GetDel: %w", err)
+    }
+
+    return accountID, nil
+}
+
+// DeleteRefreshToken revokes a refresh token.
+func (rr *RedisRepo) DeleteRefreshToken(ctx context.Context, tokenID string) error {
+    if err := rr.Client.Del(ctx, refreshTokenKey(tokenID)).Err(); err != nil {
+        return fmt.Errorf("RedisRepo - DeleteRefreshToken - Client.Del: %w", err)
+    }
+
+    return nil
+}
@@ -22,39 +22,93 @@ func New(rdb *redis.Redis) *RedisRepo {
     }
 }
 
-func (rr *RedisRepo) GetCourseById(ctx context.Context, courseID int) (entity.Course, error) {
-    return entity.Course{}, nil
+// cachedReport - report with the moment it becomes stale, it is kept in Redis for a while after that.
+type cachedReport struct {
+    FreshUntil time.Time       `json:"fresh_until"`
+    Data       json.RawMessage `json:"data"`
 }
 
-func (rr *RedisRepo) GetUserById(ctx context.Context, userID int) (entity.User, error) {
-    return entity.User{}, nil
+func topCoursesReportKey(limit uint32) string {
+    return fmt.Sprintf("top_courses_report:%d", limit)
 }
 
-func (rr *RedisRepo) GetTopCoursesReport(ctx context.Context, limit uint32) ([]entity.TopCoursesReport, error) {
-    key := fmt.Sprintf("top_courses_report:%d", limit)
+func (rr *RedisRepo) GetTopCoursesReport(ctx context.Context, limit uint32) ([]entity.TopCoursesReport, bool, error) {
+    var reports []entity.TopCoursesReport
 
+    fresh, err := rr.getReport(ctx, topCoursesReportKey(limit), &reports)
+    if err != nil {
+        return nil, false, fmt.Errorf("RedisRepo - GetTopCoursesReport - rr.getReport: %w", err)
+    }
+
+    return reports, fresh, nil
+}
+
+func (rr *RedisRepo) SetTopCoursesReport(ctx context.Context, limit uint32, reports []entity.TopCoursesReport,
+    freshTTL, staleTTL time.Duration) error {
+    if err := rr.setReport(ctx, topCoursesReportKey(limit), reports, freshTTL, staleTTL); err != nil {
+        return fmt.Errorf("RedisRepo - SetTopCoursesReport - rr.setReport: %w", err)
+    }
+
+    return nil
+}
+
+// DeleteTopCoursesReports removes cached reports of every limit, e.g. after ratings change.
+func (rr *RedisRepo) DeleteTopCoursesReports(ctx context.Context) error {
+    iter := rr.Client.Scan(ctx, 0, "top_courses_report:*", 100).Iterator()
+
+    var keys []string
+    for iter.Next(ctx) {
+        keys = append(keys, iter.Val())
+    }
+
+    if err := iter.Err(); err != nil {
+        return fmt.Errorf("RedisRepo - DeleteTopCoursesReports - iter.Err: %w", err)
+    }
+
+    if len(keys) == 0 {
+        return nil
+    }
+
+    if err := rr.Client.Del(ctx, keys...).Err(); err != nil {
+        return fmt.Errorf("RedisRepo - DeleteTopCoursesReports - Client.Del: %w", err)
+    }
+
+    return nil
+}
+
+// getReport decodes a cached report into dst and tells whether it is still fresh.
+func (rr *RedisRepo) getReport(ctx context.Context, key string, dst any) (bool, error) {
     cachedData, err := rr.Client.Get(ctx, key).Result()
-    if err == nil {
-        var reports []entity.TopCoursesReport
-        if err := json.Unmarshal([]byte(cachedData), &reports); err != nil {
-            return nil, fmt.Errorf("RedisRepo - GetTopCoursesReport - json.Unmarshal: %w", err)
-        }
-        return reports, nil
+    if err != nil {
+        return false, fmt.Errorf("cache miss or error: %w", err)
+    }
+
+    var cached cachedReport
+    if err = json.Unmarshal([]byte(cachedData), &cached); err != nil {
+        return false, fmt.Errorf("json.Unmarshal: %w", err)
     }
 
-    return nil, fmt.Errorf("RedisRepo - GetTopCoursesReport - cache miss or error: %w", err)
+    if err = json.Unmarshal(cached.Data, dst); err != nil {
+        return false, fmt.Errorf("json.Unmarshal: %w", err)
+    }
+
+    return time.Now().Before(cached.FreshUntil), nil
 }
 
-func (rr *RedisRepo) SetTopCoursesReport(ctx context.Context, limit uint32, reports []entity.TopCoursesReport) error {
-    key := fmt.Sprintf("top_courses_report:%d", limit)
+// setReport stores a report that is fresh for freshTTL and may be served stale for staleTTL after that.
+func (rr *RedisRepo) setReport(ctx context.Context, key string, report any, freshTTL, staleTTL time.Duration) error {
+    data, err := json.Marshal(report)
+    if err != nil {
+        return fmt.Errorf("json.Marshal: %w", err)
+    }
 
-    data, err := json.Marshal(reports)
+    cached, err := json.Marshal(cachedReport{FreshUntil: time.Now().Add(freshTTL), Data: data})
     if err != nil {
-        return fmt.Errorf("RedisRepo - SetTopCoursesReport - json.Marshal: %w", err)
+        return fmt.Errorf("json.Marshal: %w", err)
     }
 
-    if err := rr.Client.Set(ctx, key, data, 5 * time.Minute).Err(); err != nil {
-        return fmt.Errorf("RedisRepo - SetTopCoursesReport - Client.Set: %w", err)
+    if err = rr.Client.Set(ctx, key, cached, freshTTL+staleTTL).Err(); err != nil {
+        return fmt.Errorf("Client.Set: %w", err)
     }
 
     return nil
@@ -0,0 +1,133 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/deadnotxaa/education-platform/backend/internal/entity"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+    _courseCache = "course"
+    _userCache   = "user"
+)
+
+// Stored instead of the entity when the ID is known to be missing
+const _notFoundMarker = "{}"
+
+func courseKey(courseID int) string {
+    return fmt.Sprintf("course:%d", courseID)
+}
+
+func userKey(userID int) string {
+    return fmt.Sprintf("user:%d", userID)
+}
+
+func (rr *RedisRepo) GetCourseById(ctx context.Context, courseID int) (entity.Course, error) {
+    var course entity.Course
+
+    if err := rr.getEntity(ctx, _courseCache, courseKey(courseID), &course, entity.ErrCourseNotFound); err != nil {
+        return entity.Course{}, fmt.Errorf("RedisRepo - GetCourseById - rr.getEntity: %w", err)
+    }
+
+    return course, nil
+}
+
+func (rr *RedisRepo) SetCourse(ctx context.Context, course entity.Course, ttl time.Duration) error {
+    data, err := json.Marshal(course)
+    if err != nil {
+        return fmt.Errorf("RedisRepo - SetCourse - json.Marshal: %w", err)
+    }
+
+    if err = rr.Client.Set(ctx, courseKey(course.CourseID), data, ttl).Err(); err != nil {
+        return fmt.Errorf("RedisRepo - SetCourse - Client.Set: %w", err)
+    }
+
+    return nil
+}
+
+func (rr *RedisRepo) SetCourseNotFound(ctx context.Context, courseID int, ttl time.Duration) error {
+    if err := rr.Client.Set(ctx, courseKey(courseID), _notFoundMarker, ttl).Err(); err != nil {
+        return fmt.Errorf("RedisRepo - SetCourseNotFound - Client.Set: %w", err)
+    }
+
+    return nil
+}
+
+func (rr *RedisRepo) DeleteCourse(ctx context.Context, courseID int) error {
+    if err := rr.Client.Del(ctx, courseKey(courseID)).Err(); err != nil {
+        return fmt.Errorf("RedisRepo - DeleteCourse - Client.Del: %w", err)
+    }
+
+    return nil
+}
+
+func (rr *RedisRepo) GetUserById(ctx context.Context, userID int) (entity.User, error) {
+    var user entity.User
+
+    if err := rr.getEntity(ctx, _userCache, userKey(userID), &user, entity.ErrUserNotFound); err != nil {
+        return entity.User{}, fmt.Errorf("RedisRepo - GetUserById - rr.getEntity: %w", err)
+    }
+
+    return user, nil
+}
+
+func (rr *RedisRepo) SetUser(ctx context.Context, user entity.User, ttl time.Duration) error {
+    data, err := json.Marshal(user)
+    if err != nil {
+        return fmt.Errorf("RedisRepo - SetUser - json.Marshal: %w", err)
+    }
+
+    if err = rr.Client.Set(ctx, userKey(user.AccountID), data, ttl).Err(); err != nil {
+        return fmt.Errorf("RedisRepo - SetUser - Client.Set: %w", err)
+    }
+
+    return nil
+}
+
+func (rr *RedisRepo) SetUserNotFound(ctx context.Context, userID int, ttl time.Duration) error {
+    if err := rr.Client.Set(ctx, userKey(userID), _notFoundMarker, ttl).Err(); err != nil {
+        return fmt.Errorf("RedisRepo - SetUserNotFound - Client.Set: %w", err)
+    }
+
+    return nil
+}
+
+func (rr *RedisRepo) DeleteUser(ctx context.Context, userID int) error {
+    if err := rr.Client.Del(ctx, userKey(userID)).Err(); err != nil {
+        return fmt.Errorf("RedisRepo - DeleteUser - Client.Del: %w", err)
+    }
+
+    return nil
+}
+
+// getEntity decodes a cached entity into dst, returning errNotFound for negative entries
+// and entity.ErrCacheMiss when nothing is cached.
+func (rr *RedisRepo) getEntity(ctx context.Context, cache, key string, dst any, errNotFound error) error {
+    cachedData, err := rr.Client.Get(ctx, key).Result()
+    if err != nil {
+        if errors.Is(err, redis.Nil) {
+            cacheRequestsTotal.WithLabelValues(cache, _cacheMiss).Inc()
+
+            return entity.ErrCacheMiss
+        }
+
+        return fmt.Errorf("Client.Get: %w", err)
+    }
+
+    cacheRequestsTotal.WithLabelValues(cache, _cacheHit).Inc()
+
+    if cachedData == _notFoundMarker {
+        return errNotFound
+    }
+
+    if err = json.Unmarshal([]byte(cachedData), dst); err != nil {
+        return fmt.Errorf("json.Unmarshal: %w", err)
+    }
+
+    return nil
+}
@@ -0,0 +1,48 @@
+package cache
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Deletes the lock only if it is still held by the caller's token
+var _releaseLockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+    return redis.call("DEL", KEYS[1])
+end
+return 0`)
+
+func lockKey(key string) string {
+    return fmt.Sprintf("lock:%s", key)
+}
+
+// AcquireLock tries to take a lock shared by all instances, the lock expires after ttl if it is never released.
+func (rr *RedisRepo) AcquireLock(ctx context.Context, key string, ttl time.Duration) (string, bool, error) {
+    b := make([]byte, 16)
+    if _, err := rand.Read(b); err != nil {
+        return "", false, fmt.Errorf("RedisRepo - AcquireLock - rand.Read: %w", err)
+    }
+
+    token := hex.EncodeToString(b)
+
+    acquired, err := rr.Client.SetNX(ctx, lockKey(key), token, ttl).Result()
+    if err != nil {
+        return "", false, fmt.Errorf("RedisRepo - AcquireLock - Client.SetNX: %w", err)
+    }
+
+    return token, acquired, nil
+}
+
+// ReleaseLock releases a lock taken with AcquireLock.
+func (rr *RedisRepo) ReleaseLock(ctx context.Context, key, token string) error {
+    if err := _releaseLockScript.Run(ctx, rr.Client, []string{lockKey(key)}, token).Err(); err != nil {
+        return fmt.Errorf("RedisRepo - ReleaseLock - _releaseLockScript.Run: %w", err)
+    }
+
+    return nil
+}
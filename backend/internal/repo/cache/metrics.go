@@ -0,0 +1,19 @@
+package cache
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+    _cacheHit  = "hit"
+    _cacheMiss = "miss"
+)
+
+var cacheRequestsTotal = promauto.NewCounterVec(
+    prometheus.CounterOpts{
+        Name: "cache_requests_total",
+        Help: "Total number of cache lookups by cached entity and result",
+    },
+    []string{"cache", "result"},
+)
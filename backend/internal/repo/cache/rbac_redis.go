@@ -0,0 +1,42 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/deadnotxaa/education-platform/backend/internal/entity"
+)
+
+func accountPermissionsKey(accountID int) string {
+    return fmt.Sprintf("account_permissions:%d", accountID)
+}
+
+func (rr *RedisRepo) GetAccountPermissions(ctx context.Context, accountID int) ([]entity.Permission, error) {
+    cachedData, err := rr.Client.Get(ctx, accountPermissionsKey(accountID)).Result()
+    if err != nil {
+        return nil, fmt.Errorf("RedisRepo - GetAccountPermissions - cache miss or error: %w", err)
+    }
+
+    var permissions []entity.Permission
+    if err = json.Unmarshal([]byte(cachedData), &permissions); err != nil {
+        return nil, fmt.Errorf("RedisRepo - GetAccountPermissions - json.Unmarshal: %w", err)
+    }
+
+    return permissions, nil
+}
+
+func (rr *RedisRepo) SetAccountPermissions(ctx context.Context, accountID int, permissions []entity.Permission,
+    ttl time.Duration) error {
+    data, err := json.Marshal(permissions)
+    if err != nil {
+        return fmt.Errorf("RedisRepo - SetAccountPermissions - json.Marshal: %w", err)
+    }
+
+    if err = rr.Client.Set(ctx, accountPermissionsKey(accountID), data, ttl).Err(); err != nil {
+        return fmt.Errorf("RedisRepo - SetAccountPermissions - Client.Set: %w", err)
+    }
+
+    return nil
+}
@@ -0,0 +1,44 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/deadnotxaa/education-platform/backend/internal/entity"
+)
+
+func detailedPurchaseReportKey(filter entity.DetailedPurchaseReportFilter) string {
+    return fmt.Sprintf("detailed_purchase_report:%s:%s:%d:%d",
+        formatFilterDate(filter.From), formatFilterDate(filter.To), filter.SpecializationID, filter.Limit)
+}
+
+func formatFilterDate(date time.Time) string {
+    if date.IsZero() {
+        return "-"
+    }
+
+    return date.Format(time.DateOnly)
+}
+
+func (rr *RedisRepo) GetDetailedPurchaseReport(ctx context.Context,
+    filter entity.DetailedPurchaseReportFilter) ([]entity.DetailedPurchaseReport, bool, error) {
+    var reports []entity.DetailedPurchaseReport
+
+    fresh, err := rr.getReport(ctx, detailedPurchaseReportKey(filter), &reports)
+    if err != nil {
+        return nil, false, fmt.Errorf("RedisRepo - GetDetailedPurchaseReport - rr.getReport: %w", err)
+    }
+
+    return reports, fresh, nil
+}
+
+func (rr *RedisRepo) SetDetailedPurchaseReport(ctx context.Context, filter entity.DetailedPurchaseReportFilter,
+    reports []entity.DetailedPurchaseReport, freshTTL, staleTTL time.Duration) error {
+    err := rr.setReport(ctx, detailedPurchaseReportKey(filter), reports, freshTTL, staleTTL)
+    if err != nil {
+        return fmt.Errorf("RedisRepo - SetDetailedPurchaseReport - rr.setReport: %w", err)
+    }
+
+    return nil
+}
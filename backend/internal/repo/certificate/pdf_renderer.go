@@ -0,0 +1,74 @@
+// Package certificate renders course completion certificates.
+package certificate
+
+import (
+    "bytes"
+    "fmt"
+    "strings"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+    "github.com/jung-kurt/gofpdf"
+)
+
+// PDFRenderer - renders certificates as single-page landscape A4 PDF documents.
+type PDFRenderer struct{}
+
+// NewPDF -.
+func NewPDF() *PDFRenderer {
+    return &PDFRenderer{}
+}
+
+func (p *PDFRenderer) RenderCertificate(details entity.CertificateDetails, verifyURL string) ([]byte, error) {
+    pdf := gofpdf.New("L", "mm", "A4", "")
+    pdf.SetTitle("Certificate of completion", true)
+    pdf.SetMargins(20, 20, 20)
+    pdf.SetAutoPageBreak(false, 0)
+    pdf.AddPage()
+
+    // Core fonts are cp1252 encoded
+    tr := pdf.UnicodeTranslatorFromDescriptor("")
+    width, height := pdf.GetPageSize()
+
+    pdf.SetLineWidth(1.5)
+    pdf.Rect(10, 10, width-20, height-20, "D")
+
+    pdf.SetY(35)
+    pdf.SetFont("Helvetica", "B", 32)
+    pdf.CellFormat(0, 16, tr("Certificate of Completion"), "", 1, "C", false, 0, "")
+
+    pdf.Ln(8)
+    pdf.SetFont("Helvetica", "", 14)
+    pdf.CellFormat(0, 8, tr("This is to certify that"), "", 1, "C", false, 0, "")
+
+    pdf.Ln(4)
+    pdf.SetFont("Helvetica", "B", 26)
+    pdf.CellFormat(0, 14, tr(details.StudentName), "", 1, "C", false, 0, "")
+
+    pdf.Ln(4)
+    pdf.SetFont("Helvetica", "", 14)
+    pdf.CellFormat(0, 8, tr("has successfully completed the course"), "", 1, "C", false, 0, "")
+
+    pdf.Ln(4)
+    pdf.SetFont("Helvetica", "B", 20)
+    pdf.MultiCell(0, 10, tr(details.CourseName), "", "C", false)
+
+    if len(details.Teachers) > 0 {
+        pdf.Ln(6)
+        pdf.SetFont("Helvetica", "", 12)
+        pdf.MultiCell(0, 7, tr("Teachers: "+strings.Join(details.Teachers, ", ")), "", "C", false)
+    }
+
+    pdf.SetY(height - 45)
+    pdf.SetFont("Helvetica", "", 11)
+    pdf.CellFormat(0, 6, tr("Issued on "+details.IssueDate), "", 1, "C", false, 0, "")
+    pdf.CellFormat(0, 6, tr("Certificate ID: "+details.VerificationCode), "", 1, "C", false, 0, "")
+    pdf.SetFont("Helvetica", "", 9)
+    pdf.CellFormat(0, 6, tr("Verify at "+verifyURL), "", 1, "C", false, 0, verifyURL)
+
+    var buf bytes.Buffer
+    if err := pdf.Output(&buf); err != nil {
+        return nil, fmt.Errorf("PDFRenderer - RenderCertificate - pdf.Output: %w", err)
+    }
+
+    return buf.Bytes(), nil
+}
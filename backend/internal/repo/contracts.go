@@ -3,6 +3,7 @@ package repo
 
 import (
     "context"
+    "time"
 
     "github.com/deadnotxaa/education-platform/backend/internal/entity"
 )
@@ -10,27 +11,438 @@ import (
 type (
     // PostgresRepo defines the methods for interacting with the backend repository.
     PostgresRepo interface {
+        // WithinTransaction runs fn in a transaction shared by all repo calls made with the context passed to fn.
+        WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+
         // GetCourseById retrieves a course by its ID.
         GetCourseById(ctx context.Context, courseID int) (entity.Course, error)
 
+        // GetCoursesByIDs retrieves the existing courses among the IDs.
+        GetCoursesByIDs(ctx context.Context, courseIDs []int) ([]entity.Course, error)
+
+        // CreateCourse inserts a new course and returns it with generated fields.
+        CreateCourse(ctx context.Context, course entity.Course) (entity.Course, error)
+
+        // UpdateCourse updates an existing course and returns its new state.
+        UpdateCourse(ctx context.Context, course entity.Course) (entity.Course, error)
+
+        // DeleteCourse soft-deletes a course by its ID.
+        DeleteCourse(ctx context.Context, courseID int) error
+
+        // ListCourses retrieves a filtered page of courses.
+        ListCourses(ctx context.Context, filter entity.CourseFilter) (entity.CoursePage, error)
+
+        // SearchCourses performs a full-text search over courses and their topics.
+        SearchCourses(ctx context.Context, text string, limit uint32) ([]entity.SearchHit, error)
+
+        // SearchBlogPosts performs a full-text search over published blog posts.
+        SearchBlogPosts(ctx context.Context, text string, limit uint32) ([]entity.SearchHit, error)
+
         // GetUserById retrieves some info about user by their ID.
         GetUserById(ctx context.Context, userID int) (entity.User, error)
 
+        // CreateUser registers a new account with an already hashed password.
+        CreateUser(ctx context.Context, user entity.User) (entity.User, error)
+
+        // GetUserByEmail retrieves an account with its password hash by email.
+        GetUserByEmail(ctx context.Context, email string) (entity.User, error)
+
+        // GetAccountPermissions retrieves permissions granted by the account's employee roles.
+        GetAccountPermissions(ctx context.Context, accountID int) ([]entity.Permission, error)
+
         // GetTopCoursesReport retrieves a report of the top n courses.
         GetTopCoursesReport(ctx context.Context, limit uint32) ([]entity.TopCoursesReport, error)
+
+        // GetDetailedPurchaseReport retrieves the latest purchases with their buyer, course and teachers.
+        GetDetailedPurchaseReport(ctx context.Context, filter entity.DetailedPurchaseReportFilter) ([]entity.DetailedPurchaseReport, error)
+
+        // GetCourseCalendarForUpdate retrieves a course cohort and locks it until the end of the transaction.
+        GetCourseCalendarForUpdate(ctx context.Context, calendarID int) (entity.CourseCalendar, error)
+
+        // AdjustRemainingPlaces changes the number of remaining places in a cohort by delta.
+        AdjustRemainingPlaces(ctx context.Context, calendarID int, delta int) error
+
+        // GetCourseType retrieves a course type (discount) by its ID.
+        GetCourseType(ctx context.Context, courseTypeID int) (entity.CourseType, error)
+
+        // CreatePurchase inserts a new purchase.
+        CreatePurchase(ctx context.Context, purchase entity.Purchase) (entity.Purchase, error)
+
+        // GetPurchaseForUpdate retrieves a purchase and locks it until the end of the transaction.
+        GetPurchaseForUpdate(ctx context.Context, purchaseID int) (entity.Purchase, error)
+
+        // UpdatePurchaseStatus sets a new status of a purchase.
+        UpdatePurchaseStatus(ctx context.Context, purchaseID int, status entity.PurchaseStatus) error
+
+        // SetPurchasePaymentIntent links a purchase to a payment intent.
+        SetPurchasePaymentIntent(ctx context.Context, purchaseID int, intentID string) error
+
+        // GetPurchaseByPaymentIntentForUpdate retrieves a purchase by its payment intent and locks it.
+        GetPurchaseByPaymentIntentForUpdate(ctx context.Context, intentID string) (entity.Purchase, error)
+
+        // MarkPaymentEventProcessed records a webhook event, returns false if it was already processed.
+        MarkPaymentEventProcessed(ctx context.Context, event entity.PaymentEvent) (bool, error)
+
+        // HasCompletedPurchase checks whether the user has paid for the course.
+        HasCompletedPurchase(ctx context.Context, userID, courseID int) (bool, error)
+
+        // CreateReview inserts a review waiting for moderation.
+        CreateReview(ctx context.Context, review entity.CourseReview) (entity.CourseReview, error)
+
+        // GetReviewForUpdate retrieves a review and locks it until the end of the transaction.
+        GetReviewForUpdate(ctx context.Context, reviewID int) (entity.CourseReview, error)
+
+        // UpdateReview replaces the rating and comment of a review and sends it back to moderation.
+        UpdateReview(ctx context.Context, review entity.CourseReview) (entity.CourseReview, error)
+
+        // DeleteReview deletes a review by its ID.
+        DeleteReview(ctx context.Context, reviewID int) error
+
+        // ListPendingReviews retrieves a page of reviews waiting for moderation.
+        ListPendingReviews(ctx context.Context, afterID int, limit uint32) (entity.ReviewPage, error)
+
+        // ListApprovedReviewsByCourses retrieves the latest approved reviews of each course, at most perCourse.
+        ListApprovedReviewsByCourses(ctx context.Context, courseIDs []int, perCourse uint32) (map[int][]entity.CourseReview, error)
+
+        // SetReviewStatus records a moderation decision.
+        SetReviewStatus(ctx context.Context, reviewID int, status entity.ReviewStatus, moderatorID int) error
+
+        // LockCompletedPurchase locks the completed purchase of the course by the user until the end of the transaction.
+        LockCompletedPurchase(ctx context.Context, userID, courseID int) error
+
+        // GetUserCourseCertificate retrieves the certificate of the user for the course.
+        GetUserCourseCertificate(ctx context.Context, userID, courseID int) (entity.Certificate, error)
+
+        // CreateCertificate inserts a new certificate issued today.
+        CreateCertificate(ctx context.Context, certificate entity.Certificate) (entity.Certificate, error)
+
+        // ListUserCertificates retrieves all certificates of the user.
+        ListUserCertificates(ctx context.Context, userID int) ([]entity.Certificate, error)
+
+        // GetCertificateDetails retrieves a certificate with the names printed on it.
+        GetCertificateDetails(ctx context.Context, certificateID int) (entity.CertificateDetails, error)
+
+        // GetCourseSyllabus retrieves the topics of a course in learning path order with their projects.
+        GetCourseSyllabus(ctx context.Context, courseID int) ([]entity.SyllabusTopic, error)
+
+        // GetCourseSyllabi retrieves the syllabi of several courses by course ID.
+        GetCourseSyllabi(ctx context.Context, courseIDs []int) (map[int][]entity.SyllabusTopic, error)
+
+        // LockCourse locks a course that isn't deleted until the end of the transaction.
+        LockCourse(ctx context.Context, courseID int) error
+
+        // GetCourseTopicIDs retrieves the IDs of the topics of a course in learning path order.
+        GetCourseTopicIDs(ctx context.Context, courseID int) ([]int, error)
+
+        // CreateCourseTopic inserts a topic at the end of the course.
+        CreateCourseTopic(ctx context.Context, courseID int, topic entity.CourseTopic) (entity.SyllabusTopic, error)
+
+        // UpdateCourseTopic replaces all editable fields of a topic.
+        UpdateCourseTopic(ctx context.Context, topic entity.CourseTopic) (entity.CourseTopic, error)
+
+        // RemoveCourseTopic removes a topic from a course, deleting it if no other course uses it.
+        // Positions of the following topics are left unchanged.
+        RemoveCourseTopic(ctx context.Context, courseID, topicID int) error
+
+        // ReorderCourseTopics sets the positions of the topics of a course to their order in topicIDs.
+        ReorderCourseTopics(ctx context.Context, courseID int, topicIDs []int) error
+
+        // CreateProject inserts a new topic project.
+        CreateProject(ctx context.Context, project entity.Project) (entity.Project, error)
+
+        // UpdateProject replaces all editable fields of a project.
+        UpdateProject(ctx context.Context, project entity.Project) (entity.Project, error)
+
+        // DeleteProject deletes a project by its ID.
+        DeleteProject(ctx context.Context, projectID int) error
+
+        // GetEmployeeWithPermission retrieves the employee record of the account whose role grants the permission.
+        GetEmployeeWithPermission(ctx context.Context, accountID int, permission entity.Permission) (int, error)
+
+        // CourseHasTopic checks whether the topic is part of the course.
+        CourseHasTopic(ctx context.Context, courseID, topicID int) (bool, error)
+
+        // CourseHasProject checks whether the project belongs to a topic of the course.
+        CourseHasProject(ctx context.Context, courseID, projectID int) (bool, error)
+
+        // SetTopicStatus records the progress of a student through a topic.
+        SetTopicStatus(ctx context.Context, userID, courseID, topicID int, status entity.TopicStatus) error
+
+        // SubmitProject stores a new solution of a project that isn't accepted yet.
+        SubmitProject(ctx context.Context, submission entity.ProjectSubmission) (entity.ProjectSubmission, error)
+
+        // GetSubmissionForUpdate retrieves a project submission and locks it until the end of the transaction.
+        GetSubmissionForUpdate(ctx context.Context, submissionID int) (entity.ProjectSubmission, error)
+
+        // GradeSubmission records the decision of a reviewer.
+        GradeSubmission(ctx context.Context, submission entity.ProjectSubmission) (entity.ProjectSubmission, error)
+
+        // ListPendingSubmissions retrieves a page of submissions waiting for review.
+        ListPendingSubmissions(ctx context.Context, cursor string, limit uint32) (entity.SubmissionPage, error)
+
+        // GetUserProgress retrieves the progress of the user through every purchased course.
+        GetUserProgress(ctx context.Context, userID int) ([]entity.CourseProgress, error)
+
+        // GetUserCourseProgress retrieves the progress of the user through one paid course.
+        GetUserCourseProgress(ctx context.Context, userID, courseID int) (entity.CourseProgress, error)
+
+        // CreateCourseCalendar schedules a new cohort of a course.
+        CreateCourseCalendar(ctx context.Context, calendar entity.CourseCalendar) (entity.CourseCalendar, error)
+
+        // UpdateCourseCalendar replaces the dates and the number of free places of a cohort.
+        UpdateCourseCalendar(ctx context.Context, calendar entity.CourseCalendar) (entity.CourseCalendar, error)
+
+        // ListUpcomingCourseCalendars retrieves cohorts of a course starting on or after the date.
+        ListUpcomingCourseCalendars(ctx context.Context, courseID int, from time.Time) ([]entity.CourseCalendar, error)
+
+        // ListUpcomingCourseCalendarsByCourses retrieves upcoming cohorts of several courses by course ID.
+        ListUpcomingCourseCalendarsByCourses(ctx context.Context, courseIDs []int, from time.Time) (map[int][]entity.CourseCalendar, error)
+
+        // CreateWaitlistEntry puts the user at the end of the waitlist of a cohort.
+        CreateWaitlistEntry(ctx context.Context, calendarID, userID int) (entity.WaitlistEntry, error)
+
+        // GetActiveWaitlistEntryForUpdate retrieves the waiting or offered entry of the user in a cohort and locks it.
+        GetActiveWaitlistEntryForUpdate(ctx context.Context, calendarID, userID int) (entity.WaitlistEntry, error)
+
+        // GetWaitlistEntryForUpdate retrieves a waitlist entry and locks it until the end of the transaction.
+        GetWaitlistEntryForUpdate(ctx context.Context, entryID int) (entity.WaitlistEntry, error)
+
+        // SetWaitlistEntryStatus closes a waitlist entry.
+        SetWaitlistEntryStatus(ctx context.Context, entryID int, status entity.WaitlistStatus) error
+
+        // OfferSeatToNextWaiting holds a seat for the user who has waited the longest.
+        OfferSeatToNextWaiting(ctx context.Context, calendarID int, expiresAt time.Time) (entity.WaitlistEntry, error)
+
+        // ListExpiredWaitlistOffers retrieves seat holds that expired before the time.
+        ListExpiredWaitlistOffers(ctx context.Context, before time.Time, limit uint32) ([]entity.WaitlistEntry, error)
+
+        // ListUserWaitlistEntries retrieves the active waitlist entries of the user.
+        ListUserWaitlistEntries(ctx context.Context, userID int) ([]entity.WaitlistEntry, error)
+
+        // CreateCareerStudent enrolls a graduate into career support for the course.
+        CreateCareerStudent(ctx context.Context, student entity.CareerCenterStudent) (entity.CareerCenterStudent, error)
+
+        // UpdateCareerStudentCV uploads or replaces the CV of a graduate enrolled for the course.
+        UpdateCareerStudentCV(ctx context.Context, userID, courseID int, cvURL string) (entity.CareerCenterStudent, error)
+
+        // ExtendSupportPeriod adds days to the career support period of a student.
+        ExtendSupportPeriod(ctx context.Context, studentID, days int) (entity.CareerCenterStudent, error)
+
+        // ListExpiringCareerStudents retrieves a page of students whose support ends between the dates.
+        ListExpiringCareerStudents(ctx context.Context, from, to time.Time, afterID int, limit uint32) (entity.CareerStudentPage, error)
+
+        // GetCareerStudent -.
+        GetCareerStudent(ctx context.Context, studentID int) (entity.CareerCenterStudent, error)
+
+        // GetCareerStudentByCourse retrieves the career support enrollment of the user for the course.
+        GetCareerStudentByCourse(ctx context.Context, userID, courseID int) (entity.CareerCenterStudent, error)
+
+        // GetPartnerCompany -.
+        GetPartnerCompany(ctx context.Context, companyID int) (entity.PartnerCompany, error)
+
+        // ListPartnerCompanies retrieves a page of partner companies.
+        ListPartnerCompanies(ctx context.Context, afterID int, limit uint32) (entity.PartnerCompanyPage, error)
+
+        // CreatePartnerCompany -.
+        CreatePartnerCompany(ctx context.Context, company entity.PartnerCompany) (entity.PartnerCompany, error)
+
+        // UpdatePartnerCompany replaces the editable fields of a company.
+        UpdatePartnerCompany(ctx context.Context, company entity.PartnerCompany) (entity.PartnerCompany, error)
+
+        // DeletePartnerCompany deletes a company without job applications.
+        DeletePartnerCompany(ctx context.Context, companyID int) error
+
+        // ListCareerStudentSkills retrieves students with active career support and technologies of their completed topics.
+        ListCareerStudentSkills(ctx context.Context, on time.Time) ([]entity.CareerStudentSkills, error)
+
+        // IncrementHiredGraduates counts one more graduate hired by the company.
+        IncrementHiredGraduates(ctx context.Context, companyID int) error
+
+        // CreateJobApplication stores a new application in the Submitted status.
+        CreateJobApplication(ctx context.Context, application entity.JobApplication) (entity.JobApplication, error)
+
+        // GetJobApplication -.
+        GetJobApplication(ctx context.Context, applicationID int) (entity.JobApplication, error)
+
+        // GetJobApplicationForUpdate retrieves a job application and locks it until the end of the transaction.
+        GetJobApplicationForUpdate(ctx context.Context, applicationID int) (entity.JobApplication, error)
+
+        // UpdateJobApplicationStatus -.
+        UpdateJobApplicationStatus(ctx context.Context, applicationID int, status entity.JobApplicationStatus) error
+
+        // AddJobApplicationTransition records a status change in the application history.
+        AddJobApplicationTransition(ctx context.Context, transition entity.JobApplicationTransition) (entity.JobApplicationTransition, error)
+
+        // ListJobApplicationHistory retrieves the status changes of an application in the order they happened.
+        ListJobApplicationHistory(ctx context.Context, applicationID int) ([]entity.JobApplicationTransition, error)
+
+        // ListUserJobApplications retrieves the applications the user sent.
+        ListUserJobApplications(ctx context.Context, userID int) ([]entity.JobApplication, error)
+
+        // CreateBlogPost stores a new draft and returns its ID.
+        CreateBlogPost(ctx context.Context, post entity.BlogPost) (int, error)
+
+        // UpdateBlogPost edits the title, topic, cover, content and reading time of a post.
+        UpdateBlogPost(ctx context.Context, post entity.BlogPost) error
+
+        // SetBlogPostTags replaces the tags of a post, missing tags are created.
+        SetBlogPostTags(ctx context.Context, postID int, tags []string) error
+
+        // GetBlogPost retrieves a post in any status with its tags.
+        GetBlogPost(ctx context.Context, postID int) (entity.BlogPost, error)
+
+        // GetBlogPostStatusForUpdate locks a post until the end of the transaction and returns its status.
+        GetBlogPostStatusForUpdate(ctx context.Context, postID int) (entity.BlogPostStatus, error)
+
+        // SetBlogPostStatus changes the status and the publication date of a post.
+        SetBlogPostStatus(ctx context.Context, postID int, status entity.BlogPostStatus, publicationDate time.Time) error
+
+        // ListBlogPosts retrieves a page of published posts, newest first.
+        ListBlogPosts(ctx context.Context, filter entity.BlogFilter) (entity.BlogPostPage, error)
+
+        // ListUnpublishedBlogPosts retrieves drafts and scheduled posts.
+        ListUnpublishedBlogPosts(ctx context.Context) ([]entity.BlogPost, error)
+
+        // PublishDueBlogPosts publishes scheduled posts whose publication date is not after now.
+        PublishDueBlogPosts(ctx context.Context, now time.Time) (int64, error)
+
+        // GetEmployee retrieves an employee with the name of its role.
+        GetEmployee(ctx context.Context, employeeID int) (entity.Employee, error)
+
+        // GetTeacherProfile retrieves a teacher with the name and the rating of the taught courses.
+        GetTeacherProfile(ctx context.Context, employeeID int) (entity.TeacherProfile, error)
+
+        // GetTeacherProfilesByIDs retrieves the teachers among the employees, without the courses.
+        GetTeacherProfilesByIDs(ctx context.Context, employeeIDs []int) ([]entity.TeacherProfile, error)
+
+        // ListTeacherProfiles retrieves a page of teachers.
+        ListTeacherProfiles(ctx context.Context, afterID int, limit uint32) (entity.TeacherPage, error)
+
+        // UpsertTeacher creates or replaces the teacher profile of an employee.
+        UpsertTeacher(ctx context.Context, teacher entity.Teacher) error
+
+        // ListEmployeeCourses retrieves the courses an employee is assigned to.
+        ListEmployeeCourses(ctx context.Context, employeeID int) ([]entity.TeacherCourse, error)
+
+        // ListEmployeeCoursesByEmployees retrieves the courses of several employees by employee ID.
+        ListEmployeeCoursesByEmployees(ctx context.Context, employeeIDs []int) (map[int][]entity.TeacherCourse, error)
+
+        // ListCourseStaff retrieves the teachers, mentors and reviewers of a course.
+        ListCourseStaff(ctx context.Context, courseID int) ([]entity.CourseStaffMember, error)
+
+        // ListCourseStaffByCourses retrieves the staff of several courses by course ID.
+        ListCourseStaffByCourses(ctx context.Context, courseIDs []int) (map[int][]entity.CourseStaffMember, error)
+
+        // AssignCourseStaff gives an employee a role in a course.
+        AssignCourseStaff(ctx context.Context, member entity.CourseStaffMember) error
+
+        // UnassignCourseStaff takes a role in a course away from an employee.
+        UnassignCourseStaff(ctx context.Context, member entity.CourseStaffMember) error
+
+        // AddOutboxEvent stores a domain event in the transaction of the change.
+        AddOutboxEvent(ctx context.Context, event entity.Event) error
+
+        // ClaimOutboxEvents leases due events to the caller until leaseUntil.
+        ClaimOutboxEvents(ctx context.Context, now, leaseUntil time.Time, limit uint32) ([]entity.Event, error)
+
+        // MarkOutboxEventPublished -.
+        MarkOutboxEventPublished(ctx context.Context, eventID int64) error
+
+        // RetryOutboxEvent schedules the next publishing attempt of an event.
+        RetryOutboxEvent(ctx context.Context, eventID int64, nextAttemptAt time.Time, lastError string) error
+
+        // FailOutboxEvent stops retrying an event.
+        FailOutboxEvent(ctx context.Context, eventID int64, lastError string) error
     }
 
     RedisRepo interface {
-        // GetCourseById retrieves a course by its ID.
+        // GetCourseById retrieves a cached course, entity.ErrCourseNotFound is returned for IDs cached as missing.
         GetCourseById(ctx context.Context, courseID int) (entity.Course, error)
 
-        // GetUserById retrieves some info about user by their ID.
+        // SetCourse caches a course.
+        SetCourse(ctx context.Context, course entity.Course, ttl time.Duration) error
+
+        // SetCourseNotFound caches the absence of a course.
+        SetCourseNotFound(ctx context.Context, courseID int, ttl time.Duration) error
+
+        // DeleteCourse invalidates a cached course.
+        DeleteCourse(ctx context.Context, courseID int) error
+
+        // GetUserById retrieves a cached user, entity.ErrUserNotFound is returned for IDs cached as missing.
         GetUserById(ctx context.Context, userID int) (entity.User, error)
 
-        // GetTopCoursesReport retrieves a report of the top n courses.
-        GetTopCoursesReport(ctx context.Context, limit uint32) ([]entity.TopCoursesReport, error)
+        // SetUser caches a user.
+        SetUser(ctx context.Context, user entity.User, ttl time.Duration) error
+
+        // SetUserNotFound caches the absence of a user.
+        SetUserNotFound(ctx context.Context, userID int, ttl time.Duration) error
+
+        // DeleteUser invalidates a cached user.
+        DeleteUser(ctx context.Context, userID int) error
+
+        // GetTopCoursesReport retrieves a cached report of the top n courses and tells whether it is still fresh.
+        GetTopCoursesReport(ctx context.Context, limit uint32) ([]entity.TopCoursesReport, bool, error)
+
+        // SetTopCoursesReport stores a report of the top n courses in Redis,
+        // it is fresh for freshTTL and may be served stale for staleTTL after that.
+        SetTopCoursesReport(ctx context.Context, limit uint32, reports []entity.TopCoursesReport, freshTTL, staleTTL time.Duration) error
+
+        // DeleteTopCoursesReports invalidates cached top courses reports of all sizes.
+        DeleteTopCoursesReports(ctx context.Context) error
+
+        // GetDetailedPurchaseReport retrieves a cached detailed purchase report and tells whether it is still fresh.
+        GetDetailedPurchaseReport(ctx context.Context, filter entity.DetailedPurchaseReportFilter) ([]entity.DetailedPurchaseReport, bool, error)
+
+        // SetDetailedPurchaseReport stores a detailed purchase report in Redis,
+        // it is fresh for freshTTL and may be served stale for staleTTL after that.
+        SetDetailedPurchaseReport(ctx context.Context, filter entity.DetailedPurchaseReportFilter, reports []entity.DetailedPurchaseReport, freshTTL, staleTTL time.Duration) error
+
+        // AcquireLock tries to take a lock shared by all instances, returns the token to release it with.
+        AcquireLock(ctx context.Context, key string, ttl time.Duration) (string, bool, error)
+
+        // ReleaseLock releases a lock if it is still held with the token.
+        ReleaseLock(ctx context.Context, key, token string) error
+
+        // SaveRefreshToken stores an active refresh token of the account.
+        SaveRefreshToken(ctx context.Context, tokenID string, accountID int, ttl time.Duration) error
+
+        // ConsumeRefreshToken removes a refresh token and returns the account it belonged to.
+        ConsumeRefreshToken(ctx context.Context, tokenID string) (int, error)
+
+        // DeleteRefreshToken revokes a refresh token.
+        DeleteRefreshToken(ctx context.Context, tokenID string) error
+
+        // GetAccountPermissions retrieves cached permissions of the account.
+        GetAccountPermissions(ctx context.Context, accountID int) ([]entity.Permission, error)
+
+        // SetAccountPermissions caches permissions of the account.
+        SetAccountPermissions(ctx context.Context, accountID int, permissions []entity.Permission, ttl time.Duration) error
+    }
+
+    // PaymentGateway defines the operations of a payment provider.
+    PaymentGateway interface {
+        // CreatePaymentIntent starts a payment for the purchase's total price.
+        CreatePaymentIntent(ctx context.Context, purchase entity.Purchase) (entity.PaymentIntent, error)
+
+        // CapturePayment charges an authorized payment intent.
+        CapturePayment(ctx context.Context, intentID string) error
+
+        // RefundPayment returns the money of a captured payment intent.
+        RefundPayment(ctx context.Context, intentID string) error
+
+        // VerifyWebhook checks the signature of a webhook payload and decodes the event.
+        VerifyWebhook(payload []byte, signature string) (entity.PaymentEvent, error)
+    }
+
+    // CertificateRenderer renders downloadable certificates.
+    CertificateRenderer interface {
+        // RenderCertificate returns the certificate document with a link to its public verification page.
+        RenderCertificate(details entity.CertificateDetails, verifyURL string) ([]byte, error)
+    }
 
-        // SetTopCoursesReport stores a report of the top n courses in Redis.
-        SetTopCoursesReport(ctx context.Context, limit uint32, reports []entity.TopCoursesReport) error
+    // EventPublisher delivers domain events from the outbox to a message broker.
+    EventPublisher interface {
+        // Publish sends the event, an event may be published again after a failure or a restart.
+        Publish(ctx context.Context, event entity.Event) error
     }
 )
@@ -0,0 +1,201 @@
+// Package payment implements payment providers behind repo.PaymentGateway.
+package payment
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/deadnotxaa/education-platform/backend/internal/entity"
+	"github.com/deadnotxaa/education-platform/backend/pkg/logger"
+)
+
+const (
+    _signaturePrefix = "sha256="
+    _webhookTimeout  = 5 * time.Second
+)
+
+type fakeIntentStatus int
+
+const (
+    fakeIntentCreated fakeIntentStatus = iota
+    fakeIntentCaptured
+    fakeIntentRefunded
+)
+
+type fakeIntent struct {
+    entity.PaymentIntent
+    status fakeIntentStatus
+}
+
+// FakeGateway - in-process payment provider for local development.
+// Captures and refunds always succeed and are reported with signed webhooks sent to webhookURL.
+type FakeGateway struct {
+    secret     []byte
+    webhookURL string
+    client     *http.Client
+    l          logger.Interface
+
+    mu      sync.Mutex
+    intents map[string]*fakeIntent
+}
+
+// NewFake -.
+func NewFake(secret, webhookURL string, l logger.Interface) *FakeGateway {
+    return &FakeGateway{
+        secret:     []byte(secret),
+        webhookURL: webhookURL,
+        client:     &http.Client{Timeout: _webhookTimeout},
+        l:          l,
+        intents:    make(map[string]*fakeIntent),
+    }
+}
+
+func (g *FakeGateway) CreatePaymentIntent(_ context.Context, purchase entity.Purchase) (entity.PaymentIntent, error) {
+    id, err := randomID("pi_")
+    if err != nil {
+        return entity.PaymentIntent{}, fmt.Errorf("FakeGateway - CreatePaymentIntent - randomID: %w", err)
+    }
+
+    intent := entity.PaymentIntent{
+        ID:           id,
+        PurchaseID:   purchase.PurchaseID,
+        Amount:       purchase.TotalPrice,
+        ClientSecret: id + "_secret",
+    }
+
+    g.mu.Lock()
+    g.intents[id] = &fakeIntent{PaymentIntent: intent, status: fakeIntentCreated}
+    g.mu.Unlock()
+
+    return intent, nil
+}
+
+func (g *FakeGateway) CapturePayment(_ context.Context, intentID string) error {
+    intent, err := g.transition(intentID, fakeIntentCreated, fakeIntentCaptured)
+    if err != nil {
+        return fmt.Errorf("FakeGateway - CapturePayment - g.transition: %w", err)
+    }
+
+    g.notify(entity.PaymentEventSucceeded, intent)
+
+    return nil
+}
+
+func (g *FakeGateway) RefundPayment(_ context.Context, intentID string) error {
+    intent, err := g.transition(intentID, fakeIntentCaptured, fakeIntentRefunded)
+    if err != nil {
+        return fmt.Errorf("FakeGateway - RefundPayment - g.transition: %w", err)
+    }
+
+    g.notify(entity.PaymentEventRefunded, intent)
+
+    return nil
+}
+
+func (g *FakeGateway) VerifyWebhook(payload []byte, signature string) (entity.PaymentEvent, error) {
+    if !hmac.Equal([]byte(signature), []byte(g.Sign(payload))) {
+        return entity.PaymentEvent{}, entity.ErrInvalidWebhookSignature
+    }
+
+    var event entity.PaymentEvent
+    if err := json.Unmarshal(payload, &event); err != nil {
+        return entity.PaymentEvent{}, fmt.Errorf("FakeGateway - VerifyWebhook - json.Unmarshal: %w", err)
+    }
+
+    return event, nil
+}
+
+// Sign returns the signature header value of a webhook payload.
+func (g *FakeGateway) Sign(payload []byte) string {
+    mac := hmac.New(sha256.New, g.secret)
+    mac.Write(payload)
+
+    return _signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+func (g *FakeGateway) transition(intentID string, from, to fakeIntentStatus) (entity.PaymentIntent, error) {
+    g.mu.Lock()
+    defer g.mu.Unlock()
+
+    intent, ok := g.intents[intentID]
+    if !ok {
+        return entity.PaymentIntent{}, entity.ErrPaymentNotFound
+    }
+
+    if intent.status != from {
+        return entity.PaymentIntent{}, entity.ErrInvalidPurchaseTransition
+    }
+
+    intent.status = to
+
+    return intent.PaymentIntent, nil
+}
+
+// notify delivers the webhook asynchronously, like a real provider would.
+func (g *FakeGateway) notify(eventType entity.PaymentEventType, intent entity.PaymentIntent) {
+    if g.webhookURL == "" {
+        return
+    }
+
+    go func() {
+        id, err := randomID("evt_")
+        if err != nil {
+            g.l.Error(fmt.Errorf("FakeGateway - notify - randomID: %w", err))
+
+            return
+        }
+
+        payload, err := json.Marshal(entity.PaymentEvent{
+            ID:         id,
+            Type:       eventType,
+            IntentID:   intent.ID,
+            PurchaseID: intent.PurchaseID,
+            Amount:     intent.Amount,
+        })
+        if err != nil {
+            g.l.Error(fmt.Errorf("FakeGateway - notify - json.Marshal: %w", err))
+
+            return
+        }
+
+        req, err := http.NewRequest(http.MethodPost, g.webhookURL, bytes.NewReader(payload))
+        if err != nil {
+            g.l.Error(fmt.Errorf("FakeGateway - notify - http.NewRequest: %w", err))
+
+            return
+        }
+
+        req.Header.Set("Content-Type", "application/json")
+        req.Header.Set(entity.PaymentSignatureHeader, g.Sign(payload))
+
+        resp, err := g.client.Do(req)
+        if err != nil {
+            g.l.Error(fmt.Errorf("FakeGateway - notify - client.Do: %w", err))
+
+            return
+        }
+        defer resp.Body.Close()
+
+        if resp.StatusCode >= http.StatusBadRequest {
+            g.l.Error(fmt.Errorf("FakeGateway - notify - webhook responded with %d", resp.StatusCode))
+        }
+    }()
+}
+
+func randomID(prefix string) (string, error) {
+    b := make([]byte, 12)
+    if _, err := rand.Read(b); err != nil {
+        return "", err
+    }
+
+    return prefix + hex.EncodeToString(b), nil
+}
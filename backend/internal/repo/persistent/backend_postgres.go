@@ -2,59 +2,49 @@ package persistent
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"log"
+	"time"
 
 	"github.com/deadnotxaa/education-platform/backend/internal/entity"
 	"github.com/deadnotxaa/education-platform/backend/internal/repo"
+	"github.com/deadnotxaa/education-platform/backend/pkg/logger"
 	"github.com/deadnotxaa/education-platform/backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+	"golang.org/x/sync/singleflight"
 )
 
 // PostgresRepo -.
 type PostgresRepo struct {
     *postgres.Postgres
     rr repo.RedisRepo
-}
-
-// New -.
-func New(pg *postgres.Postgres, rr repo.RedisRepo) *PostgresRepo {
-    return &PostgresRepo{pg, rr}
-}
-
-// GetCourseById -.
-func (r *PostgresRepo) GetCourseById(ctx context.Context, courseID int) (entity.Course, error) {
-    sql, args, err := r.Builder.
-        Select("course_id", "name", "description", "specialization_id", "duration", "price", "difficulty_level_id",
-            "created_at", "updated_at").
-        From("course").
-        Where("course_id = ?", courseID).
-        ToSql()
-
-    if err != nil {
-        return entity.Course{}, fmt.Errorf("PostgresRepo - GetCourse - r.Builder: %w", err)
-    }
-
-    row := r.Pool.QueryRow(ctx, sql, args...)
+    l  logger.Interface // Failures of background report refreshes and lock releases
 
-    ent := entity.Course{}
-    var createdAt, updatedAt interface{}
+    // Deduplicates concurrent rebuilds of the same report within the instance
+    sf singleflight.Group
 
-    err = row.Scan(&ent.CourseID, &ent.Name, &ent.Description, &ent.SpecializationID, &ent.Duration, &ent.Price,
-        &ent.DifficultyLevelID, &createdAt, &updatedAt)
+    reportTTL      time.Duration
+    reportStaleTTL time.Duration
+    reportLockTTL  time.Duration
+}
 
-    if err != nil {
-        return entity.Course{}, fmt.Errorf("PostgresRepo - GetCourse - row.Scan: %w", err)
+// New -.
+func New(pg *postgres.Postgres, rr repo.RedisRepo, l logger.Interface, opts ...Option) *PostgresRepo {
+    r := &PostgresRepo{
+        Postgres:       pg,
+        rr:             rr,
+        l:              l,
+        reportTTL:      _defaultReportTTL,
+        reportStaleTTL: _defaultReportStaleTTL,
+        reportLockTTL:  _defaultReportLockTTL,
     }
 
-    // Handle nullable timestamps TODO: change after redesign of the Postgres schema
-    if createdAt != nil {
-        ent.CreatedAt = createdAt.(string)
-    }
-    if updatedAt != nil {
-        ent.UpdatedAt = updatedAt.(string)
+    // Custom options
+    for _, opt := range opts {
+        opt(r)
     }
 
-    return ent, nil
+    return r
 }
 
 func (r *PostgresRepo) GetUserById(ctx context.Context, userID int) (entity.User, error) {
@@ -74,20 +64,39 @@ func (r *PostgresRepo) GetUserById(ctx context.Context, userID int) (entity.User
     err = row.Scan(&ent.AccountID, &ent.Name, &ent.Surname, &ent.Email)
 
     if err != nil {
+        if errors.Is(err, pgx.ErrNoRows) {
+            return entity.User{}, fmt.Errorf("PostgresRepo - GetUserById: %w", entity.ErrUserNotFound)
+        }
+
         return entity.User{}, fmt.Errorf("PostgresRepo - GetUserById - row.Scan: %w", err)
     }
 
     return ent, nil
 }
 
+// GetTopCoursesReport returns the top courses by average rating, cached in Redis.
 func (r *PostgresRepo) GetTopCoursesReport(ctx context.Context, limit uint32) ([]entity.TopCoursesReport, error) {
-    // Try to get data from Redis first
-    report, err := r.rr.GetTopCoursesReport(ctx, limit)
-    if err == nil {
-       log.Printf("reports found in Redis cache for limit %d", limit)
-       return report, nil
+    reports, err := loadReport(ctx, r, fmt.Sprintf("top_courses_report:%d", limit),
+        reportSource[[]entity.TopCoursesReport]{
+            get: func(ctx context.Context) ([]entity.TopCoursesReport, bool, error) {
+                return r.rr.GetTopCoursesReport(ctx, limit)
+            },
+            set: func(ctx context.Context, reports []entity.TopCoursesReport) error {
+                return r.rr.SetTopCoursesReport(ctx, limit, reports, r.reportTTL, r.reportStaleTTL)
+            },
+            query: func(ctx context.Context) ([]entity.TopCoursesReport, error) {
+                return r.queryTopCoursesReport(ctx, limit)
+            },
+        })
+
+    if err != nil {
+        return nil, fmt.Errorf("PostgresRepo - GetTopCoursesReport - loadReport: %w", err)
     }
 
+    return reports, nil
+}
+
+func (r *PostgresRepo) queryTopCoursesReport(ctx context.Context, limit uint32) ([]entity.TopCoursesReport, error) {
     rows, err := r.Pool.Query(ctx,
         `SELECT 
             c.name AS course_name,
@@ -98,9 +107,10 @@ func (r *PostgresRepo) GetTopCoursesReport(ctx context.Context, limit uint32) ([
             COALESCE(STRING_AGG(DISTINCT t.work_place, ', '), '') AS teachers_work_places
         FROM course c
         JOIN difficulty_level dl ON c.difficulty_level_id = dl.id
-        LEFT JOIN course_review cr ON c.course_id = cr.course_id
-        LEFT JOIN course_teacher ct ON c.course_id = ct.course_id
+        LEFT JOIN course_review cr ON c.course_id = cr.course_id AND cr.moderation_status = 'Approved'
+        LEFT JOIN course_teacher ct ON c.course_id = ct.course_id AND ct.staff_role = 'Teacher'
         LEFT JOIN teacher t ON ct.teacher_id = t.employee_id
+        WHERE c.deleted_at IS NULL
         GROUP BY c.course_id, c.name, dl.name, c.duration
         ORDER BY avg_rating DESC NULLS LAST
         LIMIT $1;`,
@@ -108,7 +118,7 @@ func (r *PostgresRepo) GetTopCoursesReport(ctx context.Context, limit uint32) ([
     )
 
     if err != nil {
-        return nil, fmt.Errorf("PostgresRepo - GetTopCoursesReport - r.Pool.Query: %w", err)
+        return nil, fmt.Errorf("r.Pool.Query: %w", err)
     }
     defer rows.Close()
 
@@ -121,15 +131,14 @@ func (r *PostgresRepo) GetTopCoursesReport(ctx context.Context, limit uint32) ([
             &e.TotalReviews, &e.TeachersWorkPlaces)
 
         if err != nil {
-            return nil, fmt.Errorf("PostgresRepo - GetTopCoursesReport - rows.Scan: %w", err)
+            return nil, fmt.Errorf("rows.Scan: %w", err)
         }
 
         entities = append(entities, e)
     }
 
-    err = r.rr.SetTopCoursesReport(ctx, limit, entities)
-    if err != nil {
-        return nil, fmt.Errorf("PostgresRepo - GetTopCoursesReport - r.rr.SetTopCoursesReport: %w", err)
+    if err = rows.Err(); err != nil {
+        return nil, fmt.Errorf("rows.Err: %w", err)
     }
 
     return entities, nil
@@ -0,0 +1,365 @@
+package persistent
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/deadnotxaa/education-platform/backend/internal/entity"
+	"github.com/jackc/pgx/v5"
+)
+
+var _blogPostColumns = []string{
+    "bp.post_id",
+    "COALESCE(bp.author_id, 0)",
+    "COALESCE(bp.title, '')",
+    "bp.publication_date",
+    "COALESCE(bp.topic, '')",
+    "COALESCE(bp.reading_time_minutes, 0)",
+    "COALESCE(bp.cover_image_url, '')",
+    "COALESCE(bp.content, '')",
+    "bp.status",
+    `COALESCE((
+        SELECT ARRAY_AGG(t.name ORDER BY t.name)
+        FROM post_tag pt
+        JOIN tag t ON t.tag_id = pt.tag_id
+        WHERE pt.post_id = bp.post_id
+    ), '{}')`,
+}
+
+// blogCursor - position of the last returned post, encoded into an opaque string.
+type blogCursor struct {
+    PublicationDate time.Time `json:"t"`
+    ID              int       `json:"id"`
+}
+
+// CreateBlogPost stores a new draft.
+func (r *PostgresRepo) CreateBlogPost(ctx context.Context, post entity.BlogPost) (int, error) {
+    sql, args, err := r.Builder.
+        Insert("blog_post").
+        Columns("author_id", "title", "topic", "reading_time_minutes", "cover_image_url", "content", "status").
+        Values(post.AuthorID, post.Title, post.Topic, post.ReadingTimeMinutes, post.CoverImageUrl, post.Content,
+            string(entity.BlogPostStatusDraft)).
+        Suffix("RETURNING post_id").
+        ToSql()
+
+    if err != nil {
+        return 0, fmt.Errorf("PostgresRepo - CreateBlogPost - r.Builder: %w", err)
+    }
+
+    var postID int
+    if err = r.db(ctx).QueryRow(ctx, sql, args...).Scan(&postID); err != nil {
+        return 0, fmt.Errorf("PostgresRepo - CreateBlogPost - row.Scan: %w", err)
+    }
+
+    return postID, nil
+}
+
+// UpdateBlogPost replaces the text fields of a post.
+func (r *PostgresRepo) UpdateBlogPost(ctx context.Context, post entity.BlogPost) error {
+    sql, args, err := r.Builder.
+        Update("blog_post").
+        Set("title", post.Title).
+        Set("topic", post.Topic).
+        Set("reading_time_minutes", post.ReadingTimeMinutes).
+        Set("cover_image_url", post.CoverImageUrl).
+        Set("content", post.Content).
+        Where("post_id = ?", post.PostID).
+        ToSql()
+
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - UpdateBlogPost - r.Builder: %w", err)
+    }
+
+    tag, err := r.db(ctx).Exec(ctx, sql, args...)
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - UpdateBlogPost - r.db.Exec: %w", err)
+    }
+
+    if tag.RowsAffected() == 0 {
+        return fmt.Errorf("PostgresRepo - UpdateBlogPost: %w", entity.ErrBlogPostNotFound)
+    }
+
+    return nil
+}
+
+// SetBlogPostTags replaces the tags of a post, missing tags are created.
+func (r *PostgresRepo) SetBlogPostTags(ctx context.Context, postID int, tags []string) error {
+    _, err := r.db(ctx).Exec(ctx, `DELETE FROM post_tag WHERE post_id = $1;`, postID)
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - SetBlogPostTags - r.db.Exec: %w", err)
+    }
+
+    if len(tags) == 0 {
+        return nil
+    }
+
+    _, err = r.db(ctx).Exec(ctx,
+        `INSERT INTO tag (name) SELECT UNNEST($1::varchar[]) ON CONFLICT (name) DO NOTHING;`,
+        tags,
+    )
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - SetBlogPostTags - r.db.Exec: %w", err)
+    }
+
+    _, err = r.db(ctx).Exec(ctx,
+        `INSERT INTO post_tag (post_id, tag_id)
+        SELECT $1, tag_id FROM tag WHERE name = ANY($2::varchar[])
+        ON CONFLICT DO NOTHING;`,
+        postID, tags,
+    )
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - SetBlogPostTags - r.db.Exec: %w", err)
+    }
+
+    return nil
+}
+
+// GetBlogPost retrieves a post in any status with its tags.
+func (r *PostgresRepo) GetBlogPost(ctx context.Context, postID int) (entity.BlogPost, error) {
+    sql, args, err := r.Builder.
+        Select(_blogPostColumns...).
+        From("blog_post bp").
+        Where("bp.post_id = ?", postID).
+        ToSql()
+
+    if err != nil {
+        return entity.BlogPost{}, fmt.Errorf("PostgresRepo - GetBlogPost - r.Builder: %w", err)
+    }
+
+    ent, err := scanBlogPost(r.db(ctx).QueryRow(ctx, sql, args...))
+    if err != nil {
+        return entity.BlogPost{}, fmt.Errorf("PostgresRepo - GetBlogPost - scanBlogPost: %w", err)
+    }
+
+    return ent, nil
+}
+
+// GetBlogPostStatusForUpdate locks a post until the end of the transaction and returns its status.
+func (r *PostgresRepo) GetBlogPostStatusForUpdate(ctx context.Context, postID int) (entity.BlogPostStatus, error) {
+    sql, args, err := r.Builder.
+        Select("status").
+        From("blog_post").
+        Where("post_id = ?", postID).
+        Suffix("FOR UPDATE").
+        ToSql()
+
+    if err != nil {
+        return "", fmt.Errorf("PostgresRepo - GetBlogPostStatusForUpdate - r.Builder: %w", err)
+    }
+
+    var status string
+    if err = r.db(ctx).QueryRow(ctx, sql, args...).Scan(&status); err != nil {
+        if errors.Is(err, pgx.ErrNoRows) {
+            return "", fmt.Errorf("PostgresRepo - GetBlogPostStatusForUpdate: %w", entity.ErrBlogPostNotFound)
+        }
+
+        return "", fmt.Errorf("PostgresRepo - GetBlogPostStatusForUpdate - row.Scan: %w", err)
+    }
+
+    return entity.BlogPostStatus(status), nil
+}
+
+// SetBlogPostStatus schedules or publishes a post at the date, stored in UTC.
+func (r *PostgresRepo) SetBlogPostStatus(ctx context.Context, postID int, status entity.BlogPostStatus,
+    publicationDate time.Time) error {
+    sql, args, err := r.Builder.
+        Update("blog_post").
+        Set("status", string(status)).
+        Set("publication_date", publicationDate.UTC()).
+        Where("post_id = ?", postID).
+        ToSql()
+
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - SetBlogPostStatus - r.Builder: %w", err)
+    }
+
+    tag, err := r.db(ctx).Exec(ctx, sql, args...)
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - SetBlogPostStatus - r.db.Exec: %w", err)
+    }
+
+    if tag.RowsAffected() == 0 {
+        return fmt.Errorf("PostgresRepo - SetBlogPostStatus: %w", entity.ErrBlogPostNotFound)
+    }
+
+    return nil
+}
+
+// ListBlogPosts returns a page of published posts using keyset pagination on (publication_date, post_id), newest first.
+func (r *PostgresRepo) ListBlogPosts(ctx context.Context, filter entity.BlogFilter) (entity.BlogPostPage, error) {
+    builder := r.Builder.
+        Select(_blogPostColumns...).
+        From("blog_post bp").
+        Where(squirrel.Eq{"bp.status": string(entity.BlogPostStatusPublished)})
+
+    if filter.Topic != "" {
+        builder = builder.Where(squirrel.Eq{"bp.topic": filter.Topic})
+    }
+    if filter.Tag != "" {
+        builder = builder.Where(`EXISTS (
+            SELECT 1 FROM post_tag pt JOIN tag t ON t.tag_id = pt.tag_id
+            WHERE pt.post_id = bp.post_id AND t.name = ?
+        )`, filter.Tag)
+    }
+
+    if filter.Cursor != "" {
+        cursor, err := decodeBlogCursor(filter.Cursor)
+        if err != nil {
+            return entity.BlogPostPage{}, fmt.Errorf("PostgresRepo - ListBlogPosts - decodeBlogCursor: %w", err)
+        }
+
+        builder = builder.Where("(bp.publication_date, bp.post_id) < (?, ?)", cursor.PublicationDate, cursor.ID)
+    }
+
+    // One extra row tells whether there is a next page
+    sql, args, err := builder.
+        OrderBy("bp.publication_date DESC", "bp.post_id DESC").
+        Limit(uint64(filter.Limit) + 1).
+        ToSql()
+
+    if err != nil {
+        return entity.BlogPostPage{}, fmt.Errorf("PostgresRepo - ListBlogPosts - r.Builder: %w", err)
+    }
+
+    posts, err := r.queryBlogPosts(ctx, sql, args...)
+    if err != nil {
+        return entity.BlogPostPage{}, fmt.Errorf("PostgresRepo - ListBlogPosts - r.queryBlogPosts: %w", err)
+    }
+
+    page := entity.BlogPostPage{Posts: posts}
+
+    if len(posts) > int(filter.Limit) {
+        page.Posts = posts[:filter.Limit]
+        last := page.Posts[len(page.Posts)-1]
+
+        page.NextCursor, err = encodeBlogCursor(last)
+        if err != nil {
+            return entity.BlogPostPage{}, fmt.Errorf("PostgresRepo - ListBlogPosts - encodeBlogCursor: %w", err)
+        }
+    }
+
+    return page, nil
+}
+
+// ListUnpublishedBlogPosts retrieves drafts and scheduled posts, newest first.
+func (r *PostgresRepo) ListUnpublishedBlogPosts(ctx context.Context) ([]entity.BlogPost, error) {
+    sql, args, err := r.Builder.
+        Select(_blogPostColumns...).
+        From("blog_post bp").
+        Where(squirrel.Eq{"bp.status": []string{
+            string(entity.BlogPostStatusDraft), string(entity.BlogPostStatusScheduled),
+        }}).
+        OrderBy("bp.post_id DESC").
+        ToSql()
+
+    if err != nil {
+        return nil, fmt.Errorf("PostgresRepo - ListUnpublishedBlogPosts - r.Builder: %w", err)
+    }
+
+    posts, err := r.queryBlogPosts(ctx, sql, args...)
+    if err != nil {
+        return nil, fmt.Errorf("PostgresRepo - ListUnpublishedBlogPosts - r.queryBlogPosts: %w", err)
+    }
+
+    return posts, nil
+}
+
+// PublishDueBlogPosts publishes scheduled posts whose publication date has come and returns their number.
+func (r *PostgresRepo) PublishDueBlogPosts(ctx context.Context, now time.Time) (int64, error) {
+    sql, args, err := r.Builder.
+        Update("blog_post").
+        Set("status", string(entity.BlogPostStatusPublished)).
+        Where(squirrel.Eq{"status": string(entity.BlogPostStatusScheduled)}).
+        Where(squirrel.LtOrEq{"publication_date": now.UTC()}).
+        ToSql()
+
+    if err != nil {
+        return 0, fmt.Errorf("PostgresRepo - PublishDueBlogPosts - r.Builder: %w", err)
+    }
+
+    tag, err := r.Pool.Exec(ctx, sql, args...)
+    if err != nil {
+        return 0, fmt.Errorf("PostgresRepo - PublishDueBlogPosts - r.Pool.Exec: %w", err)
+    }
+
+    return tag.RowsAffected(), nil
+}
+
+func (r *PostgresRepo) queryBlogPosts(ctx context.Context, sql string, args ...any) ([]entity.BlogPost, error) {
+    rows, err := r.Pool.Query(ctx, sql, args...)
+    if err != nil {
+        return nil, fmt.Errorf("r.Pool.Query: %w", err)
+    }
+    defer rows.Close()
+
+    posts := make([]entity.BlogPost, 0)
+
+    for rows.Next() {
+        e, err := scanBlogPost(rows)
+        if err != nil {
+            return nil, fmt.Errorf("scanBlogPost: %w", err)
+        }
+
+        posts = append(posts, e)
+    }
+
+    if err = rows.Err(); err != nil {
+        return nil, fmt.Errorf("rows.Err: %w", err)
+    }
+
+    return posts, nil
+}
+
+func scanBlogPost(row pgx.Row) (entity.BlogPost, error) {
+    ent := entity.BlogPost{}
+    var publicationDate *time.Time
+    var status string
+
+    err := row.Scan(&ent.PostID, &ent.AuthorID, &ent.Title, &publicationDate, &ent.Topic, &ent.ReadingTimeMinutes,
+        &ent.CoverImageUrl, &ent.Content, &status, &ent.Tags)
+    if err != nil {
+        if errors.Is(err, pgx.ErrNoRows) {
+            return entity.BlogPost{}, entity.ErrBlogPostNotFound
+        }
+
+        return entity.BlogPost{}, err
+    }
+
+    ent.PublicationDate = formatTime(publicationDate)
+    ent.Status = entity.BlogPostStatus(status)
+
+    return ent, nil
+}
+
+func encodeBlogCursor(post entity.BlogPost) (string, error) {
+    publicationDate, err := time.Parse(time.RFC3339, post.PublicationDate)
+    if err != nil {
+        return "", fmt.Errorf("time.Parse: %w", err)
+    }
+
+    data, err := json.Marshal(blogCursor{PublicationDate: publicationDate, ID: post.PostID})
+    if err != nil {
+        return "", fmt.Errorf("json.Marshal: %w", err)
+    }
+
+    return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeBlogCursor(encoded string) (blogCursor, error) {
+    data, err := base64.RawURLEncoding.DecodeString(encoded)
+    if err != nil {
+        return blogCursor{}, entity.ErrInvalidCursor
+    }
+
+    var cursor blogCursor
+    if err = json.Unmarshal(data, &cursor); err != nil {
+        return blogCursor{}, entity.ErrInvalidCursor
+    }
+
+    return cursor, nil
+}
@@ -0,0 +1,393 @@
+package persistent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/deadnotxaa/education-platform/backend/internal/entity"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+var (
+    _calendarColumns = []string{"id", "course_id", "start_date", "end_sales_date", "COALESCE(remaining_places, 0)"}
+
+    _waitlistColumns = []string{
+        "entry_id", "course_calendar_id", "user_id", "status", "created_at", "offer_expires_at",
+    }
+)
+
+// CreateCourseCalendar schedules a new cohort of a course.
+func (r *PostgresRepo) CreateCourseCalendar(ctx context.Context, calendar entity.CourseCalendar) (entity.CourseCalendar, error) {
+    sql, args, err := r.Builder.
+        Insert("course_calendar").
+        Columns("course_id", "start_date", "end_sales_date", "remaining_places").
+        Values(calendar.CourseID, calendar.StartDate, calendar.EndSalesDate, calendar.RemainingPlaces).
+        Suffix("RETURNING " + strings.Join(_calendarColumns, ", ")).
+        ToSql()
+
+    if err != nil {
+        return entity.CourseCalendar{}, fmt.Errorf("PostgresRepo - CreateCourseCalendar - r.Builder: %w", err)
+    }
+
+    ent, err := scanCalendar(r.db(ctx).QueryRow(ctx, sql, args...))
+    if err != nil {
+        // The only foreign key of a cohort references its course
+        var pgErr *pgconn.PgError
+        if errors.As(err, &pgErr) && pgErr.Code == _foreignKeyViolation {
+            return entity.CourseCalendar{}, fmt.Errorf("PostgresRepo - CreateCourseCalendar: %w", entity.ErrCourseNotFound)
+        }
+
+        return entity.CourseCalendar{}, fmt.Errorf("PostgresRepo - CreateCourseCalendar - scanCalendar: %w", err)
+    }
+
+    return ent, nil
+}
+
+// UpdateCourseCalendar replaces the dates and the number of free places of a cohort.
+func (r *PostgresRepo) UpdateCourseCalendar(ctx context.Context, calendar entity.CourseCalendar) (entity.CourseCalendar, error) {
+    sql, args, err := r.Builder.
+        Update("course_calendar").
+        Set("start_date", calendar.StartDate).
+        Set("end_sales_date", calendar.EndSalesDate).
+        Set("remaining_places", calendar.RemainingPlaces).
+        Where("id = ?", calendar.ID).
+        Suffix("RETURNING " + strings.Join(_calendarColumns, ", ")).
+        ToSql()
+
+    if err != nil {
+        return entity.CourseCalendar{}, fmt.Errorf("PostgresRepo - UpdateCourseCalendar - r.Builder: %w", err)
+    }
+
+    ent, err := scanCalendar(r.db(ctx).QueryRow(ctx, sql, args...))
+    if err != nil {
+        return entity.CourseCalendar{}, fmt.Errorf("PostgresRepo - UpdateCourseCalendar - scanCalendar: %w", err)
+    }
+
+    return ent, nil
+}
+
+// ListUpcomingCourseCalendars retrieves cohorts of a course starting on or after the date, soonest first.
+func (r *PostgresRepo) ListUpcomingCourseCalendars(ctx context.Context, courseID int, from time.Time) ([]entity.CourseCalendar, error) {
+    calendars, err := r.ListUpcomingCourseCalendarsByCourses(ctx, []int{courseID}, from)
+    if err != nil {
+        return nil, fmt.Errorf("PostgresRepo - ListUpcomingCourseCalendars - r.ListUpcomingCourseCalendarsByCourses: %w", err)
+    }
+
+    if calendars[courseID] == nil {
+        return make([]entity.CourseCalendar, 0), nil
+    }
+
+    return calendars[courseID], nil
+}
+
+// ListUpcomingCourseCalendarsByCourses retrieves upcoming cohorts of several courses by course ID,
+// courses without cohorts are left out.
+func (r *PostgresRepo) ListUpcomingCourseCalendarsByCourses(ctx context.Context, courseIDs []int,
+    from time.Time) (map[int][]entity.CourseCalendar, error) {
+    sql, args, err := r.Builder.
+        Select(_calendarColumns...).
+        From("course_calendar").
+        Where(squirrel.Eq{"course_id": courseIDs}).
+        Where("start_date >= ?", from.Format(time.DateOnly)).
+        OrderBy("course_id", "start_date", "id").
+        ToSql()
+
+    if err != nil {
+        return nil, fmt.Errorf("PostgresRepo - ListUpcomingCourseCalendarsByCourses - r.Builder: %w", err)
+    }
+
+    rows, err := r.Pool.Query(ctx, sql, args...)
+    if err != nil {
+        return nil, fmt.Errorf("PostgresRepo - ListUpcomingCourseCalendarsByCourses - r.Pool.Query: %w", err)
+    }
+    defer rows.Close()
+
+    calendars := make(map[int][]entity.CourseCalendar, len(courseIDs))
+
+    for rows.Next() {
+        e, err := scanCalendar(rows)
+        if err != nil {
+            return nil, fmt.Errorf("PostgresRepo - ListUpcomingCourseCalendarsByCourses - scanCalendar: %w", err)
+        }
+
+        calendars[e.CourseID] = append(calendars[e.CourseID], e)
+    }
+
+    if err = rows.Err(); err != nil {
+        return nil, fmt.Errorf("PostgresRepo - ListUpcomingCourseCalendarsByCourses - rows.Err: %w", err)
+    }
+
+    return calendars, nil
+}
+
+// CreateWaitlistEntry puts the user at the end of the waitlist of a cohort.
+func (r *PostgresRepo) CreateWaitlistEntry(ctx context.Context, calendarID, userID int) (entity.WaitlistEntry, error) {
+    sql, args, err := r.Builder.
+        Insert("waitlist_entry").
+        Columns("course_calendar_id", "user_id", "status", "created_at").
+        Values(calendarID, userID, string(entity.WaitlistStatusWaiting), time.Now()).
+        Suffix("RETURNING " + strings.Join(_waitlistColumns, ", ")).
+        ToSql()
+
+    if err != nil {
+        return entity.WaitlistEntry{}, fmt.Errorf("PostgresRepo - CreateWaitlistEntry - r.Builder: %w", err)
+    }
+
+    ent, err := scanWaitlistEntry(r.db(ctx).QueryRow(ctx, sql, args...))
+    if err != nil {
+        var pgErr *pgconn.PgError
+        if errors.As(err, &pgErr) && pgErr.Code == _uniqueViolation {
+            return entity.WaitlistEntry{}, fmt.Errorf("PostgresRepo - CreateWaitlistEntry: %w", entity.ErrAlreadyInWaitlist)
+        }
+
+        return entity.WaitlistEntry{}, fmt.Errorf("PostgresRepo - CreateWaitlistEntry - scanWaitlistEntry: %w", err)
+    }
+
+    return ent, nil
+}
+
+// GetActiveWaitlistEntryForUpdate retrieves the waiting or offered entry of the user in a cohort and locks it.
+func (r *PostgresRepo) GetActiveWaitlistEntryForUpdate(ctx context.Context, calendarID, userID int) (entity.WaitlistEntry, error) {
+    sql, args, err := r.Builder.
+        Select(_waitlistColumns...).
+        From("waitlist_entry").
+        Where(squirrel.Eq{
+            "course_calendar_id": calendarID,
+            "user_id":            userID,
+            "status":             []string{string(entity.WaitlistStatusWaiting), string(entity.WaitlistStatusOffered)},
+        }).
+        Suffix("FOR UPDATE").
+        ToSql()
+
+    if err != nil {
+        return entity.WaitlistEntry{}, fmt.Errorf("PostgresRepo - GetActiveWaitlistEntryForUpdate - r.Builder: %w", err)
+    }
+
+    ent, err := scanWaitlistEntry(r.db(ctx).QueryRow(ctx, sql, args...))
+    if err != nil {
+        return entity.WaitlistEntry{}, fmt.Errorf("PostgresRepo - GetActiveWaitlistEntryForUpdate - scanWaitlistEntry: %w", err)
+    }
+
+    return ent, nil
+}
+
+// GetWaitlistEntryForUpdate retrieves a waitlist entry by its ID and locks it.
+func (r *PostgresRepo) GetWaitlistEntryForUpdate(ctx context.Context, entryID int) (entity.WaitlistEntry, error) {
+    sql, args, err := r.Builder.
+        Select(_waitlistColumns...).
+        From("waitlist_entry").
+        Where("entry_id = ?", entryID).
+        Suffix("FOR UPDATE").
+        ToSql()
+
+    if err != nil {
+        return entity.WaitlistEntry{}, fmt.Errorf("PostgresRepo - GetWaitlistEntryForUpdate - r.Builder: %w", err)
+    }
+
+    ent, err := scanWaitlistEntry(r.db(ctx).QueryRow(ctx, sql, args...))
+    if err != nil {
+        return entity.WaitlistEntry{}, fmt.Errorf("PostgresRepo - GetWaitlistEntryForUpdate - scanWaitlistEntry: %w", err)
+    }
+
+    return ent, nil
+}
+
+// SetWaitlistEntryStatus closes a waitlist entry as claimed, expired or left.
+func (r *PostgresRepo) SetWaitlistEntryStatus(ctx context.Context, entryID int, status entity.WaitlistStatus) error {
+    sql, args, err := r.Builder.
+        Update("waitlist_entry").
+        Set("status", string(status)).
+        Where("entry_id = ?", entryID).
+        ToSql()
+
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - SetWaitlistEntryStatus - r.Builder: %w", err)
+    }
+
+    tag, err := r.db(ctx).Exec(ctx, sql, args...)
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - SetWaitlistEntryStatus - r.db.Exec: %w", err)
+    }
+
+    if tag.RowsAffected() == 0 {
+        return fmt.Errorf("PostgresRepo - SetWaitlistEntryStatus: %w", entity.ErrWaitlistEntryNotFound)
+    }
+
+    return nil
+}
+
+// OfferSeatToNextWaiting holds a seat for the user who has waited the longest, the cohort must be locked.
+// entity.ErrWaitlistEntryNotFound is returned if nobody is waiting.
+func (r *PostgresRepo) OfferSeatToNextWaiting(ctx context.Context, calendarID int, expiresAt time.Time) (entity.WaitlistEntry, error) {
+    next, args, err := r.Builder.
+        Select("entry_id").
+        From("waitlist_entry").
+        Where(squirrel.Eq{"course_calendar_id": calendarID, "status": string(entity.WaitlistStatusWaiting)}).
+        OrderBy("entry_id").
+        Limit(1).
+        ToSql()
+
+    if err != nil {
+        return entity.WaitlistEntry{}, fmt.Errorf("PostgresRepo - OfferSeatToNextWaiting - r.Builder: %w", err)
+    }
+
+    sql, args, err := r.Builder.
+        Update("waitlist_entry").
+        Set("status", string(entity.WaitlistStatusOffered)).
+        Set("offered_at", time.Now()).
+        Set("offer_expires_at", expiresAt).
+        Where("entry_id = ("+next+")", args...).
+        Suffix("RETURNING " + strings.Join(_waitlistColumns, ", ")).
+        ToSql()
+
+    if err != nil {
+        return entity.WaitlistEntry{}, fmt.Errorf("PostgresRepo - OfferSeatToNextWaiting - r.Builder: %w", err)
+    }
+
+    ent, err := scanWaitlistEntry(r.db(ctx).QueryRow(ctx, sql, args...))
+    if err != nil {
+        return entity.WaitlistEntry{}, fmt.Errorf("PostgresRepo - OfferSeatToNextWaiting - scanWaitlistEntry: %w", err)
+    }
+
+    return ent, nil
+}
+
+// ListExpiredWaitlistOffers retrieves up to limit offers that expired before the time, oldest first.
+func (r *PostgresRepo) ListExpiredWaitlistOffers(ctx context.Context, before time.Time, limit uint32) ([]entity.WaitlistEntry, error) {
+    sql, args, err := r.Builder.
+        Select(_waitlistColumns...).
+        From("waitlist_entry").
+        Where(squirrel.Eq{"status": string(entity.WaitlistStatusOffered)}).
+        Where(squirrel.Lt{"offer_expires_at": before}).
+        OrderBy("offer_expires_at").
+        Limit(uint64(limit)).
+        ToSql()
+
+    if err != nil {
+        return nil, fmt.Errorf("PostgresRepo - ListExpiredWaitlistOffers - r.Builder: %w", err)
+    }
+
+    rows, err := r.Pool.Query(ctx, sql, args...)
+    if err != nil {
+        return nil, fmt.Errorf("PostgresRepo - ListExpiredWaitlistOffers - r.Pool.Query: %w", err)
+    }
+    defer rows.Close()
+
+    entries := make([]entity.WaitlistEntry, 0)
+
+    for rows.Next() {
+        e, err := scanWaitlistEntry(rows)
+        if err != nil {
+            return nil, fmt.Errorf("PostgresRepo - ListExpiredWaitlistOffers - scanWaitlistEntry: %w", err)
+        }
+
+        entries = append(entries, e)
+    }
+
+    if err = rows.Err(); err != nil {
+        return nil, fmt.Errorf("PostgresRepo - ListExpiredWaitlistOffers - rows.Err: %w", err)
+    }
+
+    return entries, nil
+}
+
+// ListUserWaitlistEntries retrieves the active waitlist entries of the user with their place in the queue.
+func (r *PostgresRepo) ListUserWaitlistEntries(ctx context.Context, userID int) ([]entity.WaitlistEntry, error) {
+    rows, err := r.Pool.Query(ctx,
+        `SELECT
+            w.entry_id,
+            w.course_calendar_id,
+            w.user_id,
+            w.status,
+            w.created_at,
+            w.offer_expires_at,
+            CASE WHEN w.status = 'Waiting' THEN (
+                SELECT COUNT(*)
+                FROM waitlist_entry ahead
+                WHERE ahead.course_calendar_id = w.course_calendar_id
+                  AND ahead.status = 'Waiting'
+                  AND ahead.entry_id <= w.entry_id
+            ) ELSE 0 END
+        FROM waitlist_entry w
+        WHERE w.user_id = $1 AND w.status IN ('Waiting', 'Offered')
+        ORDER BY w.entry_id;`,
+        userID,
+    )
+
+    if err != nil {
+        return nil, fmt.Errorf("PostgresRepo - ListUserWaitlistEntries - r.Pool.Query: %w", err)
+    }
+    defer rows.Close()
+
+    entries := make([]entity.WaitlistEntry, 0)
+
+    for rows.Next() {
+        e := entity.WaitlistEntry{}
+        var status string
+        var createdAt time.Time
+        var offerExpiresAt *time.Time
+
+        err = rows.Scan(&e.EntryID, &e.CalendarID, &e.UserID, &status, &createdAt, &offerExpiresAt, &e.Position)
+        if err != nil {
+            return nil, fmt.Errorf("PostgresRepo - ListUserWaitlistEntries - rows.Scan: %w", err)
+        }
+
+        e.Status = entity.WaitlistStatus(status)
+        e.CreatedAt = createdAt.Format(time.RFC3339)
+        e.OfferExpiresAt = formatTime(offerExpiresAt)
+
+        entries = append(entries, e)
+    }
+
+    if err = rows.Err(); err != nil {
+        return nil, fmt.Errorf("PostgresRepo - ListUserWaitlistEntries - rows.Err: %w", err)
+    }
+
+    return entries, nil
+}
+
+func scanCalendar(row pgx.Row) (entity.CourseCalendar, error) {
+    ent := entity.CourseCalendar{}
+    var startDate, endSalesDate *time.Time
+
+    err := row.Scan(&ent.ID, &ent.CourseID, &startDate, &endSalesDate, &ent.RemainingPlaces)
+    if err != nil {
+        if errors.Is(err, pgx.ErrNoRows) {
+            return entity.CourseCalendar{}, entity.ErrCourseCalendarNotFound
+        }
+
+        return entity.CourseCalendar{}, err
+    }
+
+    ent.StartDate = formatDate(startDate)
+    ent.EndSalesDate = formatDate(endSalesDate)
+
+    return ent, nil
+}
+
+func scanWaitlistEntry(row pgx.Row) (entity.WaitlistEntry, error) {
+    ent := entity.WaitlistEntry{}
+    var status string
+    var createdAt time.Time
+    var offerExpiresAt *time.Time
+
+    err := row.Scan(&ent.EntryID, &ent.CalendarID, &ent.UserID, &status, &createdAt, &offerExpiresAt)
+    if err != nil {
+        if errors.Is(err, pgx.ErrNoRows) {
+            return entity.WaitlistEntry{}, entity.ErrWaitlistEntryNotFound
+        }
+
+        return entity.WaitlistEntry{}, err
+    }
+
+    ent.Status = entity.WaitlistStatus(status)
+    ent.CreatedAt = createdAt.Format(time.RFC3339)
+    ent.OfferExpiresAt = formatTime(offerExpiresAt)
+
+    return ent, nil
+}
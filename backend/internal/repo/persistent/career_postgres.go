@@ -0,0 +1,201 @@
+package persistent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/deadnotxaa/education-platform/backend/internal/entity"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+var _careerStudentColumns = []string{
+    "id",
+    "user_id",
+    "course_id",
+    "COALESCE(cv_url, '')",
+    "career_support_start",
+    "COALESCE(support_period, 0)",
+    "career_support_start + support_period",
+}
+
+// CreateCareerStudent enrolls a graduate into career support for the course.
+func (r *PostgresRepo) CreateCareerStudent(ctx context.Context, student entity.CareerCenterStudent) (entity.CareerCenterStudent, error) {
+    sql, args, err := r.Builder.
+        Insert("career_center_student").
+        Columns("user_id", "course_id", "cv_url", "career_support_start", "support_period").
+        Values(student.UserID, student.CourseID, squirrel.Expr("NULLIF(?, '')", student.CVUrl),
+            student.CareerSupportStart, student.SupportPeriod).
+        Suffix("RETURNING " + strings.Join(_careerStudentColumns, ", ")).
+        ToSql()
+
+    if err != nil {
+        return entity.CareerCenterStudent{}, fmt.Errorf("PostgresRepo - CreateCareerStudent - r.Builder: %w", err)
+    }
+
+    ent, err := scanCareerStudent(r.db(ctx).QueryRow(ctx, sql, args...))
+    if err != nil {
+        var pgErr *pgconn.PgError
+        if errors.As(err, &pgErr) && pgErr.Code == _uniqueViolation {
+            return entity.CareerCenterStudent{}, fmt.Errorf("PostgresRepo - CreateCareerStudent: %w", entity.ErrAlreadyEnrolled)
+        }
+
+        return entity.CareerCenterStudent{}, fmt.Errorf("PostgresRepo - CreateCareerStudent - scanCareerStudent: %w", err)
+    }
+
+    return ent, nil
+}
+
+// GetCareerStudentByCourse retrieves the career support enrollment of the user for the course.
+func (r *PostgresRepo) GetCareerStudentByCourse(ctx context.Context, userID, courseID int) (entity.CareerCenterStudent, error) {
+    sql, args, err := r.Builder.
+        Select(_careerStudentColumns...).
+        From("career_center_student").
+        Where(squirrel.Eq{"user_id": userID, "course_id": courseID}).
+        ToSql()
+
+    if err != nil {
+        return entity.CareerCenterStudent{}, fmt.Errorf("PostgresRepo - GetCareerStudentByCourse - r.Builder: %w", err)
+    }
+
+    ent, err := scanCareerStudent(r.db(ctx).QueryRow(ctx, sql, args...))
+    if err != nil {
+        return entity.CareerCenterStudent{}, fmt.Errorf("PostgresRepo - GetCareerStudentByCourse - scanCareerStudent: %w", err)
+    }
+
+    return ent, nil
+}
+
+// GetCareerStudent -.
+func (r *PostgresRepo) GetCareerStudent(ctx context.Context, studentID int) (entity.CareerCenterStudent, error) {
+    sql, args, err := r.Builder.
+        Select(_careerStudentColumns...).
+        From("career_center_student").
+        Where("id = ?", studentID).
+        ToSql()
+
+    if err != nil {
+        return entity.CareerCenterStudent{}, fmt.Errorf("PostgresRepo - GetCareerStudent - r.Builder: %w", err)
+    }
+
+    ent, err := scanCareerStudent(r.db(ctx).QueryRow(ctx, sql, args...))
+    if err != nil {
+        return entity.CareerCenterStudent{}, fmt.Errorf("PostgresRepo - GetCareerStudent - scanCareerStudent: %w", err)
+    }
+
+    return ent, nil
+}
+
+// UpdateCareerStudentCV uploads or replaces the CV of a graduate enrolled for the course.
+func (r *PostgresRepo) UpdateCareerStudentCV(ctx context.Context, userID, courseID int, cvURL string) (entity.CareerCenterStudent, error) {
+    sql, args, err := r.Builder.
+        Update("career_center_student").
+        Set("cv_url", cvURL).
+        Where(squirrel.Eq{"user_id": userID, "course_id": courseID}).
+        Suffix("RETURNING " + strings.Join(_careerStudentColumns, ", ")).
+        ToSql()
+
+    if err != nil {
+        return entity.CareerCenterStudent{}, fmt.Errorf("PostgresRepo - UpdateCareerStudentCV - r.Builder: %w", err)
+    }
+
+    ent, err := scanCareerStudent(r.db(ctx).QueryRow(ctx, sql, args...))
+    if err != nil {
+        return entity.CareerCenterStudent{}, fmt.Errorf("PostgresRepo - UpdateCareerStudentCV - scanCareerStudent: %w", err)
+    }
+
+    return ent, nil
+}
+
+// ExtendSupportPeriod adds days to the career support period of a student.
+func (r *PostgresRepo) ExtendSupportPeriod(ctx context.Context, studentID, days int) (entity.CareerCenterStudent, error) {
+    sql, args, err := r.Builder.
+        Update("career_center_student").
+        Set("support_period", squirrel.Expr("COALESCE(support_period, 0) + ?", days)).
+        Where("id = ?", studentID).
+        Suffix("RETURNING " + strings.Join(_careerStudentColumns, ", ")).
+        ToSql()
+
+    if err != nil {
+        return entity.CareerCenterStudent{}, fmt.Errorf("PostgresRepo - ExtendSupportPeriod - r.Builder: %w", err)
+    }
+
+    ent, err := scanCareerStudent(r.db(ctx).QueryRow(ctx, sql, args...))
+    if err != nil {
+        return entity.CareerCenterStudent{}, fmt.Errorf("PostgresRepo - ExtendSupportPeriod - scanCareerStudent: %w", err)
+    }
+
+    return ent, nil
+}
+
+// ListExpiringCareerStudents retrieves a page of students whose support ends between the dates, inclusive.
+func (r *PostgresRepo) ListExpiringCareerStudents(ctx context.Context, from, to time.Time, afterID int,
+    limit uint32) (entity.CareerStudentPage, error) {
+    // One extra row tells whether there is a next page
+    sql, args, err := r.Builder.
+        Select(_careerStudentColumns...).
+        From("career_center_student").
+        Where("career_support_start + support_period BETWEEN ? AND ?",
+            from.Format(time.DateOnly), to.Format(time.DateOnly)).
+        Where(squirrel.Gt{"id": afterID}).
+        OrderBy("id").
+        Limit(uint64(limit) + 1).
+        ToSql()
+
+    if err != nil {
+        return entity.CareerStudentPage{}, fmt.Errorf("PostgresRepo - ListExpiringCareerStudents - r.Builder: %w", err)
+    }
+
+    rows, err := r.Pool.Query(ctx, sql, args...)
+    if err != nil {
+        return entity.CareerStudentPage{}, fmt.Errorf("PostgresRepo - ListExpiringCareerStudents - r.Pool.Query: %w", err)
+    }
+    defer rows.Close()
+
+    students := make([]entity.CareerCenterStudent, 0, limit+1)
+
+    for rows.Next() {
+        e, err := scanCareerStudent(rows)
+        if err != nil {
+            return entity.CareerStudentPage{}, fmt.Errorf("PostgresRepo - ListExpiringCareerStudents - scanCareerStudent: %w", err)
+        }
+
+        students = append(students, e)
+    }
+
+    if err = rows.Err(); err != nil {
+        return entity.CareerStudentPage{}, fmt.Errorf("PostgresRepo - ListExpiringCareerStudents - rows.Err: %w", err)
+    }
+
+    page := entity.CareerStudentPage{Students: students}
+
+    if len(students) > int(limit) {
+        page.Students = students[:limit]
+        page.NextID = page.Students[limit-1].ID
+    }
+
+    return page, nil
+}
+
+func scanCareerStudent(row pgx.Row) (entity.CareerCenterStudent, error) {
+    ent := entity.CareerCenterStudent{}
+    var supportStart, supportEnd *time.Time
+
+    err := row.Scan(&ent.ID, &ent.UserID, &ent.CourseID, &ent.CVUrl, &supportStart, &ent.SupportPeriod, &supportEnd)
+    if err != nil {
+        if errors.Is(err, pgx.ErrNoRows) {
+            return entity.CareerCenterStudent{}, entity.ErrCareerStudentNotFound
+        }
+
+        return entity.CareerCenterStudent{}, err
+    }
+
+    ent.CareerSupportStart = formatDate(supportStart)
+    ent.SupportEndDate = formatDate(supportEnd)
+
+    return ent, nil
+}
@@ -0,0 +1,186 @@
+package persistent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/deadnotxaa/education-platform/backend/internal/entity"
+	"github.com/jackc/pgx/v5"
+)
+
+var _certificateColumns = []string{"certificate_id", "user_id", "course_id", "issue_date"}
+
+// LockCompletedPurchase locks the completed purchase of the course by the user until the end of the transaction,
+// serializing operations that depend on it.
+func (r *PostgresRepo) LockCompletedPurchase(ctx context.Context, userID, courseID int) error {
+    sql, args, err := r.Builder.
+        Select("purchase_id").
+        From("purchase").
+        Where(squirrel.Eq{
+            "user_id":         userID,
+            "course_id":       courseID,
+            "purchase_status": entity.PurchaseStatusCompleted.String(),
+        }).
+        OrderBy("purchase_id").
+        Limit(1).
+        Suffix("FOR UPDATE").
+        ToSql()
+
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - LockCompletedPurchase - r.Builder: %w", err)
+    }
+
+    var purchaseID int
+    if err = r.db(ctx).QueryRow(ctx, sql, args...).Scan(&purchaseID); err != nil {
+        if errors.Is(err, pgx.ErrNoRows) {
+            return fmt.Errorf("PostgresRepo - LockCompletedPurchase: %w", entity.ErrCourseNotPurchased)
+        }
+
+        return fmt.Errorf("PostgresRepo - LockCompletedPurchase - row.Scan: %w", err)
+    }
+
+    return nil
+}
+
+// GetUserCourseCertificate retrieves the certificate of the user for the course.
+func (r *PostgresRepo) GetUserCourseCertificate(ctx context.Context, userID, courseID int) (entity.Certificate, error) {
+    sql, args, err := r.Builder.
+        Select(_certificateColumns...).
+        From("certificate").
+        Where(squirrel.Eq{"user_id": userID, "course_id": courseID}).
+        OrderBy("certificate_id").
+        Limit(1).
+        ToSql()
+
+    if err != nil {
+        return entity.Certificate{}, fmt.Errorf("PostgresRepo - GetUserCourseCertificate - r.Builder: %w", err)
+    }
+
+    ent, err := scanCertificate(r.db(ctx).QueryRow(ctx, sql, args...))
+    if err != nil {
+        return entity.Certificate{}, fmt.Errorf("PostgresRepo - GetUserCourseCertificate - scanCertificate: %w", err)
+    }
+
+    return ent, nil
+}
+
+// CreateCertificate -.
+func (r *PostgresRepo) CreateCertificate(ctx context.Context, certificate entity.Certificate) (entity.Certificate, error) {
+    sql, args, err := r.Builder.
+        Insert("certificate").
+        Columns("user_id", "course_id", "issue_date").
+        Values(certificate.UserID, certificate.CourseID, time.Now()).
+        Suffix("RETURNING certificate_id, user_id, course_id, issue_date").
+        ToSql()
+
+    if err != nil {
+        return entity.Certificate{}, fmt.Errorf("PostgresRepo - CreateCertificate - r.Builder: %w", err)
+    }
+
+    ent, err := scanCertificate(r.db(ctx).QueryRow(ctx, sql, args...))
+    if err != nil {
+        return entity.Certificate{}, fmt.Errorf("PostgresRepo - CreateCertificate - scanCertificate: %w", err)
+    }
+
+    return ent, nil
+}
+
+// ListUserCertificates -.
+func (r *PostgresRepo) ListUserCertificates(ctx context.Context, userID int) ([]entity.Certificate, error) {
+    sql, args, err := r.Builder.
+        Select(_certificateColumns...).
+        From("certificate").
+        Where("user_id = ?", userID).
+        OrderBy("certificate_id").
+        ToSql()
+
+    if err != nil {
+        return nil, fmt.Errorf("PostgresRepo - ListUserCertificates - r.Builder: %w", err)
+    }
+
+    rows, err := r.Pool.Query(ctx, sql, args...)
+    if err != nil {
+        return nil, fmt.Errorf("PostgresRepo - ListUserCertificates - r.Pool.Query: %w", err)
+    }
+    defer rows.Close()
+
+    certificates := make([]entity.Certificate, 0)
+
+    for rows.Next() {
+        e, err := scanCertificate(rows)
+        if err != nil {
+            return nil, fmt.Errorf("PostgresRepo - ListUserCertificates - scanCertificate: %w", err)
+        }
+
+        certificates = append(certificates, e)
+    }
+
+    if err = rows.Err(); err != nil {
+        return nil, fmt.Errorf("PostgresRepo - ListUserCertificates - rows.Err: %w", err)
+    }
+
+    return certificates, nil
+}
+
+// GetCertificateDetails retrieves a certificate with the student, course and teacher names printed on it.
+func (r *PostgresRepo) GetCertificateDetails(ctx context.Context, certificateID int) (entity.CertificateDetails, error) {
+    ent := entity.CertificateDetails{}
+    var issueDate *time.Time
+
+    err := r.Pool.QueryRow(ctx,
+        `SELECT
+            cert.certificate_id,
+            cert.user_id,
+            cert.course_id,
+            cert.issue_date,
+            CONCAT_WS(' ', u.name, u.surname),
+            c.name,
+            COALESCE(ARRAY(
+                SELECT CONCAT_WS(' ', tu.name, tu.surname)
+                FROM course_teacher ct
+                JOIN employee e ON e.id = ct.teacher_id
+                JOIN users tu ON tu.account_id = e.user_id
+                WHERE ct.course_id = cert.course_id AND ct.staff_role = 'Teacher'
+                ORDER BY tu.surname, tu.name
+            ), '{}')
+        FROM certificate cert
+        JOIN users u ON u.account_id = cert.user_id
+        JOIN course c ON c.course_id = cert.course_id
+        WHERE cert.certificate_id = $1;`,
+        certificateID,
+    ).Scan(&ent.CertificateID, &ent.UserID, &ent.CourseID, &issueDate, &ent.StudentName, &ent.CourseName,
+        &ent.Teachers)
+
+    if err != nil {
+        if errors.Is(err, pgx.ErrNoRows) {
+            return entity.CertificateDetails{}, fmt.Errorf("PostgresRepo - GetCertificateDetails: %w",
+                entity.ErrCertificateNotFound)
+        }
+
+        return entity.CertificateDetails{}, fmt.Errorf("PostgresRepo - GetCertificateDetails - row.Scan: %w", err)
+    }
+
+    ent.IssueDate = formatDate(issueDate)
+
+    return ent, nil
+}
+
+func scanCertificate(row pgx.Row) (entity.Certificate, error) {
+    ent := entity.Certificate{}
+    var issueDate *time.Time
+
+    if err := row.Scan(&ent.CertificateID, &ent.UserID, &ent.CourseID, &issueDate); err != nil {
+        if errors.Is(err, pgx.ErrNoRows) {
+            return entity.Certificate{}, entity.ErrCertificateNotFound
+        }
+
+        return entity.Certificate{}, err
+    }
+
+    ent.IssueDate = formatDate(issueDate)
+
+    return ent, nil
+}
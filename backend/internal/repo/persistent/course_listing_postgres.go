@@ -0,0 +1,211 @@
+package persistent
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/deadnotxaa/education-platform/backend/internal/entity"
+)
+
+// courseCursor - position of the last returned row, encoded into an opaque string.
+type courseCursor struct {
+    SortBy entity.CourseSortField `json:"s"`
+    Value  json.RawMessage        `json:"v,omitempty"`
+    ID     int                    `json:"id"`
+}
+
+// ListCourses returns a page of non-deleted courses using keyset pagination on (sort column, course_id).
+func (r *PostgresRepo) ListCourses(ctx context.Context, filter entity.CourseFilter) (entity.CoursePage, error) {
+    sortExpr := courseSortExpr(filter.SortBy)
+
+    direction, comparison := "ASC", ">"
+    if filter.Descending {
+        direction, comparison = "DESC", "<"
+    }
+
+    builder := r.Builder.
+        Select("c.course_id", "c.name", "c.description", "c.specialization_id", "c.duration", "c.price",
+            "c.difficulty_level_id", "c.created_at", "c.updated_at", "COALESCE(rt.avg_rating, 0)").
+        From("course c").
+        JoinClause(`LEFT JOIN LATERAL (
+            SELECT AVG(cr.rating)::float8 AS avg_rating FROM course_review cr
+            WHERE cr.course_id = c.course_id AND cr.moderation_status = 'Approved'
+        ) rt ON TRUE`).
+        Where("c.deleted_at IS NULL")
+
+    if filter.SpecializationID != 0 {
+        builder = builder.Where(squirrel.Eq{"c.specialization_id": filter.SpecializationID})
+    }
+    if filter.DifficultyLevelID != 0 {
+        builder = builder.Where(squirrel.Eq{"c.difficulty_level_id": filter.DifficultyLevelID})
+    }
+    if filter.MinPrice != nil {
+        builder = builder.Where(squirrel.GtOrEq{"c.price": *filter.MinPrice})
+    }
+    if filter.MaxPrice != nil {
+        builder = builder.Where(squirrel.LtOrEq{"c.price": *filter.MaxPrice})
+    }
+    if filter.MinDuration != nil {
+        builder = builder.Where(squirrel.GtOrEq{"c.duration": *filter.MinDuration})
+    }
+    if filter.MaxDuration != nil {
+        builder = builder.Where(squirrel.LtOrEq{"c.duration": *filter.MaxDuration})
+    }
+
+    if filter.Cursor != "" {
+        value, id, err := decodeCourseCursor(filter.Cursor, filter.SortBy)
+        if err != nil {
+            return entity.CoursePage{}, fmt.Errorf("PostgresRepo - ListCourses - decodeCourseCursor: %w", err)
+        }
+
+        if filter.SortBy == entity.CourseSortByID {
+            builder = builder.Where("c.course_id "+comparison+" ?", id)
+        } else {
+            builder = builder.Where("("+sortExpr+", c.course_id) "+comparison+" (?, ?)", value, id)
+        }
+    }
+
+    if filter.SortBy != entity.CourseSortByID {
+        builder = builder.OrderBy(sortExpr + " " + direction)
+    }
+
+    // One extra row tells whether there is a next page
+    sql, args, err := builder.
+        OrderBy("c.course_id " + direction).
+        Limit(uint64(filter.Limit) + 1).
+        ToSql()
+
+    if err != nil {
+        return entity.CoursePage{}, fmt.Errorf("PostgresRepo - ListCourses - r.Builder: %w", err)
+    }
+
+    rows, err := r.Pool.Query(ctx, sql, args...)
+    if err != nil {
+        return entity.CoursePage{}, fmt.Errorf("PostgresRepo - ListCourses - r.Pool.Query: %w", err)
+    }
+    defer rows.Close()
+
+    items := make([]entity.CourseListItem, 0, filter.Limit+1)
+    createdAts := make([]time.Time, 0, filter.Limit+1)
+
+    for rows.Next() {
+        e := entity.CourseListItem{}
+        var description *string
+        var createdAt, updatedAt time.Time
+
+        err = rows.Scan(&e.CourseID, &e.Name, &description, &e.SpecializationID, &e.Duration, &e.Price,
+            &e.DifficultyLevelID, &createdAt, &updatedAt, &e.AverageRating)
+
+        if err != nil {
+            return entity.CoursePage{}, fmt.Errorf("PostgresRepo - ListCourses - rows.Scan: %w", err)
+        }
+
+        if description != nil {
+            e.Description = *description
+        }
+        e.CreatedAt = createdAt.Format(time.RFC3339)
+        e.UpdatedAt = updatedAt.Format(time.RFC3339)
+
+        items = append(items, e)
+        createdAts = append(createdAts, createdAt)
+    }
+
+    if err = rows.Err(); err != nil {
+        return entity.CoursePage{}, fmt.Errorf("PostgresRepo - ListCourses - rows.Err: %w", err)
+    }
+
+    page := entity.CoursePage{Courses: items}
+
+    if len(items) > int(filter.Limit) {
+        page.Courses = items[:filter.Limit]
+        last := len(page.Courses) - 1
+
+        var value any
+        switch filter.SortBy {
+        case entity.CourseSortByPrice:
+            value = page.Courses[last].Price
+        case entity.CourseSortByRating:
+            value = page.Courses[last].AverageRating
+        case entity.CourseSortByCreatedAt:
+            value = createdAts[last]
+        }
+
+        page.NextCursor, err = encodeCourseCursor(filter.SortBy, value, page.Courses[last].CourseID)
+        if err != nil {
+            return entity.CoursePage{}, fmt.Errorf("PostgresRepo - ListCourses - encodeCourseCursor: %w", err)
+        }
+    }
+
+    return page, nil
+}
+
+func courseSortExpr(sortBy entity.CourseSortField) string {
+    switch sortBy {
+    case entity.CourseSortByPrice:
+        return "COALESCE(c.price, 0)"
+    case entity.CourseSortByRating:
+        return "COALESCE(rt.avg_rating, 0)"
+    case entity.CourseSortByCreatedAt:
+        return "c.created_at"
+    default:
+        return "c.course_id"
+    }
+}
+
+func encodeCourseCursor(sortBy entity.CourseSortField, value any, id int) (string, error) {
+    cursor := courseCursor{SortBy: sortBy, ID: id}
+
+    if value != nil {
+        raw, err := json.Marshal(value)
+        if err != nil {
+            return "", fmt.Errorf("json.Marshal: %w", err)
+        }
+        cursor.Value = raw
+    }
+
+    data, err := json.Marshal(cursor)
+    if err != nil {
+        return "", fmt.Errorf("json.Marshal: %w", err)
+    }
+
+    return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodeCourseCursor returns the typed sort value and course ID stored in the cursor.
+func decodeCourseCursor(encoded string, sortBy entity.CourseSortField) (any, int, error) {
+    data, err := base64.RawURLEncoding.DecodeString(encoded)
+    if err != nil {
+        return nil, 0, entity.ErrInvalidCursor
+    }
+
+    var cursor courseCursor
+    if err = json.Unmarshal(data, &cursor); err != nil || cursor.SortBy != sortBy {
+        return nil, 0, entity.ErrInvalidCursor
+    }
+
+    var value any
+    switch sortBy {
+    case entity.CourseSortByPrice:
+        var price int
+        err = json.Unmarshal(cursor.Value, &price)
+        value = price
+    case entity.CourseSortByRating:
+        var rating float64
+        err = json.Unmarshal(cursor.Value, &rating)
+        value = rating
+    case entity.CourseSortByCreatedAt:
+        var createdAt time.Time
+        err = json.Unmarshal(cursor.Value, &createdAt)
+        value = createdAt
+    }
+
+    if err != nil {
+        return nil, 0, entity.ErrInvalidCursor
+    }
+
+    return value, cursor.ID, nil
+}
@@ -0,0 +1,196 @@
+package persistent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/deadnotxaa/education-platform/backend/internal/entity"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+    _foreignKeyViolation = "23503"
+
+    _courseSpecializationFK  = "course_specialization_id_fkey"
+    _courseDifficultyLevelFK = "course_difficulty_level_id_fkey"
+)
+
+var _courseColumns = []string{
+    "course_id", "name", "description", "specialization_id", "duration", "price", "difficulty_level_id",
+    "created_at", "updated_at",
+}
+
+// GetCourseById -.
+func (r *PostgresRepo) GetCourseById(ctx context.Context, courseID int) (entity.Course, error) {
+    sql, args, err := r.Builder.
+        Select(_courseColumns...).
+        From("course").
+        Where("course_id = ? AND deleted_at IS NULL", courseID).
+        ToSql()
+
+    if err != nil {
+        return entity.Course{}, fmt.Errorf("PostgresRepo - GetCourse - r.Builder: %w", err)
+    }
+
+    ent, err := scanCourse(r.db(ctx).QueryRow(ctx, sql, args...))
+    if err != nil {
+        return entity.Course{}, fmt.Errorf("PostgresRepo - GetCourse - scanCourse: %w", err)
+    }
+
+    return ent, nil
+}
+
+// GetCoursesByIDs retrieves the courses that exist and aren't deleted, in no particular order.
+func (r *PostgresRepo) GetCoursesByIDs(ctx context.Context, courseIDs []int) ([]entity.Course, error) {
+    sql, args, err := r.Builder.
+        Select(_courseColumns...).
+        From("course").
+        Where(squirrel.Eq{"course_id": courseIDs}).
+        Where("deleted_at IS NULL").
+        ToSql()
+
+    if err != nil {
+        return nil, fmt.Errorf("PostgresRepo - GetCoursesByIDs - r.Builder: %w", err)
+    }
+
+    rows, err := r.db(ctx).Query(ctx, sql, args...)
+    if err != nil {
+        return nil, fmt.Errorf("PostgresRepo - GetCoursesByIDs - r.db.Query: %w", err)
+    }
+    defer rows.Close()
+
+    courses := make([]entity.Course, 0, len(courseIDs))
+
+    for rows.Next() {
+        e, err := scanCourse(rows)
+        if err != nil {
+            return nil, fmt.Errorf("PostgresRepo - GetCoursesByIDs - scanCourse: %w", err)
+        }
+
+        courses = append(courses, e)
+    }
+
+    if err = rows.Err(); err != nil {
+        return nil, fmt.Errorf("PostgresRepo - GetCoursesByIDs - rows.Err: %w", err)
+    }
+
+    return courses, nil
+}
+
+// CreateCourse inserts a new course, created_at and updated_at are filled by the database.
+func (r *PostgresRepo) CreateCourse(ctx context.Context, course entity.Course) (entity.Course, error) {
+    sql, args, err := r.Builder.
+        Insert("course").
+        Columns("name", "description", "specialization_id", "duration", "price", "difficulty_level_id").
+        Values(course.Name, course.Description, course.SpecializationID, course.Duration, course.Price,
+            course.DifficultyLevelID).
+        Suffix("RETURNING " + strings.Join(_courseColumns, ", ")).
+        ToSql()
+
+    if err != nil {
+        return entity.Course{}, fmt.Errorf("PostgresRepo - CreateCourse - r.Builder: %w", err)
+    }
+
+    ent, err := scanCourse(r.db(ctx).QueryRow(ctx, sql, args...))
+    if err != nil {
+        return entity.Course{}, fmt.Errorf("PostgresRepo - CreateCourse - scanCourse: %w", err)
+    }
+
+    return ent, nil
+}
+
+// UpdateCourse replaces all editable fields of a course, updated_at is maintained by a trigger.
+func (r *PostgresRepo) UpdateCourse(ctx context.Context, course entity.Course) (entity.Course, error) {
+    sql, args, err := r.Builder.
+        Update("course").
+        Set("name", course.Name).
+        Set("description", course.Description).
+        Set("specialization_id", course.SpecializationID).
+        Set("duration", course.Duration).
+        Set("price", course.Price).
+        Set("difficulty_level_id", course.DifficultyLevelID).
+        Where("course_id = ? AND deleted_at IS NULL", course.CourseID).
+        Suffix("RETURNING " + strings.Join(_courseColumns, ", ")).
+        ToSql()
+
+    if err != nil {
+        return entity.Course{}, fmt.Errorf("PostgresRepo - UpdateCourse - r.Builder: %w", err)
+    }
+
+    ent, err := scanCourse(r.db(ctx).QueryRow(ctx, sql, args...))
+    if err != nil {
+        return entity.Course{}, fmt.Errorf("PostgresRepo - UpdateCourse - scanCourse: %w", err)
+    }
+
+    return ent, nil
+}
+
+// DeleteCourse soft-deletes a course by setting deleted_at.
+func (r *PostgresRepo) DeleteCourse(ctx context.Context, courseID int) error {
+    sql, args, err := r.Builder.
+        Update("course").
+        Set("deleted_at", time.Now()).
+        Where("course_id = ? AND deleted_at IS NULL", courseID).
+        ToSql()
+
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - DeleteCourse - r.Builder: %w", err)
+    }
+
+    tag, err := r.db(ctx).Exec(ctx, sql, args...)
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - DeleteCourse - r.db.Exec: %w", err)
+    }
+
+    if tag.RowsAffected() == 0 {
+        return fmt.Errorf("PostgresRepo - DeleteCourse: %w", entity.ErrCourseNotFound)
+    }
+
+    return nil
+}
+
+func scanCourse(row pgx.Row) (entity.Course, error) {
+    ent := entity.Course{}
+    var description *string
+    var createdAt, updatedAt time.Time
+
+    err := row.Scan(&ent.CourseID, &ent.Name, &description, &ent.SpecializationID, &ent.Duration, &ent.Price,
+        &ent.DifficultyLevelID, &createdAt, &updatedAt)
+
+    if err != nil {
+        return entity.Course{}, courseError(err)
+    }
+
+    if description != nil {
+        ent.Description = *description
+    }
+
+    ent.CreatedAt = createdAt.Format(time.RFC3339)
+    ent.UpdatedAt = updatedAt.Format(time.RFC3339)
+
+    return ent, nil
+}
+
+// courseError translates driver errors into domain errors.
+func courseError(err error) error {
+    if errors.Is(err, pgx.ErrNoRows) {
+        return entity.ErrCourseNotFound
+    }
+
+    var pgErr *pgconn.PgError
+    if errors.As(err, &pgErr) && pgErr.Code == _foreignKeyViolation {
+        switch pgErr.ConstraintName {
+        case _courseSpecializationFK:
+            return entity.ErrSpecializationNotFound
+        case _courseDifficultyLevelFK:
+            return entity.ErrDifficultyLevelNotFound
+        }
+    }
+
+    return err
+}
@@ -0,0 +1,270 @@
+package persistent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/deadnotxaa/education-platform/backend/internal/entity"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+var (
+    _jobApplicationColumns = []string{"id", "student_id", "company_id", "application_date", "status"}
+
+    _jobApplicationTransitionColumns = []string{
+        "id", "application_id", "COALESCE(from_status, '')", "to_status", "COALESCE(actor_id, 0)",
+        "COALESCE(comment, '')", "changed_at",
+    }
+)
+
+// CreateJobApplication stores a new application submitted today.
+func (r *PostgresRepo) CreateJobApplication(ctx context.Context, application entity.JobApplication) (entity.JobApplication, error) {
+    sql, args, err := r.Builder.
+        Insert("job_application").
+        Columns("student_id", "company_id", "application_date", "status").
+        Values(application.StudentID, application.CompanyID, time.Now().Format(time.DateOnly),
+            string(entity.JobApplicationStatusSubmitted)).
+        Suffix("RETURNING " + strings.Join(_jobApplicationColumns, ", ")).
+        ToSql()
+
+    if err != nil {
+        return entity.JobApplication{}, fmt.Errorf("PostgresRepo - CreateJobApplication - r.Builder: %w", err)
+    }
+
+    ent, err := scanJobApplication(r.db(ctx).QueryRow(ctx, sql, args...))
+    if err != nil {
+        var pgErr *pgconn.PgError
+        if errors.As(err, &pgErr) && pgErr.Code == _uniqueViolation {
+            return entity.JobApplication{}, fmt.Errorf("PostgresRepo - CreateJobApplication: %w", entity.ErrJobApplicationExists)
+        }
+
+        return entity.JobApplication{}, fmt.Errorf("PostgresRepo - CreateJobApplication - scanJobApplication: %w", err)
+    }
+
+    return ent, nil
+}
+
+// GetJobApplication -.
+func (r *PostgresRepo) GetJobApplication(ctx context.Context, applicationID int) (entity.JobApplication, error) {
+    sql, args, err := r.Builder.
+        Select(_jobApplicationColumns...).
+        From("job_application").
+        Where("id = ?", applicationID).
+        ToSql()
+
+    if err != nil {
+        return entity.JobApplication{}, fmt.Errorf("PostgresRepo - GetJobApplication - r.Builder: %w", err)
+    }
+
+    ent, err := scanJobApplication(r.db(ctx).QueryRow(ctx, sql, args...))
+    if err != nil {
+        return entity.JobApplication{}, fmt.Errorf("PostgresRepo - GetJobApplication - scanJobApplication: %w", err)
+    }
+
+    return ent, nil
+}
+
+// GetJobApplicationForUpdate retrieves a job application and locks it until the end of the transaction.
+func (r *PostgresRepo) GetJobApplicationForUpdate(ctx context.Context, applicationID int) (entity.JobApplication, error) {
+    sql, args, err := r.Builder.
+        Select(_jobApplicationColumns...).
+        From("job_application").
+        Where("id = ?", applicationID).
+        Suffix("FOR UPDATE").
+        ToSql()
+
+    if err != nil {
+        return entity.JobApplication{}, fmt.Errorf("PostgresRepo - GetJobApplicationForUpdate - r.Builder: %w", err)
+    }
+
+    ent, err := scanJobApplication(r.db(ctx).QueryRow(ctx, sql, args...))
+    if err != nil {
+        return entity.JobApplication{}, fmt.Errorf("PostgresRepo - GetJobApplicationForUpdate - scanJobApplication: %w", err)
+    }
+
+    return ent, nil
+}
+
+// UpdateJobApplicationStatus -.
+func (r *PostgresRepo) UpdateJobApplicationStatus(ctx context.Context, applicationID int,
+    status entity.JobApplicationStatus) error {
+    sql, args, err := r.Builder.
+        Update("job_application").
+        Set("status", string(status)).
+        Where("id = ?", applicationID).
+        ToSql()
+
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - UpdateJobApplicationStatus - r.Builder: %w", err)
+    }
+
+    tag, err := r.db(ctx).Exec(ctx, sql, args...)
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - UpdateJobApplicationStatus - r.db.Exec: %w", err)
+    }
+
+    if tag.RowsAffected() == 0 {
+        return fmt.Errorf("PostgresRepo - UpdateJobApplicationStatus: %w", entity.ErrJobApplicationNotFound)
+    }
+
+    return nil
+}
+
+// AddJobApplicationTransition records a status change in the application history.
+func (r *PostgresRepo) AddJobApplicationTransition(ctx context.Context,
+    transition entity.JobApplicationTransition) (entity.JobApplicationTransition, error) {
+    var fromStatus *string
+    if transition.FromStatus != "" {
+        status := string(transition.FromStatus)
+        fromStatus = &status
+    }
+
+    var actorID *int
+    if transition.ActorID != 0 {
+        actorID = &transition.ActorID
+    }
+
+    sql, args, err := r.Builder.
+        Insert("job_application_status_history").
+        Columns("application_id", "from_status", "to_status", "actor_id", "comment", "changed_at").
+        Values(transition.ApplicationID, fromStatus, string(transition.ToStatus), actorID,
+            squirrel.Expr("NULLIF(?, '')", transition.Comment), time.Now()).
+        Suffix("RETURNING " + strings.Join(_jobApplicationTransitionColumns, ", ")).
+        ToSql()
+
+    if err != nil {
+        return entity.JobApplicationTransition{}, fmt.Errorf("PostgresRepo - AddJobApplicationTransition - r.Builder: %w", err)
+    }
+
+    ent, err := scanJobApplicationTransition(r.db(ctx).QueryRow(ctx, sql, args...))
+    if err != nil {
+        return entity.JobApplicationTransition{}, fmt.Errorf("PostgresRepo - AddJobApplicationTransition - scanJobApplicationTransition: %w", err)
+    }
+
+    return ent, nil
+}
+
+// ListJobApplicationHistory retrieves the status changes of an application in the order they happened.
+func (r *PostgresRepo) ListJobApplicationHistory(ctx context.Context, applicationID int) ([]entity.JobApplicationTransition, error) {
+    sql, args, err := r.Builder.
+        Select(_jobApplicationTransitionColumns...).
+        From("job_application_status_history").
+        Where("application_id = ?", applicationID).
+        OrderBy("id").
+        ToSql()
+
+    if err != nil {
+        return nil, fmt.Errorf("PostgresRepo - ListJobApplicationHistory - r.Builder: %w", err)
+    }
+
+    rows, err := r.Pool.Query(ctx, sql, args...)
+    if err != nil {
+        return nil, fmt.Errorf("PostgresRepo - ListJobApplicationHistory - r.Pool.Query: %w", err)
+    }
+    defer rows.Close()
+
+    transitions := make([]entity.JobApplicationTransition, 0)
+
+    for rows.Next() {
+        e, err := scanJobApplicationTransition(rows)
+        if err != nil {
+            return nil, fmt.Errorf("PostgresRepo - ListJobApplicationHistory - scanJobApplicationTransition: %w", err)
+        }
+
+        transitions = append(transitions, e)
+    }
+
+    if err = rows.Err(); err != nil {
+        return nil, fmt.Errorf("PostgresRepo - ListJobApplicationHistory - rows.Err: %w", err)
+    }
+
+    return transitions, nil
+}
+
+// ListUserJobApplications retrieves the applications the user sent from all career support enrollments.
+func (r *PostgresRepo) ListUserJobApplications(ctx context.Context, userID int) ([]entity.JobApplication, error) {
+    sql, args, err := r.Builder.
+        Select("ja.id", "ja.student_id", "ja.company_id", "ja.application_date", "ja.status").
+        From("job_application ja").
+        Join("career_center_student ccs ON ccs.id = ja.student_id").
+        Where("ccs.user_id = ?", userID).
+        OrderBy("ja.id DESC").
+        ToSql()
+
+    if err != nil {
+        return nil, fmt.Errorf("PostgresRepo - ListUserJobApplications - r.Builder: %w", err)
+    }
+
+    rows, err := r.Pool.Query(ctx, sql, args...)
+    if err != nil {
+        return nil, fmt.Errorf("PostgresRepo - ListUserJobApplications - r.Pool.Query: %w", err)
+    }
+    defer rows.Close()
+
+    applications := make([]entity.JobApplication, 0)
+
+    for rows.Next() {
+        e, err := scanJobApplication(rows)
+        if err != nil {
+            return nil, fmt.Errorf("PostgresRepo - ListUserJobApplications - scanJobApplication: %w", err)
+        }
+
+        applications = append(applications, e)
+    }
+
+    if err = rows.Err(); err != nil {
+        return nil, fmt.Errorf("PostgresRepo - ListUserJobApplications - rows.Err: %w", err)
+    }
+
+    return applications, nil
+}
+
+func scanJobApplication(row pgx.Row) (entity.JobApplication, error) {
+    ent := entity.JobApplication{}
+    var studentID, companyID *int
+    var applicationDate *time.Time
+    var status string
+
+    err := row.Scan(&ent.ID, &studentID, &companyID, &applicationDate, &status)
+    if err != nil {
+        if errors.Is(err, pgx.ErrNoRows) {
+            return entity.JobApplication{}, entity.ErrJobApplicationNotFound
+        }
+
+        return entity.JobApplication{}, err
+    }
+
+    if studentID != nil {
+        ent.StudentID = *studentID
+    }
+    if companyID != nil {
+        ent.CompanyID = *companyID
+    }
+
+    ent.ApplicationDate = formatDate(applicationDate)
+    ent.Status = entity.JobApplicationStatus(status)
+
+    return ent, nil
+}
+
+func scanJobApplicationTransition(row pgx.Row) (entity.JobApplicationTransition, error) {
+    ent := entity.JobApplicationTransition{}
+    var fromStatus, toStatus string
+    var changedAt time.Time
+
+    err := row.Scan(&ent.ID, &ent.ApplicationID, &fromStatus, &toStatus, &ent.ActorID, &ent.Comment, &changedAt)
+    if err != nil {
+        return entity.JobApplicationTransition{}, err
+    }
+
+    ent.FromStatus = entity.JobApplicationStatus(fromStatus)
+    ent.ToStatus = entity.JobApplicationStatus(toStatus)
+    ent.ChangedAt = changedAt.Format(time.RFC3339)
+
+    return ent, nil
+}
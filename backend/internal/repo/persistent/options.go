@@ -0,0 +1,22 @@
+package persistent
+
+import "time"
+
+const (
+    _defaultReportTTL      = 5 * time.Minute
+    _defaultReportStaleTTL = 10 * time.Minute
+    _defaultReportLockTTL  = 30 * time.Second
+)
+
+// Option -.
+type Option func(*PostgresRepo)
+
+// ReportCacheTTL sets how long a cached report is fresh, how long it may be served stale after that
+// and how long one instance may hold the lock while rebuilding it.
+func ReportCacheTTL(fresh, stale, lock time.Duration) Option {
+    return func(r *PostgresRepo) {
+        r.reportTTL = fresh
+        r.reportStaleTTL = stale
+        r.reportLockTTL = lock
+    }
+}
@@ -0,0 +1,143 @@
+package persistent
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/deadnotxaa/education-platform/backend/internal/entity"
+)
+
+// _claimOutboxEventsSQL leases due events to one relay, SKIP LOCKED lets several instances relay at once.
+// The lease ends at next_attempt_at, an event of a relay that died is claimed again after it.
+const _claimOutboxEventsSQL = `
+UPDATE outbox_event
+SET attempts = attempts + 1, next_attempt_at = $2
+WHERE event_id IN (
+    SELECT event_id
+    FROM outbox_event
+    WHERE published_at IS NULL AND failed_at IS NULL AND next_attempt_at <= $1
+    ORDER BY event_id
+    LIMIT $3
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING event_id, event_type, event_version, aggregate_id, payload, created_at, attempts`
+
+// AddOutboxEvent stores an event, called within the transaction of the change the event describes.
+func (r *PostgresRepo) AddOutboxEvent(ctx context.Context, event entity.Event) error {
+    sql, args, err := r.Builder.
+        Insert("outbox_event").
+        Columns("event_type", "event_version", "aggregate_id", "payload").
+        Values(string(event.Type), event.Version, event.AggregateID, []byte(event.Payload)).
+        ToSql()
+
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - AddOutboxEvent - r.Builder: %w", err)
+    }
+
+    if _, err = r.db(ctx).Exec(ctx, sql, args...); err != nil {
+        return fmt.Errorf("PostgresRepo - AddOutboxEvent - r.db.Exec: %w", err)
+    }
+
+    return nil
+}
+
+// ClaimOutboxEvents leases up to limit events due at now until leaseUntil and counts the attempt, oldest first.
+func (r *PostgresRepo) ClaimOutboxEvents(ctx context.Context, now, leaseUntil time.Time, limit uint32) ([]entity.Event, error) {
+    rows, err := r.db(ctx).Query(ctx, _claimOutboxEventsSQL, now, leaseUntil, limit)
+    if err != nil {
+        return nil, fmt.Errorf("PostgresRepo - ClaimOutboxEvents - r.db.Query: %w", err)
+    }
+    defer rows.Close()
+
+    events := make([]entity.Event, 0)
+
+    for rows.Next() {
+        e := entity.Event{}
+        var eventType string
+        var payload []byte
+        var createdAt time.Time
+
+        err = rows.Scan(&e.ID, &eventType, &e.Version, &e.AggregateID, &payload, &createdAt, &e.Attempts)
+        if err != nil {
+            return nil, fmt.Errorf("PostgresRepo - ClaimOutboxEvents - rows.Scan: %w", err)
+        }
+
+        e.Type = entity.EventType(eventType)
+        e.Payload = payload
+        e.OccurredAt = createdAt.Format(time.RFC3339)
+
+        events = append(events, e)
+    }
+
+    if err = rows.Err(); err != nil {
+        return nil, fmt.Errorf("PostgresRepo - ClaimOutboxEvents - rows.Err: %w", err)
+    }
+
+    // RETURNING doesn't keep the order of the subquery
+    slices.SortFunc(events, func(a, b entity.Event) int { return cmp.Compare(a.ID, b.ID) })
+
+    return events, nil
+}
+
+// MarkOutboxEventPublished -.
+func (r *PostgresRepo) MarkOutboxEventPublished(ctx context.Context, eventID int64) error {
+    sql, args, err := r.Builder.
+        Update("outbox_event").
+        Set("published_at", time.Now()).
+        Set("last_error", nil).
+        Where("event_id = ?", eventID).
+        ToSql()
+
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - MarkOutboxEventPublished - r.Builder: %w", err)
+    }
+
+    if _, err = r.db(ctx).Exec(ctx, sql, args...); err != nil {
+        return fmt.Errorf("PostgresRepo - MarkOutboxEventPublished - r.db.Exec: %w", err)
+    }
+
+    return nil
+}
+
+// RetryOutboxEvent schedules the next attempt of an event that failed to publish.
+func (r *PostgresRepo) RetryOutboxEvent(ctx context.Context, eventID int64, nextAttemptAt time.Time, lastError string) error {
+    sql, args, err := r.Builder.
+        Update("outbox_event").
+        Set("next_attempt_at", nextAttemptAt).
+        Set("last_error", lastError).
+        Where("event_id = ?", eventID).
+        ToSql()
+
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - RetryOutboxEvent - r.Builder: %w", err)
+    }
+
+    if _, err = r.db(ctx).Exec(ctx, sql, args...); err != nil {
+        return fmt.Errorf("PostgresRepo - RetryOutboxEvent - r.db.Exec: %w", err)
+    }
+
+    return nil
+}
+
+// FailOutboxEvent stops retrying an event, it stays in the outbox with the last error for inspection.
+func (r *PostgresRepo) FailOutboxEvent(ctx context.Context, eventID int64, lastError string) error {
+    sql, args, err := r.Builder.
+        Update("outbox_event").
+        Set("failed_at", time.Now()).
+        Set("last_error", lastError).
+        Where("event_id = ?", eventID).
+        ToSql()
+
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - FailOutboxEvent - r.Builder: %w", err)
+    }
+
+    if _, err = r.db(ctx).Exec(ctx, sql, args...); err != nil {
+        return fmt.Errorf("PostgresRepo - FailOutboxEvent - r.db.Exec: %w", err)
+    }
+
+    return nil
+}
@@ -0,0 +1,256 @@
+package persistent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/deadnotxaa/education-platform/backend/internal/entity"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+var _partnerCompanyColumns = []string{
+    "company_id",
+    "COALESCE(short_name, '')",
+    "COALESCE(full_name, '')",
+    "COALESCE(hired_graduates_count, 0)",
+    "COALESCE(requirements, '')",
+    "COALESCE(agreement_status, FALSE)",
+}
+
+// GetPartnerCompany -.
+func (r *PostgresRepo) GetPartnerCompany(ctx context.Context, companyID int) (entity.PartnerCompany, error) {
+    sql, args, err := r.Builder.
+        Select(_partnerCompanyColumns...).
+        From("partner_company").
+        Where("company_id = ?", companyID).
+        ToSql()
+
+    if err != nil {
+        return entity.PartnerCompany{}, fmt.Errorf("PostgresRepo - GetPartnerCompany - r.Builder: %w", err)
+    }
+
+    ent, err := scanPartnerCompany(r.db(ctx).QueryRow(ctx, sql, args...))
+    if err != nil {
+        return entity.PartnerCompany{}, fmt.Errorf("PostgresRepo - GetPartnerCompany - scanPartnerCompany: %w", err)
+    }
+
+    return ent, nil
+}
+
+// ListPartnerCompanies retrieves a page of partner companies ordered by ID.
+func (r *PostgresRepo) ListPartnerCompanies(ctx context.Context, afterID int, limit uint32) (entity.PartnerCompanyPage, error) {
+    // One extra row tells whether there is a next page
+    sql, args, err := r.Builder.
+        Select(_partnerCompanyColumns...).
+        From("partner_company").
+        Where(squirrel.Gt{"company_id": afterID}).
+        OrderBy("company_id").
+        Limit(uint64(limit) + 1).
+        ToSql()
+
+    if err != nil {
+        return entity.PartnerCompanyPage{}, fmt.Errorf("PostgresRepo - ListPartnerCompanies - r.Builder: %w", err)
+    }
+
+    rows, err := r.Pool.Query(ctx, sql, args...)
+    if err != nil {
+        return entity.PartnerCompanyPage{}, fmt.Errorf("PostgresRepo - ListPartnerCompanies - r.Pool.Query: %w", err)
+    }
+    defer rows.Close()
+
+    companies := make([]entity.PartnerCompany, 0, limit+1)
+
+    for rows.Next() {
+        e, err := scanPartnerCompany(rows)
+        if err != nil {
+            return entity.PartnerCompanyPage{}, fmt.Errorf("PostgresRepo - ListPartnerCompanies - scanPartnerCompany: %w", err)
+        }
+
+        companies = append(companies, e)
+    }
+
+    if err = rows.Err(); err != nil {
+        return entity.PartnerCompanyPage{}, fmt.Errorf("PostgresRepo - ListPartnerCompanies - rows.Err: %w", err)
+    }
+
+    page := entity.PartnerCompanyPage{Companies: companies}
+
+    if len(companies) > int(limit) {
+        page.Companies = companies[:limit]
+        page.NextID = page.Companies[limit-1].CompanyID
+    }
+
+    return page, nil
+}
+
+// CreatePartnerCompany -.
+func (r *PostgresRepo) CreatePartnerCompany(ctx context.Context, company entity.PartnerCompany) (entity.PartnerCompany, error) {
+    sql, args, err := r.Builder.
+        Insert("partner_company").
+        Columns("short_name", "full_name", "hired_graduates_count", "requirements", "agreement_status").
+        Values(company.ShortName, company.FullName, 0, company.Requirements, company.AgreementStatus).
+        Suffix("RETURNING " + strings.Join(_partnerCompanyColumns, ", ")).
+        ToSql()
+
+    if err != nil {
+        return entity.PartnerCompany{}, fmt.Errorf("PostgresRepo - CreatePartnerCompany - r.Builder: %w", err)
+    }
+
+    ent, err := scanPartnerCompany(r.db(ctx).QueryRow(ctx, sql, args...))
+    if err != nil {
+        return entity.PartnerCompany{}, fmt.Errorf("PostgresRepo - CreatePartnerCompany - scanPartnerCompany: %w", err)
+    }
+
+    return ent, nil
+}
+
+// UpdatePartnerCompany replaces the editable fields of a company, the hired graduates count is kept.
+func (r *PostgresRepo) UpdatePartnerCompany(ctx context.Context, company entity.PartnerCompany) (entity.PartnerCompany, error) {
+    sql, args, err := r.Builder.
+        Update("partner_company").
+        Set("short_name", company.ShortName).
+        Set("full_name", company.FullName).
+        Set("requirements", company.Requirements).
+        Set("agreement_status", company.AgreementStatus).
+        Where("company_id = ?", company.CompanyID).
+        Suffix("RETURNING " + strings.Join(_partnerCompanyColumns, ", ")).
+        ToSql()
+
+    if err != nil {
+        return entity.PartnerCompany{}, fmt.Errorf("PostgresRepo - UpdatePartnerCompany - r.Builder: %w", err)
+    }
+
+    ent, err := scanPartnerCompany(r.db(ctx).QueryRow(ctx, sql, args...))
+    if err != nil {
+        return entity.PartnerCompany{}, fmt.Errorf("PostgresRepo - UpdatePartnerCompany - scanPartnerCompany: %w", err)
+    }
+
+    return ent, nil
+}
+
+// DeletePartnerCompany deletes a company without job applications.
+func (r *PostgresRepo) DeletePartnerCompany(ctx context.Context, companyID int) error {
+    sql, args, err := r.Builder.
+        Delete("partner_company").
+        Where("company_id = ?", companyID).
+        ToSql()
+
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - DeletePartnerCompany - r.Builder: %w", err)
+    }
+
+    tag, err := r.db(ctx).Exec(ctx, sql, args...)
+    if err != nil {
+        var pgErr *pgconn.PgError
+        if errors.As(err, &pgErr) && pgErr.Code == _foreignKeyViolation {
+            return fmt.Errorf("PostgresRepo - DeletePartnerCompany: %w", entity.ErrPartnerCompanyInUse)
+        }
+
+        return fmt.Errorf("PostgresRepo - DeletePartnerCompany - r.db.Exec: %w", err)
+    }
+
+    if tag.RowsAffected() == 0 {
+        return fmt.Errorf("PostgresRepo - DeletePartnerCompany: %w", entity.ErrPartnerCompanyNotFound)
+    }
+
+    return nil
+}
+
+// IncrementHiredGraduates counts one more graduate hired by the company.
+func (r *PostgresRepo) IncrementHiredGraduates(ctx context.Context, companyID int) error {
+    sql, args, err := r.Builder.
+        Update("partner_company").
+        Set("hired_graduates_count", squirrel.Expr("COALESCE(hired_graduates_count, 0) + 1")).
+        Where("company_id = ?", companyID).
+        ToSql()
+
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - IncrementHiredGraduates - r.Builder: %w", err)
+    }
+
+    tag, err := r.db(ctx).Exec(ctx, sql, args...)
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - IncrementHiredGraduates - r.db.Exec: %w", err)
+    }
+
+    if tag.RowsAffected() == 0 {
+        return fmt.Errorf("PostgresRepo - IncrementHiredGraduates: %w", entity.ErrPartnerCompanyNotFound)
+    }
+
+    return nil
+}
+
+// ListCareerStudentSkills retrieves every student whose career support is active on the date
+// with the technologies of the course topics the student has completed.
+func (r *PostgresRepo) ListCareerStudentSkills(ctx context.Context, on time.Time) ([]entity.CareerStudentSkills, error) {
+    rows, err := r.Pool.Query(ctx,
+        `SELECT
+            ccs.id,
+            ccs.user_id,
+            ccs.course_id,
+            COALESCE(ccs.cv_url, ''),
+            ccs.career_support_start,
+            COALESCE(ccs.support_period, 0),
+            ccs.career_support_start + ccs.support_period,
+            COUNT(ct.id),
+            COALESCE(ARRAY_AGG(ct.technologies) FILTER (WHERE ct.technologies IS NOT NULL), '{}')
+        FROM career_center_student ccs
+        LEFT JOIN topic_progress tp
+            ON tp.user_id = ccs.user_id AND tp.course_id = ccs.course_id AND tp.status = 'Completed'
+        LEFT JOIN course_topic ct ON ct.id = tp.topic_id
+        WHERE ccs.career_support_start + ccs.support_period >= $1::date
+        GROUP BY ccs.id
+        ORDER BY ccs.id;`,
+        on.Format(time.DateOnly),
+    )
+
+    if err != nil {
+        return nil, fmt.Errorf("PostgresRepo - ListCareerStudentSkills - r.Pool.Query: %w", err)
+    }
+    defer rows.Close()
+
+    students := make([]entity.CareerStudentSkills, 0)
+
+    for rows.Next() {
+        e := entity.CareerStudentSkills{}
+        var supportStart, supportEnd *time.Time
+
+        err = rows.Scan(&e.Student.ID, &e.Student.UserID, &e.Student.CourseID, &e.Student.CVUrl, &supportStart,
+            &e.Student.SupportPeriod, &supportEnd, &e.CompletedTopics, &e.Technologies)
+        if err != nil {
+            return nil, fmt.Errorf("PostgresRepo - ListCareerStudentSkills - rows.Scan: %w", err)
+        }
+
+        e.Student.CareerSupportStart = formatDate(supportStart)
+        e.Student.SupportEndDate = formatDate(supportEnd)
+
+        students = append(students, e)
+    }
+
+    if err = rows.Err(); err != nil {
+        return nil, fmt.Errorf("PostgresRepo - ListCareerStudentSkills - rows.Err: %w", err)
+    }
+
+    return students, nil
+}
+
+func scanPartnerCompany(row pgx.Row) (entity.PartnerCompany, error) {
+    ent := entity.PartnerCompany{}
+
+    err := row.Scan(&ent.CompanyID, &ent.ShortName, &ent.FullName, &ent.HiredGraduatesCount, &ent.Requirements,
+        &ent.AgreementStatus)
+    if err != nil {
+        if errors.Is(err, pgx.ErrNoRows) {
+            return entity.PartnerCompany{}, entity.ErrPartnerCompanyNotFound
+        }
+
+        return entity.PartnerCompany{}, err
+    }
+
+    return ent, nil
+}
@@ -0,0 +1,75 @@
+package persistent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deadnotxaa/education-platform/backend/internal/entity"
+)
+
+// SetPurchasePaymentIntent links a purchase to the provider's payment intent.
+func (r *PostgresRepo) SetPurchasePaymentIntent(ctx context.Context, purchaseID int, intentID string) error {
+    sql, args, err := r.Builder.
+        Update("purchase").
+        Set("payment_intent_id", intentID).
+        Where("purchase_id = ?", purchaseID).
+        ToSql()
+
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - SetPurchasePaymentIntent - r.Builder: %w", err)
+    }
+
+    tag, err := r.db(ctx).Exec(ctx, sql, args...)
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - SetPurchasePaymentIntent - r.db.Exec: %w", err)
+    }
+
+    if tag.RowsAffected() == 0 {
+        return fmt.Errorf("PostgresRepo - SetPurchasePaymentIntent: %w", entity.ErrPurchaseNotFound)
+    }
+
+    return nil
+}
+
+// GetPurchaseByPaymentIntentForUpdate retrieves a purchase paid by the intent and locks it.
+func (r *PostgresRepo) GetPurchaseByPaymentIntentForUpdate(ctx context.Context, intentID string) (entity.Purchase, error) {
+    sql, args, err := r.Builder.
+        Select(_purchaseColumns...).
+        From("purchase").
+        Where("payment_intent_id = ?", intentID).
+        Suffix("FOR UPDATE").
+        ToSql()
+
+    if err != nil {
+        return entity.Purchase{}, fmt.Errorf("PostgresRepo - GetPurchaseByPaymentIntentForUpdate - r.Builder: %w", err)
+    }
+
+    ent, err := scanPurchase(r.db(ctx).QueryRow(ctx, sql, args...))
+    if err != nil {
+        return entity.Purchase{}, fmt.Errorf("PostgresRepo - GetPurchaseByPaymentIntentForUpdate - scanPurchase: %w", err)
+    }
+
+    return ent, nil
+}
+
+// MarkPaymentEventProcessed records a webhook event, false means it has already been processed.
+func (r *PostgresRepo) MarkPaymentEventProcessed(ctx context.Context, event entity.PaymentEvent) (bool, error) {
+    sql, args, err := r.Builder.
+        Insert("processed_payment_event").
+        Columns("event_id", "event_type", "payment_intent_id").
+        Values(event.ID, string(event.Type), event.IntentID).
+        Suffix("ON CONFLICT (event_id) DO NOTHING").
+        ToSql()
+
+    if err != nil {
+        return false, fmt.Errorf("PostgresRepo - MarkPaymentEventProcessed - r.Builder: %w", err)
+    }
+
+    tag, err := r.db(ctx).Exec(ctx, sql, args...)
+    if err != nil {
+        return false, fmt.Errorf("PostgresRepo - MarkPaymentEventProcessed - r.db.Exec: %w", err)
+    }
+
+    return tag.RowsAffected() == 1, nil
+}
+
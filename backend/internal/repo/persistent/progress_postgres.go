@@ -0,0 +1,466 @@
+package persistent
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/deadnotxaa/education-platform/backend/internal/entity"
+	"github.com/jackc/pgx/v5"
+)
+
+// submissionCursor - position of the last returned submission, encoded into an opaque string.
+type submissionCursor struct {
+    SubmittedAt time.Time `json:"t"`
+    ID          int       `json:"id"`
+}
+
+var _submissionColumns = []string{
+    "submission_id", "user_id", "course_id", "project_id", "status", "solution_url", "submitted_at",
+    "COALESCE(reviewer_id, 0)", "grade", "COALESCE(review_comment, '')", "reviewed_at",
+}
+
+// CourseHasTopic checks whether the topic is part of the course.
+func (r *PostgresRepo) CourseHasTopic(ctx context.Context, courseID, topicID int) (bool, error) {
+    sql, args, err := r.Builder.
+        Select("1").
+        From("course_topic_association").
+        Where(squirrel.Eq{"course_id": courseID, "topic_id": topicID}).
+        Prefix("SELECT EXISTS (").
+        Suffix(")").
+        ToSql()
+
+    if err != nil {
+        return false, fmt.Errorf("PostgresRepo - CourseHasTopic - r.Builder: %w", err)
+    }
+
+    var exists bool
+    if err = r.db(ctx).QueryRow(ctx, sql, args...).Scan(&exists); err != nil {
+        return false, fmt.Errorf("PostgresRepo - CourseHasTopic - row.Scan: %w", err)
+    }
+
+    return exists, nil
+}
+
+// CourseHasProject checks whether the project belongs to one of the topics of the course.
+func (r *PostgresRepo) CourseHasProject(ctx context.Context, courseID, projectID int) (bool, error) {
+    sql, args, err := r.Builder.
+        Select("1").
+        From("project p").
+        Join("course_topic_association cta ON cta.topic_id = p.topic_id").
+        Where(squirrel.Eq{"cta.course_id": courseID, "p.project_id": projectID}).
+        Prefix("SELECT EXISTS (").
+        Suffix(")").
+        ToSql()
+
+    if err != nil {
+        return false, fmt.Errorf("PostgresRepo - CourseHasProject - r.Builder: %w", err)
+    }
+
+    var exists bool
+    if err = r.db(ctx).QueryRow(ctx, sql, args...).Scan(&exists); err != nil {
+        return false, fmt.Errorf("PostgresRepo - CourseHasProject - row.Scan: %w", err)
+    }
+
+    return exists, nil
+}
+
+// SetTopicStatus records the progress of a student through a topic. The completion time is kept
+// when a completed topic is marked completed again and cleared when it is reopened.
+func (r *PostgresRepo) SetTopicStatus(ctx context.Context, userID, courseID, topicID int,
+    status entity.TopicStatus) error {
+    now := time.Now()
+
+    var completedAt *time.Time
+    if status == entity.TopicStatusCompleted {
+        completedAt = &now
+    }
+
+    sql, args, err := r.Builder.
+        Insert("topic_progress").
+        Columns("user_id", "course_id", "topic_id", "status", "started_at", "completed_at").
+        Values(userID, courseID, topicID, string(status), now, completedAt).
+        Suffix(`ON CONFLICT (user_id, course_id, topic_id) DO UPDATE SET
+            status = EXCLUDED.status,
+            completed_at = CASE
+                WHEN EXCLUDED.status = 'Completed' THEN COALESCE(topic_progress.completed_at, EXCLUDED.completed_at)
+            END`).
+        ToSql()
+
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - SetTopicStatus - r.Builder: %w", err)
+    }
+
+    if _, err = r.db(ctx).Exec(ctx, sql, args...); err != nil {
+        return fmt.Errorf("PostgresRepo - SetTopicStatus - r.db.Exec: %w", err)
+    }
+
+    return nil
+}
+
+// SubmitProject stores a new solution of a project, replacing a previous one that isn't accepted yet.
+// The previous grade is discarded and the submission goes back to the review queue.
+func (r *PostgresRepo) SubmitProject(ctx context.Context, submission entity.ProjectSubmission) (entity.ProjectSubmission, error) {
+    sql, args, err := r.Builder.
+        Insert("project_submission").
+        Columns("user_id", "course_id", "project_id", "status", "solution_url", "submitted_at").
+        Values(submission.UserID, submission.CourseID, submission.ProjectID,
+            string(entity.SubmissionStatusSubmitted), submission.SolutionURL, time.Now()).
+        Suffix(`ON CONFLICT (user_id, course_id, project_id) DO UPDATE SET
+            status = EXCLUDED.status,
+            solution_url = EXCLUDED.solution_url,
+            submitted_at = EXCLUDED.submitted_at,
+            reviewer_id = NULL,
+            grade = NULL,
+            review_comment = NULL,
+            reviewed_at = NULL
+        WHERE project_submission.status <> 'Accepted'
+        RETURNING ` + strings.Join(_submissionColumns, ", ")).
+        ToSql()
+
+    if err != nil {
+        return entity.ProjectSubmission{}, fmt.Errorf("PostgresRepo - SubmitProject - r.Builder: %w", err)
+    }
+
+    ent, err := scanSubmission(r.db(ctx).QueryRow(ctx, sql, args...))
+    if err != nil {
+        // The conflicting row is left untouched and not returned only when it is accepted
+        if errors.Is(err, entity.ErrSubmissionNotFound) {
+            return entity.ProjectSubmission{}, fmt.Errorf("PostgresRepo - SubmitProject: %w", entity.ErrProjectAccepted)
+        }
+
+        return entity.ProjectSubmission{}, fmt.Errorf("PostgresRepo - SubmitProject - scanSubmission: %w", err)
+    }
+
+    return ent, nil
+}
+
+// GetSubmissionForUpdate retrieves a project submission and locks its row until the end of the transaction.
+func (r *PostgresRepo) GetSubmissionForUpdate(ctx context.Context, submissionID int) (entity.ProjectSubmission, error) {
+    sql, args, err := r.Builder.
+        Select(_submissionColumns...).
+        From("project_submission").
+        Where("submission_id = ?", submissionID).
+        Suffix("FOR UPDATE").
+        ToSql()
+
+    if err != nil {
+        return entity.ProjectSubmission{}, fmt.Errorf("PostgresRepo - GetSubmissionForUpdate - r.Builder: %w", err)
+    }
+
+    ent, err := scanSubmission(r.db(ctx).QueryRow(ctx, sql, args...))
+    if err != nil {
+        return entity.ProjectSubmission{}, fmt.Errorf("PostgresRepo - GetSubmissionForUpdate - scanSubmission: %w", err)
+    }
+
+    return ent, nil
+}
+
+// GradeSubmission records the decision of a reviewer.
+func (r *PostgresRepo) GradeSubmission(ctx context.Context, submission entity.ProjectSubmission) (entity.ProjectSubmission, error) {
+    sql, args, err := r.Builder.
+        Update("project_submission").
+        Set("status", string(submission.Status)).
+        Set("reviewer_id", submission.ReviewerID).
+        Set("grade", submission.Grade).
+        Set("review_comment", submission.ReviewComment).
+        Set("reviewed_at", time.Now()).
+        Where("submission_id = ?", submission.SubmissionID).
+        Suffix("RETURNING " + strings.Join(_submissionColumns, ", ")).
+        ToSql()
+
+    if err != nil {
+        return entity.ProjectSubmission{}, fmt.Errorf("PostgresRepo - GradeSubmission - r.Builder: %w", err)
+    }
+
+    ent, err := scanSubmission(r.db(ctx).QueryRow(ctx, sql, args...))
+    if err != nil {
+        return entity.ProjectSubmission{}, fmt.Errorf("PostgresRepo - GradeSubmission - scanSubmission: %w", err)
+    }
+
+    return ent, nil
+}
+
+// ListPendingSubmissions returns the review queue oldest first, paginated by submission time and ID.
+// A resubmitted project keeps its ID, so it is ordered by the time of the new solution.
+func (r *PostgresRepo) ListPendingSubmissions(ctx context.Context, cursor string, limit uint32) (entity.SubmissionPage, error) {
+    // One extra row tells whether there is a next page
+    builder := r.Builder.
+        Select(_submissionColumns...).
+        From("project_submission").
+        Where(squirrel.Eq{"status": string(entity.SubmissionStatusSubmitted)}).
+        OrderBy("submitted_at", "submission_id").
+        Limit(uint64(limit) + 1)
+
+    if cursor != "" {
+        after, err := decodeSubmissionCursor(cursor)
+        if err != nil {
+            return entity.SubmissionPage{}, fmt.Errorf("PostgresRepo - ListPendingSubmissions - decodeSubmissionCursor: %w", err)
+        }
+
+        builder = builder.Where("(submitted_at, submission_id) > (?, ?)", after.SubmittedAt, after.ID)
+    }
+
+    sql, args, err := builder.ToSql()
+    if err != nil {
+        return entity.SubmissionPage{}, fmt.Errorf("PostgresRepo - ListPendingSubmissions - r.Builder: %w", err)
+    }
+
+    rows, err := r.Pool.Query(ctx, sql, args...)
+    if err != nil {
+        return entity.SubmissionPage{}, fmt.Errorf("PostgresRepo - ListPendingSubmissions - r.Pool.Query: %w", err)
+    }
+    defer rows.Close()
+
+    submissions := make([]entity.ProjectSubmission, 0, limit+1)
+    var last submissionCursor
+
+    for rows.Next() {
+        e, submittedAt, err := scanSubmissionAt(rows)
+        if err != nil {
+            return entity.SubmissionPage{}, fmt.Errorf("PostgresRepo - ListPendingSubmissions - scanSubmissionAt: %w", err)
+        }
+
+        // The cursor keeps the exact time, the entity has it rounded to seconds
+        if len(submissions) < int(limit) {
+            last = submissionCursor{SubmittedAt: submittedAt, ID: e.SubmissionID}
+        }
+
+        submissions = append(submissions, e)
+    }
+
+    if err = rows.Err(); err != nil {
+        return entity.SubmissionPage{}, fmt.Errorf("PostgresRepo - ListPendingSubmissions - rows.Err: %w", err)
+    }
+
+    page := entity.SubmissionPage{Submissions: submissions}
+
+    if len(submissions) > int(limit) {
+        page.Submissions = submissions[:limit]
+
+        page.NextCursor, err = encodeSubmissionCursor(last)
+        if err != nil {
+            return entity.SubmissionPage{}, fmt.Errorf("PostgresRepo - ListPendingSubmissions - encodeSubmissionCursor: %w", err)
+        }
+    }
+
+    return page, nil
+}
+
+// _progressSQL - topics and projects of the courses the user has paid for with the user's progress on them,
+// one row per project. $3 limits it to one course, 0 selects every course.
+const _progressSQL = `
+SELECT
+    c.course_id,
+    c.name,
+    ct.id,
+    ct.name,
+    cta.position,
+    tp.status,
+    tp.started_at,
+    tp.completed_at,
+    p.project_id,
+    p.name,
+    ps.submission_id,
+    ps.status,
+    ps.submitted_at,
+    ps.grade,
+    ps.review_comment,
+    ps.reviewed_at
+FROM (
+    SELECT DISTINCT course_id
+    FROM purchase
+    WHERE user_id = $1 AND purchase_status = $2 AND ($3::int = 0 OR course_id = $3)
+) pc
+JOIN course c ON c.course_id = pc.course_id AND c.deleted_at IS NULL
+LEFT JOIN course_topic_association cta ON cta.course_id = c.course_id
+LEFT JOIN course_topic ct ON ct.id = cta.topic_id
+LEFT JOIN topic_progress tp ON tp.user_id = $1 AND tp.course_id = c.course_id AND tp.topic_id = ct.id
+LEFT JOIN project p ON p.topic_id = ct.id
+LEFT JOIN project_submission ps
+    ON ps.user_id = $1 AND ps.course_id = c.course_id AND ps.project_id = p.project_id
+ORDER BY c.course_id, cta.position, p.project_id`
+
+// GetUserProgress retrieves the topics and projects of every course the user has paid for,
+// with the user's progress on each of them. Completion numbers are left for the caller to compute.
+func (r *PostgresRepo) GetUserProgress(ctx context.Context, userID int) ([]entity.CourseProgress, error) {
+    rows, err := r.db(ctx).Query(ctx, _progressSQL, userID, entity.PurchaseStatusCompleted.String(), 0)
+    if err != nil {
+        return nil, fmt.Errorf("PostgresRepo - GetUserProgress - r.db.Query: %w", err)
+    }
+    defer rows.Close()
+
+    courses, err := scanProgress(rows)
+    if err != nil {
+        return nil, fmt.Errorf("PostgresRepo - GetUserProgress - scanProgress: %w", err)
+    }
+
+    return courses, nil
+}
+
+// GetUserCourseProgress retrieves the progress of the user through one paid course,
+// reading the changes of the current transaction.
+func (r *PostgresRepo) GetUserCourseProgress(ctx context.Context, userID, courseID int) (entity.CourseProgress, error) {
+    rows, err := r.db(ctx).Query(ctx, _progressSQL, userID, entity.PurchaseStatusCompleted.String(), courseID)
+    if err != nil {
+        return entity.CourseProgress{}, fmt.Errorf("PostgresRepo - GetUserCourseProgress - r.db.Query: %w", err)
+    }
+    defer rows.Close()
+
+    courses, err := scanProgress(rows)
+    if err != nil {
+        return entity.CourseProgress{}, fmt.Errorf("PostgresRepo - GetUserCourseProgress - scanProgress: %w", err)
+    }
+
+    if len(courses) == 0 {
+        return entity.CourseProgress{}, fmt.Errorf("PostgresRepo - GetUserCourseProgress: %w", entity.ErrCourseNotPurchased)
+    }
+
+    return courses[0], nil
+}
+
+// scanProgress groups the rows of _progressSQL by course and topic.
+func scanProgress(rows pgx.Rows) ([]entity.CourseProgress, error) {
+    courses := make([]entity.CourseProgress, 0)
+
+    for rows.Next() {
+        var course entity.CourseProgress
+        var topicID, position, projectID, submissionID, grade *int
+        var topicName, topicStatus, projectName, submissionStatus, reviewComment *string
+        var startedAt, completedAt, submittedAt, reviewedAt *time.Time
+
+        err := rows.Scan(&course.CourseID, &course.CourseName, &topicID, &topicName, &position, &topicStatus,
+            &startedAt, &completedAt, &projectID, &projectName, &submissionID, &submissionStatus, &submittedAt,
+            &grade, &reviewComment, &reviewedAt)
+        if err != nil {
+            return nil, fmt.Errorf("rows.Scan: %w", err)
+        }
+
+        // Rows come grouped by course, then by topic, one per project
+        if len(courses) == 0 || courses[len(courses)-1].CourseID != course.CourseID {
+            course.Topics = make([]entity.TopicProgress, 0)
+            courses = append(courses, course)
+        }
+
+        if topicID == nil {
+            continue
+        }
+
+        c := &courses[len(courses)-1]
+
+        if len(c.Topics) == 0 || c.Topics[len(c.Topics)-1].TopicID != *topicID {
+            topic := entity.TopicProgress{
+                TopicID:     *topicID,
+                Name:        *topicName,
+                Position:    *position,
+                Status:      entity.TopicStatusNotStarted,
+                StartedAt:   formatTime(startedAt),
+                CompletedAt: formatTime(completedAt),
+                Projects:    make([]entity.ProjectProgress, 0),
+            }
+
+            if topicStatus != nil {
+                topic.Status = entity.TopicStatus(*topicStatus)
+            }
+
+            c.Topics = append(c.Topics, topic)
+        }
+
+        if projectID == nil {
+            continue
+        }
+
+        project := entity.ProjectProgress{
+            ProjectID:   *projectID,
+            Name:        *projectName,
+            Status:      entity.SubmissionStatusNotSubmitted,
+            Grade:       grade,
+            SubmittedAt: formatTime(submittedAt),
+            ReviewedAt:  formatTime(reviewedAt),
+        }
+
+        if submissionID != nil {
+            project.SubmissionID = *submissionID
+            project.Status = entity.SubmissionStatus(*submissionStatus)
+        }
+
+        if reviewComment != nil {
+            project.ReviewComment = *reviewComment
+        }
+
+        t := &c.Topics[len(c.Topics)-1]
+        t.Projects = append(t.Projects, project)
+    }
+
+    if err := rows.Err(); err != nil {
+        return nil, fmt.Errorf("rows.Err: %w", err)
+    }
+
+    return courses, nil
+}
+
+func scanSubmission(row pgx.Row) (entity.ProjectSubmission, error) {
+    ent, _, err := scanSubmissionAt(row)
+
+    return ent, err
+}
+
+// scanSubmissionAt also returns the exact submission time for pagination.
+func scanSubmissionAt(row pgx.Row) (entity.ProjectSubmission, time.Time, error) {
+    ent := entity.ProjectSubmission{}
+    var status string
+    var submittedAt time.Time
+    var reviewedAt *time.Time
+
+    err := row.Scan(&ent.SubmissionID, &ent.UserID, &ent.CourseID, &ent.ProjectID, &status, &ent.SolutionURL,
+        &submittedAt, &ent.ReviewerID, &ent.Grade, &ent.ReviewComment, &reviewedAt)
+    if err != nil {
+        if errors.Is(err, pgx.ErrNoRows) {
+            return entity.ProjectSubmission{}, time.Time{}, entity.ErrSubmissionNotFound
+        }
+
+        return entity.ProjectSubmission{}, time.Time{}, err
+    }
+
+    ent.Status = entity.SubmissionStatus(status)
+    ent.SubmittedAt = submittedAt.Format(time.RFC3339)
+    ent.ReviewedAt = formatTime(reviewedAt)
+
+    return ent, submittedAt, nil
+}
+
+func formatTime(t *time.Time) string {
+    if t == nil {
+        return ""
+    }
+
+    return t.Format(time.RFC3339)
+}
+
+func encodeSubmissionCursor(cursor submissionCursor) (string, error) {
+    data, err := json.Marshal(cursor)
+    if err != nil {
+        return "", fmt.Errorf("json.Marshal: %w", err)
+    }
+
+    return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeSubmissionCursor(encoded string) (submissionCursor, error) {
+    data, err := base64.RawURLEncoding.DecodeString(encoded)
+    if err != nil {
+        return submissionCursor{}, entity.ErrInvalidCursor
+    }
+
+    var cursor submissionCursor
+    if err = json.Unmarshal(data, &cursor); err != nil {
+        return submissionCursor{}, entity.ErrInvalidCursor
+    }
+
+    return cursor, nil
+}
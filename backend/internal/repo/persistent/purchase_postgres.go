@@ -0,0 +1,199 @@
+package persistent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/deadnotxaa/education-platform/backend/internal/entity"
+	"github.com/jackc/pgx/v5"
+)
+
+var _purchaseColumns = []string{
+    "purchase_id", "user_id", "course_id", "COALESCE(course_calendar_id, 0)", "purchase_date",
+    "COALESCE(course_type_id, 0)", "COALESCE(total_price, 0)", "purchase_status", "COALESCE(payment_intent_id, '')",
+}
+
+// GetCourseCalendarForUpdate retrieves a cohort and locks its row until the end of the transaction.
+func (r *PostgresRepo) GetCourseCalendarForUpdate(ctx context.Context, calendarID int) (entity.CourseCalendar, error) {
+    sql, args, err := r.Builder.
+        Select(_calendarColumns...).
+        From("course_calendar").
+        Where("id = ?", calendarID).
+        Suffix("FOR UPDATE").
+        ToSql()
+
+    if err != nil {
+        return entity.CourseCalendar{}, fmt.Errorf("PostgresRepo - GetCourseCalendarForUpdate - r.Builder: %w", err)
+    }
+
+    ent, err := scanCalendar(r.db(ctx).QueryRow(ctx, sql, args...))
+    if err != nil {
+        return entity.CourseCalendar{}, fmt.Errorf("PostgresRepo - GetCourseCalendarForUpdate - scanCalendar: %w", err)
+    }
+
+    return ent, nil
+}
+
+// AdjustRemainingPlaces adds delta (negative to reserve, positive to release) to the cohort's remaining places.
+func (r *PostgresRepo) AdjustRemainingPlaces(ctx context.Context, calendarID int, delta int) error {
+    sql, args, err := r.Builder.
+        Update("course_calendar").
+        Set("remaining_places", squirrel.Expr("remaining_places + ?", delta)).
+        Where("id = ?", calendarID).
+        ToSql()
+
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - AdjustRemainingPlaces - r.Builder: %w", err)
+    }
+
+    tag, err := r.db(ctx).Exec(ctx, sql, args...)
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - AdjustRemainingPlaces - r.db.Exec: %w", err)
+    }
+
+    if tag.RowsAffected() == 0 {
+        return fmt.Errorf("PostgresRepo - AdjustRemainingPlaces: %w", entity.ErrCourseCalendarNotFound)
+    }
+
+    return nil
+}
+
+// GetCourseType -.
+func (r *PostgresRepo) GetCourseType(ctx context.Context, courseTypeID int) (entity.CourseType, error) {
+    sql, args, err := r.Builder.
+        Select("id", "type_name", "COALESCE(discount, 0)").
+        From("course_type").
+        Where("id = ?", courseTypeID).
+        ToSql()
+
+    if err != nil {
+        return entity.CourseType{}, fmt.Errorf("PostgresRepo - GetCourseType - r.Builder: %w", err)
+    }
+
+    ent := entity.CourseType{}
+
+    err = r.db(ctx).QueryRow(ctx, sql, args...).Scan(&ent.ID, &ent.TypeName, &ent.Discount)
+    if err != nil {
+        if errors.Is(err, pgx.ErrNoRows) {
+            return entity.CourseType{}, fmt.Errorf("PostgresRepo - GetCourseType: %w", entity.ErrCourseTypeNotFound)
+        }
+
+        return entity.CourseType{}, fmt.Errorf("PostgresRepo - GetCourseType - row.Scan: %w", err)
+    }
+
+    return ent, nil
+}
+
+// CreatePurchase -.
+func (r *PostgresRepo) CreatePurchase(ctx context.Context, purchase entity.Purchase) (entity.Purchase, error) {
+    var courseTypeID *int
+    if purchase.CourseTypeID != 0 {
+        courseTypeID = &purchase.CourseTypeID
+    }
+
+    sql, args, err := r.Builder.
+        Insert("purchase").
+        Columns("user_id", "course_id", "course_calendar_id", "purchase_date", "course_type_id", "total_price",
+            "purchase_status").
+        Values(purchase.UserID, purchase.CourseID, purchase.CalendarID, time.Now(), courseTypeID,
+            purchase.TotalPrice, purchase.PurchaseStatus.String()).
+        Suffix("RETURNING " + strings.Join(_purchaseColumns, ", ")).
+        ToSql()
+
+    if err != nil {
+        return entity.Purchase{}, fmt.Errorf("PostgresRepo - CreatePurchase - r.Builder: %w", err)
+    }
+
+    ent, err := scanPurchase(r.db(ctx).QueryRow(ctx, sql, args...))
+    if err != nil {
+        return entity.Purchase{}, fmt.Errorf("PostgresRepo - CreatePurchase - scanPurchase: %w", err)
+    }
+
+    return ent, nil
+}
+
+// GetPurchaseForUpdate retrieves a purchase and locks its row until the end of the transaction.
+func (r *PostgresRepo) GetPurchaseForUpdate(ctx context.Context, purchaseID int) (entity.Purchase, error) {
+    sql, args, err := r.Builder.
+        Select(_purchaseColumns...).
+        From("purchase").
+        Where("purchase_id = ?", purchaseID).
+        Suffix("FOR UPDATE").
+        ToSql()
+
+    if err != nil {
+        return entity.Purchase{}, fmt.Errorf("PostgresRepo - GetPurchaseForUpdate - r.Builder: %w", err)
+    }
+
+    ent, err := scanPurchase(r.db(ctx).QueryRow(ctx, sql, args...))
+    if err != nil {
+        return entity.Purchase{}, fmt.Errorf("PostgresRepo - GetPurchaseForUpdate - scanPurchase: %w", err)
+    }
+
+    return ent, nil
+}
+
+// UpdatePurchaseStatus -.
+func (r *PostgresRepo) UpdatePurchaseStatus(ctx context.Context, purchaseID int, status entity.PurchaseStatus) error {
+    sql, args, err := r.Builder.
+        Update("purchase").
+        Set("purchase_status", status.String()).
+        Where("purchase_id = ?", purchaseID).
+        ToSql()
+
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - UpdatePurchaseStatus - r.Builder: %w", err)
+    }
+
+    tag, err := r.db(ctx).Exec(ctx, sql, args...)
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - UpdatePurchaseStatus - r.db.Exec: %w", err)
+    }
+
+    if tag.RowsAffected() == 0 {
+        return fmt.Errorf("PostgresRepo - UpdatePurchaseStatus: %w", entity.ErrPurchaseNotFound)
+    }
+
+    return nil
+}
+
+func scanPurchase(row pgx.Row) (entity.Purchase, error) {
+    ent := entity.Purchase{}
+    var purchaseDate *time.Time
+    var status string
+
+    err := row.Scan(&ent.PurchaseID, &ent.UserID, &ent.CourseID, &ent.CalendarID, &purchaseDate, &ent.CourseTypeID,
+        &ent.TotalPrice, &status, &ent.PaymentIntent)
+
+    if err != nil {
+        if errors.Is(err, pgx.ErrNoRows) {
+            return entity.Purchase{}, entity.ErrPurchaseNotFound
+        }
+
+        return entity.Purchase{}, err
+    }
+
+    if purchaseDate != nil {
+        ent.PurchaseDate = purchaseDate.Format(time.RFC3339)
+    }
+
+    ent.PurchaseStatus, err = entity.ParsePurchaseStatus(status)
+    if err != nil {
+        return entity.Purchase{}, err
+    }
+
+    return ent, nil
+}
+
+// formatDate formats a nullable DATE column.
+func formatDate(date *time.Time) string {
+    if date == nil {
+        return ""
+    }
+
+    return date.Format(time.DateOnly)
+}
@@ -0,0 +1,79 @@
+package persistent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/deadnotxaa/education-platform/backend/internal/entity"
+	"github.com/jackc/pgx/v5"
+)
+
+// GetAccountPermissions retrieves permissions granted to all employee roles of the account.
+func (r *PostgresRepo) GetAccountPermissions(ctx context.Context, accountID int) ([]entity.Permission, error) {
+    sql, args, err := r.Builder.
+        Select("DISTINCT p.name").
+        From("employee e").
+        Join("role_permission rp ON rp.role_id = e.role_id").
+        Join("permission p ON p.id = rp.permission_id").
+        Where("e.user_id = ?", accountID).
+        ToSql()
+
+    if err != nil {
+        return nil, fmt.Errorf("PostgresRepo - GetAccountPermissions - r.Builder: %w", err)
+    }
+
+    rows, err := r.Pool.Query(ctx, sql, args...)
+    if err != nil {
+        return nil, fmt.Errorf("PostgresRepo - GetAccountPermissions - r.Pool.Query: %w", err)
+    }
+    defer rows.Close()
+
+    permissions := make([]entity.Permission, 0)
+
+    for rows.Next() {
+        var p entity.Permission
+
+        if err = rows.Scan(&p); err != nil {
+            return nil, fmt.Errorf("PostgresRepo - GetAccountPermissions - rows.Scan: %w", err)
+        }
+
+        permissions = append(permissions, p)
+    }
+
+    if err = rows.Err(); err != nil {
+        return nil, fmt.Errorf("PostgresRepo - GetAccountPermissions - rows.Err: %w", err)
+    }
+
+    return permissions, nil
+}
+
+// GetEmployeeWithPermission retrieves the ID of the account's employee record whose role grants the permission,
+// entity.ErrForbidden is returned if there is none.
+func (r *PostgresRepo) GetEmployeeWithPermission(ctx context.Context, accountID int,
+    permission entity.Permission) (int, error) {
+    sql, args, err := r.Builder.
+        Select("e.id").
+        From("employee e").
+        Join("role_permission rp ON rp.role_id = e.role_id").
+        Join("permission p ON p.id = rp.permission_id").
+        Where("e.user_id = ? AND p.name = ?", accountID, string(permission)).
+        OrderBy("e.id").
+        Limit(1).
+        ToSql()
+
+    if err != nil {
+        return 0, fmt.Errorf("PostgresRepo - GetEmployeeWithPermission - r.Builder: %w", err)
+    }
+
+    var employeeID int
+    if err = r.db(ctx).QueryRow(ctx, sql, args...).Scan(&employeeID); err != nil {
+        if errors.Is(err, pgx.ErrNoRows) {
+            return 0, fmt.Errorf("PostgresRepo - GetEmployeeWithPermission: %w", entity.ErrForbidden)
+        }
+
+        return 0, fmt.Errorf("PostgresRepo - GetEmployeeWithPermission - row.Scan: %w", err)
+    }
+
+    return employeeID, nil
+}
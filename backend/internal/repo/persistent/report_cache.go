@@ -0,0 +1,131 @@
+package persistent
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// How often an instance waiting for another one to rebuild a report checks the cache
+const _reportPollInterval = 100 * time.Millisecond
+
+// reportSource - how to read, store and build one cached report.
+type reportSource[T any] struct {
+    get   func(ctx context.Context) (T, bool, error)
+    set   func(ctx context.Context, report T) error
+    query func(ctx context.Context) (T, error)
+}
+
+// loadReport returns a report through the Redis cache:
+//   - a fresh report is returned as is;
+//   - a stale report is returned right away and rebuilt in the background;
+//   - on a miss concurrent requests of this instance share one query, and other instances
+//     wait for the instance holding the Redis lock instead of running the heavy query too.
+func loadReport[T any](ctx context.Context, r *PostgresRepo, key string, src reportSource[T]) (T, error) {
+    report, fresh, err := src.get(ctx)
+    if err == nil {
+        if !fresh {
+            go func() {
+                // A refresh that loses the lock returns no report, so misses must not join it
+                _, err, _ := r.sf.Do(key+":refresh", func() (any, error) {
+                    return refreshReport(context.WithoutCancel(ctx), r, key, src, false)
+                })
+                if err != nil {
+                    r.l.Error(fmt.Errorf("PostgresRepo - loadReport - refresh of %s: %w", key, err))
+                }
+            }()
+        }
+
+        return report, nil
+    }
+
+    // The shared query must not be cancelled when the request that started it goes away
+    ch := r.sf.DoChan(key, func() (any, error) {
+        return refreshReport(context.WithoutCancel(ctx), r, key, src, true)
+    })
+
+    select {
+    case res := <-ch:
+        if res.Err != nil {
+            var zero T
+            return zero, res.Err
+        }
+
+        return res.Val.(T), nil
+    case <-ctx.Done():
+        var zero T
+        return zero, ctx.Err()
+    }
+}
+
+// refreshReport rebuilds a report under the Redis lock. If another instance holds the lock,
+// it waits for that instance's result when wait is set, and gives up otherwise.
+func refreshReport[T any](ctx context.Context, r *PostgresRepo, key string, src reportSource[T], wait bool) (T, error) {
+    var zero T
+
+    token, acquired, err := r.rr.AcquireLock(ctx, key, r.reportLockTTL)
+    if err != nil {
+        return zero, fmt.Errorf("r.rr.AcquireLock: %w", err)
+    }
+
+    if !acquired {
+        if !wait {
+            return zero, nil
+        }
+
+        report, err := waitForReport(ctx, r, src)
+        if err == nil {
+            return report, nil
+        }
+
+        // The lock holder didn't store the report in time, build it without the lock
+        report, err = src.query(ctx)
+        if err != nil {
+            return zero, fmt.Errorf("src.query: %w", err)
+        }
+
+        return report, nil
+    }
+
+    defer func() {
+        if err := r.rr.ReleaseLock(ctx, key, token); err != nil {
+            r.l.Error(fmt.Errorf("PostgresRepo - refreshReport - r.rr.ReleaseLock of %s: %w", key, err))
+        }
+    }()
+
+    // The report must be stored before the lock expires and another instance starts rebuilding it
+    queryCtx, cancel := context.WithTimeout(ctx, r.reportLockTTL)
+    defer cancel()
+
+    report, err := src.query(queryCtx)
+    if err != nil {
+        return zero, fmt.Errorf("src.query: %w", err)
+    }
+
+    if err = src.set(ctx, report); err != nil {
+        return zero, fmt.Errorf("src.set: %w", err)
+    }
+
+    return report, nil
+}
+
+// waitForReport polls the cache until another instance stores the report or its lock expires.
+func waitForReport[T any](ctx context.Context, r *PostgresRepo, src reportSource[T]) (T, error) {
+    ctx, cancel := context.WithTimeout(ctx, r.reportLockTTL)
+    defer cancel()
+
+    ticker := time.NewTicker(_reportPollInterval)
+    defer ticker.Stop()
+
+    for {
+        select {
+        case <-ctx.Done():
+            var zero T
+            return zero, ctx.Err()
+        case <-ticker.C:
+            if report, _, err := src.get(ctx); err == nil {
+                return report, nil
+            }
+        }
+    }
+}
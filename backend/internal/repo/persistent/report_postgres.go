@@ -0,0 +1,106 @@
+package persistent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/deadnotxaa/education-platform/backend/internal/entity"
+)
+
+// GetDetailedPurchaseReport returns the latest purchases with buyer, course, specialization, course type and
+// the work places of the course teachers, cached in Redis.
+func (r *PostgresRepo) GetDetailedPurchaseReport(ctx context.Context,
+    filter entity.DetailedPurchaseReportFilter) ([]entity.DetailedPurchaseReport, error) {
+    key := fmt.Sprintf("detailed_purchase_report:%s:%s:%d:%d", filter.From.Format(time.DateOnly),
+        filter.To.Format(time.DateOnly), filter.SpecializationID, filter.Limit)
+
+    reports, err := loadReport(ctx, r, key, reportSource[[]entity.DetailedPurchaseReport]{
+        get: func(ctx context.Context) ([]entity.DetailedPurchaseReport, bool, error) {
+            return r.rr.GetDetailedPurchaseReport(ctx, filter)
+        },
+        set: func(ctx context.Context, reports []entity.DetailedPurchaseReport) error {
+            return r.rr.SetDetailedPurchaseReport(ctx, filter, reports, r.reportTTL, r.reportStaleTTL)
+        },
+        query: func(ctx context.Context) ([]entity.DetailedPurchaseReport, error) {
+            return r.queryDetailedPurchaseReport(ctx, filter)
+        },
+    })
+
+    if err != nil {
+        return nil, fmt.Errorf("PostgresRepo - GetDetailedPurchaseReport - loadReport: %w", err)
+    }
+
+    return reports, nil
+}
+
+// Date bounds use idx_purchase_date/idx_purchase_date_desc, the specialization filter uses idx_course_specialization_id.
+func (r *PostgresRepo) queryDetailedPurchaseReport(ctx context.Context,
+    filter entity.DetailedPurchaseReportFilter) ([]entity.DetailedPurchaseReport, error) {
+    builder := r.Builder.
+        Select("COALESCE(u.name, '')", "COALESCE(u.surname, '')", "c.name", "cs.name",
+            "COALESCE(ct.type_name, '')", "COALESCE(p.total_price, 0)::float8", "p.purchase_date",
+            "COALESCE(tw.work_places, '')").
+        From("purchase p").
+        Join("users u ON u.account_id = p.user_id").
+        Join("course c ON c.course_id = p.course_id").
+        Join("course_specialization cs ON cs.id = c.specialization_id").
+        LeftJoin("course_type ct ON ct.id = p.course_type_id").
+        // Aggregated per course, so courses with several teachers don't multiply purchase rows
+        JoinClause(`LEFT JOIN LATERAL (
+            SELECT STRING_AGG(DISTINCT t.work_place, ', ') AS work_places
+            FROM course_teacher ctr
+            JOIN teacher t ON t.employee_id = ctr.teacher_id
+            WHERE ctr.course_id = c.course_id AND ctr.staff_role = 'Teacher'
+        ) tw ON TRUE`)
+
+    if !filter.From.IsZero() {
+        builder = builder.Where(squirrel.GtOrEq{"p.purchase_date": filter.From})
+    }
+    if !filter.To.IsZero() {
+        builder = builder.Where(squirrel.Lt{"p.purchase_date": filter.To.AddDate(0, 0, 1)})
+    }
+    if filter.SpecializationID != 0 {
+        builder = builder.Where(squirrel.Eq{"c.specialization_id": filter.SpecializationID})
+    }
+
+    sql, args, err := builder.
+        OrderBy("p.purchase_date DESC NULLS LAST", "p.purchase_id DESC").
+        Limit(uint64(filter.Limit)).
+        ToSql()
+
+    if err != nil {
+        return nil, fmt.Errorf("r.Builder: %w", err)
+    }
+
+    rows, err := r.Pool.Query(ctx, sql, args...)
+    if err != nil {
+        return nil, fmt.Errorf("r.Pool.Query: %w", err)
+    }
+    defer rows.Close()
+
+    entities := make([]entity.DetailedPurchaseReport, 0, filter.Limit)
+
+    for rows.Next() {
+        e := entity.DetailedPurchaseReport{}
+        var purchaseDate *time.Time
+
+        err = rows.Scan(&e.UserName, &e.UserSurname, &e.CourseName, &e.SpecializationName, &e.CourseType,
+            &e.TotalPrice, &purchaseDate, &e.TeacherWorkPlace)
+
+        if err != nil {
+            return nil, fmt.Errorf("rows.Scan: %w", err)
+        }
+
+        e.PurchaseDate = formatDate(purchaseDate)
+
+        entities = append(entities, e)
+    }
+
+    if err = rows.Err(); err != nil {
+        return nil, fmt.Errorf("rows.Err: %w", err)
+    }
+
+    return entities, nil
+}
@@ -0,0 +1,278 @@
+package persistent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/deadnotxaa/education-platform/backend/internal/entity"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+var _reviewColumns = []string{
+    "review_id", "course_id", "user_id", "COALESCE(rating, 0)", "COALESCE(comment, '')", "review_date",
+    "moderation_status",
+}
+
+// HasCompletedPurchase checks whether the user has paid for the course.
+func (r *PostgresRepo) HasCompletedPurchase(ctx context.Context, userID, courseID int) (bool, error) {
+    sql, args, err := r.Builder.
+        Select("1").
+        From("purchase").
+        Where(squirrel.Eq{
+            "user_id":         userID,
+            "course_id":       courseID,
+            "purchase_status": entity.PurchaseStatusCompleted.String(),
+        }).
+        Prefix("SELECT EXISTS (").
+        Suffix(")").
+        ToSql()
+
+    if err != nil {
+        return false, fmt.Errorf("PostgresRepo - HasCompletedPurchase - r.Builder: %w", err)
+    }
+
+    var exists bool
+    if err = r.db(ctx).QueryRow(ctx, sql, args...).Scan(&exists); err != nil {
+        return false, fmt.Errorf("PostgresRepo - HasCompletedPurchase - row.Scan: %w", err)
+    }
+
+    return exists, nil
+}
+
+// CreateReview inserts a review waiting for moderation.
+func (r *PostgresRepo) CreateReview(ctx context.Context, review entity.CourseReview) (entity.CourseReview, error) {
+    sql, args, err := r.Builder.
+        Insert("course_review").
+        Columns("course_id", "user_id", "rating", "comment", "review_date", "moderation_status").
+        Values(review.CourseID, review.UserID, review.Rating, review.Comment, time.Now(),
+            string(entity.ReviewStatusPending)).
+        Suffix("RETURNING " + strings.Join(_reviewColumns, ", ")).
+        ToSql()
+
+    if err != nil {
+        return entity.CourseReview{}, fmt.Errorf("PostgresRepo - CreateReview - r.Builder: %w", err)
+    }
+
+    ent, err := scanReview(r.db(ctx).QueryRow(ctx, sql, args...))
+    if err != nil {
+        var pgErr *pgconn.PgError
+        if errors.As(err, &pgErr) && pgErr.Code == _uniqueViolation {
+            return entity.CourseReview{}, fmt.Errorf("PostgresRepo - CreateReview: %w", entity.ErrReviewExists)
+        }
+
+        return entity.CourseReview{}, fmt.Errorf("PostgresRepo - CreateReview - scanReview: %w", err)
+    }
+
+    return ent, nil
+}
+
+// GetReviewForUpdate retrieves a review and locks its row until the end of the transaction.
+func (r *PostgresRepo) GetReviewForUpdate(ctx context.Context, reviewID int) (entity.CourseReview, error) {
+    sql, args, err := r.Builder.
+        Select(_reviewColumns...).
+        From("course_review").
+        Where("review_id = ?", reviewID).
+        Suffix("FOR UPDATE").
+        ToSql()
+
+    if err != nil {
+        return entity.CourseReview{}, fmt.Errorf("PostgresRepo - GetReviewForUpdate - r.Builder: %w", err)
+    }
+
+    ent, err := scanReview(r.db(ctx).QueryRow(ctx, sql, args...))
+    if err != nil {
+        return entity.CourseReview{}, fmt.Errorf("PostgresRepo - GetReviewForUpdate - scanReview: %w", err)
+    }
+
+    return ent, nil
+}
+
+// UpdateReview replaces the rating and comment, the edited review goes back to the moderation queue.
+func (r *PostgresRepo) UpdateReview(ctx context.Context, review entity.CourseReview) (entity.CourseReview, error) {
+    sql, args, err := r.Builder.
+        Update("course_review").
+        Set("rating", review.Rating).
+        Set("comment", review.Comment).
+        Set("review_date", time.Now()).
+        Set("moderation_status", string(entity.ReviewStatusPending)).
+        Set("moderated_by", nil).
+        Set("moderated_at", nil).
+        Where("review_id = ?", review.ReviewID).
+        Suffix("RETURNING " + strings.Join(_reviewColumns, ", ")).
+        ToSql()
+
+    if err != nil {
+        return entity.CourseReview{}, fmt.Errorf("PostgresRepo - UpdateReview - r.Builder: %w", err)
+    }
+
+    ent, err := scanReview(r.db(ctx).QueryRow(ctx, sql, args...))
+    if err != nil {
+        return entity.CourseReview{}, fmt.Errorf("PostgresRepo - UpdateReview - scanReview: %w", err)
+    }
+
+    return ent, nil
+}
+
+// DeleteReview -.
+func (r *PostgresRepo) DeleteReview(ctx context.Context, reviewID int) error {
+    sql, args, err := r.Builder.
+        Delete("course_review").
+        Where("review_id = ?", reviewID).
+        ToSql()
+
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - DeleteReview - r.Builder: %w", err)
+    }
+
+    tag, err := r.db(ctx).Exec(ctx, sql, args...)
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - DeleteReview - r.db.Exec: %w", err)
+    }
+
+    if tag.RowsAffected() == 0 {
+        return fmt.Errorf("PostgresRepo - DeleteReview: %w", entity.ErrReviewNotFound)
+    }
+
+    return nil
+}
+
+// ListPendingReviews returns the moderation queue oldest first, paginated by review ID.
+func (r *PostgresRepo) ListPendingReviews(ctx context.Context, afterID int, limit uint32) (entity.ReviewPage, error) {
+    // One extra row tells whether there is a next page
+    sql, args, err := r.Builder.
+        Select(_reviewColumns...).
+        From("course_review").
+        Where(squirrel.Eq{"moderation_status": string(entity.ReviewStatusPending)}).
+        Where(squirrel.Gt{"review_id": afterID}).
+        OrderBy("review_id").
+        Limit(uint64(limit) + 1).
+        ToSql()
+
+    if err != nil {
+        return entity.ReviewPage{}, fmt.Errorf("PostgresRepo - ListPendingReviews - r.Builder: %w", err)
+    }
+
+    rows, err := r.Pool.Query(ctx, sql, args...)
+    if err != nil {
+        return entity.ReviewPage{}, fmt.Errorf("PostgresRepo - ListPendingReviews - r.Pool.Query: %w", err)
+    }
+    defer rows.Close()
+
+    reviews := make([]entity.CourseReview, 0, limit+1)
+
+    for rows.Next() {
+        e, err := scanReview(rows)
+        if err != nil {
+            return entity.ReviewPage{}, fmt.Errorf("PostgresRepo - ListPendingReviews - scanReview: %w", err)
+        }
+
+        reviews = append(reviews, e)
+    }
+
+    if err = rows.Err(); err != nil {
+        return entity.ReviewPage{}, fmt.Errorf("PostgresRepo - ListPendingReviews - rows.Err: %w", err)
+    }
+
+    page := entity.ReviewPage{Reviews: reviews}
+
+    if len(reviews) > int(limit) {
+        page.Reviews = reviews[:limit]
+        page.NextID = page.Reviews[limit-1].ReviewID
+    }
+
+    return page, nil
+}
+
+// ListApprovedReviewsByCourses retrieves the latest approved reviews of several courses by course ID, at most
+// perCourse of each, courses without reviews are left out.
+func (r *PostgresRepo) ListApprovedReviewsByCourses(ctx context.Context, courseIDs []int,
+    perCourse uint32) (map[int][]entity.CourseReview, error) {
+    rows, err := r.Pool.Query(ctx,
+        `SELECT `+strings.Join(_reviewColumns, ", ")+`
+        FROM (
+            SELECT *, ROW_NUMBER() OVER (PARTITION BY course_id ORDER BY review_date DESC, review_id DESC) AS rn
+            FROM course_review
+            WHERE course_id = ANY($1) AND moderation_status = $2
+        ) cr
+        WHERE rn <= $3
+        ORDER BY course_id, rn;`,
+        courseIDs, string(entity.ReviewStatusApproved), perCourse,
+    )
+
+    if err != nil {
+        return nil, fmt.Errorf("PostgresRepo - ListApprovedReviewsByCourses - r.Pool.Query: %w", err)
+    }
+    defer rows.Close()
+
+    reviews := make(map[int][]entity.CourseReview, len(courseIDs))
+
+    for rows.Next() {
+        e, err := scanReview(rows)
+        if err != nil {
+            return nil, fmt.Errorf("PostgresRepo - ListApprovedReviewsByCourses - scanReview: %w", err)
+        }
+
+        reviews[e.CourseID] = append(reviews[e.CourseID], e)
+    }
+
+    if err = rows.Err(); err != nil {
+        return nil, fmt.Errorf("PostgresRepo - ListApprovedReviewsByCourses - rows.Err: %w", err)
+    }
+
+    return reviews, nil
+}
+
+// SetReviewStatus records a moderation decision.
+func (r *PostgresRepo) SetReviewStatus(ctx context.Context, reviewID int, status entity.ReviewStatus,
+    moderatorID int) error {
+    sql, args, err := r.Builder.
+        Update("course_review").
+        Set("moderation_status", string(status)).
+        Set("moderated_by", moderatorID).
+        Set("moderated_at", time.Now()).
+        Where("review_id = ?", reviewID).
+        ToSql()
+
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - SetReviewStatus - r.Builder: %w", err)
+    }
+
+    tag, err := r.db(ctx).Exec(ctx, sql, args...)
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - SetReviewStatus - r.db.Exec: %w", err)
+    }
+
+    if tag.RowsAffected() == 0 {
+        return fmt.Errorf("PostgresRepo - SetReviewStatus: %w", entity.ErrReviewNotFound)
+    }
+
+    return nil
+}
+
+func scanReview(row pgx.Row) (entity.CourseReview, error) {
+    ent := entity.CourseReview{}
+    var reviewDate *time.Time
+    var status string
+
+    err := row.Scan(&ent.ReviewID, &ent.CourseID, &ent.UserID, &ent.Rating, &ent.Comment, &reviewDate, &status)
+    if err != nil {
+        if errors.Is(err, pgx.ErrNoRows) {
+            return entity.CourseReview{}, entity.ErrReviewNotFound
+        }
+
+        return entity.CourseReview{}, err
+    }
+
+    if reviewDate != nil {
+        ent.ReviewDate = reviewDate.Format(time.RFC3339)
+    }
+
+    ent.Status = entity.ReviewStatus(status)
+
+    return ent, nil
+}
@@ -0,0 +1,116 @@
+package persistent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deadnotxaa/education-platform/backend/internal/entity"
+	"github.com/jackc/pgx/v5"
+)
+
+// Options of ts_headline used to mark matched words in search results. The text is HTML-escaped before
+// highlighting, so the <b> marks are the only markup in a highlight and it is safe to render as HTML.
+const _searchHighlightOptions = "StartSel=<b>, StopSel=</b>, MaxFragments=2, MaxWords=30, MinWords=10"
+
+// SearchCourses finds courses by their name, description and the names and technologies of their topics.
+// Topic matches weigh half as much as matches in the course itself.
+func (r *PostgresRepo) SearchCourses(ctx context.Context, text string, limit uint32) ([]entity.SearchHit, error) {
+    rows, err := r.Pool.Query(ctx,
+        `WITH q AS (
+            SELECT websearch_to_tsquery('english', $1) AS query
+        ),
+        topic_hits AS (
+            SELECT cta.course_id, MAX(ts_rank(ct.search_vector, q.query)) AS topic_rank
+            FROM course_topic ct
+            JOIN course_topic_association cta ON cta.topic_id = ct.id
+            CROSS JOIN q
+            WHERE ct.search_vector @@ q.query
+            GROUP BY cta.course_id
+        )
+        SELECT
+            c.course_id,
+            c.name,
+            ts_headline(
+                'english',
+                REPLACE(REPLACE(REPLACE(CONCAT_WS(' ', c.name, c.description), '&', '&amp;'), '<', '&lt;'), '>', '&gt;'),
+                q.query, $3
+            ) AS highlight,
+            (ts_rank(c.search_vector, q.query) + 0.5 * COALESCE(th.topic_rank, 0))::float8 AS rank
+        FROM course c
+        CROSS JOIN q
+        LEFT JOIN topic_hits th ON th.course_id = c.course_id
+        WHERE c.deleted_at IS NULL
+          AND (c.search_vector @@ q.query OR th.course_id IS NOT NULL)
+        ORDER BY rank DESC, c.course_id
+        LIMIT $2;`,
+        text, limit, _searchHighlightOptions,
+    )
+
+    if err != nil {
+        return nil, fmt.Errorf("PostgresRepo - SearchCourses - r.Pool.Query: %w", err)
+    }
+
+    hits, err := scanSearchHits(rows, entity.SearchHitCourse, limit)
+    if err != nil {
+        return nil, fmt.Errorf("PostgresRepo - SearchCourses - scanSearchHits: %w", err)
+    }
+
+    return hits, nil
+}
+
+// SearchBlogPosts finds published blog posts by their title, topic and content.
+func (r *PostgresRepo) SearchBlogPosts(ctx context.Context, text string, limit uint32) ([]entity.SearchHit, error) {
+    rows, err := r.Pool.Query(ctx,
+        `WITH q AS (
+            SELECT websearch_to_tsquery('english', $1) AS query
+        )
+        SELECT
+            bp.post_id,
+            COALESCE(bp.title, ''),
+            ts_headline(
+                'english',
+                REPLACE(REPLACE(REPLACE(COALESCE(bp.content, ''), '&', '&amp;'), '<', '&lt;'), '>', '&gt;'),
+                q.query, $3
+            ) AS highlight,
+            ts_rank(bp.search_vector, q.query)::float8 AS rank
+        FROM blog_post bp
+        CROSS JOIN q
+        WHERE bp.search_vector @@ q.query AND bp.status = $4
+        ORDER BY rank DESC, bp.post_id
+        LIMIT $2;`,
+        text, limit, _searchHighlightOptions, string(entity.BlogPostStatusPublished),
+    )
+
+    if err != nil {
+        return nil, fmt.Errorf("PostgresRepo - SearchBlogPosts - r.Pool.Query: %w", err)
+    }
+
+    hits, err := scanSearchHits(rows, entity.SearchHitBlogPost, limit)
+    if err != nil {
+        return nil, fmt.Errorf("PostgresRepo - SearchBlogPosts - scanSearchHits: %w", err)
+    }
+
+    return hits, nil
+}
+
+func scanSearchHits(rows pgx.Rows, hitType entity.SearchHitType, limit uint32) ([]entity.SearchHit, error) {
+    defer rows.Close()
+
+    hits := make([]entity.SearchHit, 0, limit)
+
+    for rows.Next() {
+        h := entity.SearchHit{Type: hitType}
+
+        if err := rows.Scan(&h.ID, &h.Title, &h.Highlight, &h.Rank); err != nil {
+            return nil, fmt.Errorf("rows.Scan: %w", err)
+        }
+
+        hits = append(hits, h)
+    }
+
+    if err := rows.Err(); err != nil {
+        return nil, fmt.Errorf("rows.Err: %w", err)
+    }
+
+    return hits, nil
+}
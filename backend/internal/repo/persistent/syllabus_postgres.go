@@ -0,0 +1,393 @@
+package persistent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/deadnotxaa/education-platform/backend/internal/entity"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+var (
+    _topicColumns = []string{
+        "id", "name", "COALESCE(description, '')", "COALESCE(technologies, '')",
+        "COALESCE(labor_intensity_hours, 0)", "projects_number",
+    }
+
+    _projectColumns = []string{"project_id", "topic_id", "name", "COALESCE(description, '')"}
+)
+
+// GetCourseSyllabus retrieves the topics of a course in learning path order together with their projects.
+func (r *PostgresRepo) GetCourseSyllabus(ctx context.Context, courseID int) ([]entity.SyllabusTopic, error) {
+    syllabi, err := r.GetCourseSyllabi(ctx, []int{courseID})
+    if err != nil {
+        return nil, fmt.Errorf("PostgresRepo - GetCourseSyllabus - r.GetCourseSyllabi: %w", err)
+    }
+
+    if syllabi[courseID] == nil {
+        return make([]entity.SyllabusTopic, 0), nil
+    }
+
+    return syllabi[courseID], nil
+}
+
+// GetCourseSyllabi retrieves the syllabi of several courses by course ID, courses without topics are left out.
+func (r *PostgresRepo) GetCourseSyllabi(ctx context.Context, courseIDs []int) (map[int][]entity.SyllabusTopic, error) {
+    rows, err := r.db(ctx).Query(ctx,
+        `SELECT
+            cta.course_id,
+            ct.id,
+            ct.name,
+            COALESCE(ct.description, ''),
+            COALESCE(ct.technologies, ''),
+            COALESCE(ct.labor_intensity_hours, 0),
+            ct.projects_number,
+            cta.position,
+            p.project_id,
+            p.name,
+            COALESCE(p.description, '')
+        FROM course_topic_association cta
+        JOIN course_topic ct ON ct.id = cta.topic_id
+        LEFT JOIN project p ON p.topic_id = ct.id
+        WHERE cta.course_id = ANY($1)
+        ORDER BY cta.course_id, cta.position, p.project_id;`,
+        courseIDs,
+    )
+
+    if err != nil {
+        return nil, fmt.Errorf("PostgresRepo - GetCourseSyllabi - r.db.Query: %w", err)
+    }
+    defer rows.Close()
+
+    syllabi := make(map[int][]entity.SyllabusTopic, len(courseIDs))
+
+    for rows.Next() {
+        var courseID int
+        var topic entity.SyllabusTopic
+        var projectID *int
+        var projectName, projectDescription *string
+
+        err = rows.Scan(&courseID, &topic.ID, &topic.Name, &topic.Description, &topic.Technologies,
+            &topic.LaborIntensityHours, &topic.ProjectsNumber, &topic.Position, &projectID, &projectName,
+            &projectDescription)
+        if err != nil {
+            return nil, fmt.Errorf("PostgresRepo - GetCourseSyllabi - rows.Scan: %w", err)
+        }
+
+        // Rows come grouped by course and topic, one per project
+        topics := syllabi[courseID]
+        if len(topics) == 0 || topics[len(topics)-1].ID != topic.ID {
+            topic.Projects = make([]entity.Project, 0, topic.ProjectsNumber)
+            topics = append(topics, topic)
+        }
+
+        if projectID != nil {
+            last := &topics[len(topics)-1]
+            last.Projects = append(last.Projects, entity.Project{
+                ProjectID:   *projectID,
+                TopicID:     topic.ID,
+                Name:        *projectName,
+                Description: *projectDescription,
+            })
+        }
+
+        syllabi[courseID] = topics
+    }
+
+    if err = rows.Err(); err != nil {
+        return nil, fmt.Errorf("PostgresRepo - GetCourseSyllabi - rows.Err: %w", err)
+    }
+
+    return syllabi, nil
+}
+
+// LockCourse locks a course that isn't deleted until the end of the transaction,
+// serializing changes of its syllabus.
+func (r *PostgresRepo) LockCourse(ctx context.Context, courseID int) error {
+    sql, args, err := r.Builder.
+        Select("course_id").
+        From("course").
+        Where("course_id = ? AND deleted_at IS NULL", courseID).
+        Suffix("FOR UPDATE").
+        ToSql()
+
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - LockCourse - r.Builder: %w", err)
+    }
+
+    var id int
+    if err = r.db(ctx).QueryRow(ctx, sql, args...).Scan(&id); err != nil {
+        if errors.Is(err, pgx.ErrNoRows) {
+            return fmt.Errorf("PostgresRepo - LockCourse: %w", entity.ErrCourseNotFound)
+        }
+
+        return fmt.Errorf("PostgresRepo - LockCourse - row.Scan: %w", err)
+    }
+
+    return nil
+}
+
+// GetCourseTopicIDs retrieves the IDs of the topics of a course in learning path order.
+func (r *PostgresRepo) GetCourseTopicIDs(ctx context.Context, courseID int) ([]int, error) {
+    sql, args, err := r.Builder.
+        Select("topic_id").
+        From("course_topic_association").
+        Where("course_id = ?", courseID).
+        OrderBy("position").
+        ToSql()
+
+    if err != nil {
+        return nil, fmt.Errorf("PostgresRepo - GetCourseTopicIDs - r.Builder: %w", err)
+    }
+
+    rows, err := r.db(ctx).Query(ctx, sql, args...)
+    if err != nil {
+        return nil, fmt.Errorf("PostgresRepo - GetCourseTopicIDs - r.db.Query: %w", err)
+    }
+
+    ids, err := pgx.CollectRows(rows, pgx.RowTo[int])
+    if err != nil {
+        return nil, fmt.Errorf("PostgresRepo - GetCourseTopicIDs - pgx.CollectRows: %w", err)
+    }
+
+    return ids, nil
+}
+
+// CreateCourseTopic inserts a topic and appends it to the end of the course, the course must be locked.
+// projects_number is maintained by the database.
+func (r *PostgresRepo) CreateCourseTopic(ctx context.Context, courseID int, topic entity.CourseTopic) (entity.SyllabusTopic, error) {
+    sql, args, err := r.Builder.
+        Insert("course_topic").
+        Columns("name", "description", "technologies", "labor_intensity_hours").
+        Values(topic.Name, topic.Description, topic.Technologies, topic.LaborIntensityHours).
+        Suffix("RETURNING " + strings.Join(_topicColumns, ", ")).
+        ToSql()
+
+    if err != nil {
+        return entity.SyllabusTopic{}, fmt.Errorf("PostgresRepo - CreateCourseTopic - r.Builder: %w", err)
+    }
+
+    created, err := scanTopic(r.db(ctx).QueryRow(ctx, sql, args...))
+    if err != nil {
+        return entity.SyllabusTopic{}, fmt.Errorf("PostgresRepo - CreateCourseTopic - scanTopic: %w", err)
+    }
+
+    sql, args, err = r.Builder.
+        Insert("course_topic_association").
+        Columns("course_id", "topic_id", "position").
+        Values(courseID, created.ID, squirrel.Expr(
+            "(SELECT COALESCE(MAX(position), 0) + 1 FROM course_topic_association WHERE course_id = ?)", courseID,
+        )).
+        Suffix("RETURNING position").
+        ToSql()
+
+    if err != nil {
+        return entity.SyllabusTopic{}, fmt.Errorf("PostgresRepo - CreateCourseTopic - r.Builder: %w", err)
+    }
+
+    ent := entity.SyllabusTopic{CourseTopic: created, Projects: make([]entity.Project, 0)}
+
+    if err = r.db(ctx).QueryRow(ctx, sql, args...).Scan(&ent.Position); err != nil {
+        return entity.SyllabusTopic{}, fmt.Errorf("PostgresRepo - CreateCourseTopic - row.Scan: %w", err)
+    }
+
+    return ent, nil
+}
+
+// UpdateCourseTopic replaces all editable fields of a topic, the topic changes in every course it belongs to.
+func (r *PostgresRepo) UpdateCourseTopic(ctx context.Context, topic entity.CourseTopic) (entity.CourseTopic, error) {
+    sql, args, err := r.Builder.
+        Update("course_topic").
+        Set("name", topic.Name).
+        Set("description", topic.Description).
+        Set("technologies", topic.Technologies).
+        Set("labor_intensity_hours", topic.LaborIntensityHours).
+        Where("id = ?", topic.ID).
+        Suffix("RETURNING " + strings.Join(_topicColumns, ", ")).
+        ToSql()
+
+    if err != nil {
+        return entity.CourseTopic{}, fmt.Errorf("PostgresRepo - UpdateCourseTopic - r.Builder: %w", err)
+    }
+
+    ent, err := scanTopic(r.db(ctx).QueryRow(ctx, sql, args...))
+    if err != nil {
+        return entity.CourseTopic{}, fmt.Errorf("PostgresRepo - UpdateCourseTopic - scanTopic: %w", err)
+    }
+
+    return ent, nil
+}
+
+// RemoveCourseTopic removes a topic from a course, leaving a gap in positions. The topic itself
+// and its projects are deleted when no other course uses it.
+func (r *PostgresRepo) RemoveCourseTopic(ctx context.Context, courseID, topicID int) error {
+    sql, args, err := r.Builder.
+        Delete("course_topic_association").
+        Where("course_id = ? AND topic_id = ?", courseID, topicID).
+        ToSql()
+
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - RemoveCourseTopic - r.Builder: %w", err)
+    }
+
+    tag, err := r.db(ctx).Exec(ctx, sql, args...)
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - RemoveCourseTopic - r.db.Exec: %w", err)
+    }
+
+    if tag.RowsAffected() == 0 {
+        return fmt.Errorf("PostgresRepo - RemoveCourseTopic: %w", entity.ErrTopicNotFound)
+    }
+
+    for _, sql := range []string{
+        `DELETE FROM project
+        WHERE topic_id = $1
+          AND NOT EXISTS (SELECT 1 FROM course_topic_association WHERE topic_id = $1);`,
+        `DELETE FROM course_topic
+        WHERE id = $1
+          AND NOT EXISTS (SELECT 1 FROM course_topic_association WHERE topic_id = $1);`,
+    } {
+        if _, err = r.db(ctx).Exec(ctx, sql, topicID); err != nil {
+            return fmt.Errorf("PostgresRepo - RemoveCourseTopic - r.db.Exec: %w", err)
+        }
+    }
+
+    return nil
+}
+
+// ReorderCourseTopics sets the position of every topic of a course to its index in topicIDs (starting from 1).
+func (r *PostgresRepo) ReorderCourseTopics(ctx context.Context, courseID int, topicIDs []int) error {
+    // Positions are unique and checked row by row, so the topics are first moved past the current maximum
+    _, err := r.db(ctx).Exec(ctx,
+        `UPDATE course_topic_association
+        SET position = position + (SELECT MAX(position) FROM course_topic_association WHERE course_id = $1)
+        WHERE course_id = $1;`,
+        courseID,
+    )
+
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - ReorderCourseTopics - r.db.Exec: %w", err)
+    }
+
+    _, err = r.db(ctx).Exec(ctx,
+        `UPDATE course_topic_association cta
+        SET position = o.position
+        FROM unnest($2::int[]) WITH ORDINALITY AS o(topic_id, position)
+        WHERE cta.course_id = $1 AND cta.topic_id = o.topic_id;`,
+        courseID, topicIDs,
+    )
+
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - ReorderCourseTopics - r.db.Exec: %w", err)
+    }
+
+    return nil
+}
+
+// CreateProject inserts a project, projects_number of its topic is updated by a trigger.
+func (r *PostgresRepo) CreateProject(ctx context.Context, project entity.Project) (entity.Project, error) {
+    sql, args, err := r.Builder.
+        Insert("project").
+        Columns("topic_id", "name", "description").
+        Values(project.TopicID, project.Name, project.Description).
+        Suffix("RETURNING " + strings.Join(_projectColumns, ", ")).
+        ToSql()
+
+    if err != nil {
+        return entity.Project{}, fmt.Errorf("PostgresRepo - CreateProject - r.Builder: %w", err)
+    }
+
+    ent, err := scanProject(r.db(ctx).QueryRow(ctx, sql, args...))
+    if err != nil {
+        return entity.Project{}, fmt.Errorf("PostgresRepo - CreateProject - scanProject: %w", err)
+    }
+
+    return ent, nil
+}
+
+// UpdateProject replaces all editable fields of a project, it may be moved to another topic.
+func (r *PostgresRepo) UpdateProject(ctx context.Context, project entity.Project) (entity.Project, error) {
+    sql, args, err := r.Builder.
+        Update("project").
+        Set("topic_id", project.TopicID).
+        Set("name", project.Name).
+        Set("description", project.Description).
+        Where("project_id = ?", project.ProjectID).
+        Suffix("RETURNING " + strings.Join(_projectColumns, ", ")).
+        ToSql()
+
+    if err != nil {
+        return entity.Project{}, fmt.Errorf("PostgresRepo - UpdateProject - r.Builder: %w", err)
+    }
+
+    ent, err := scanProject(r.db(ctx).QueryRow(ctx, sql, args...))
+    if err != nil {
+        return entity.Project{}, fmt.Errorf("PostgresRepo - UpdateProject - scanProject: %w", err)
+    }
+
+    return ent, nil
+}
+
+// DeleteProject -.
+func (r *PostgresRepo) DeleteProject(ctx context.Context, projectID int) error {
+    sql, args, err := r.Builder.
+        Delete("project").
+        Where("project_id = ?", projectID).
+        ToSql()
+
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - DeleteProject - r.Builder: %w", err)
+    }
+
+    tag, err := r.db(ctx).Exec(ctx, sql, args...)
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - DeleteProject - r.db.Exec: %w", err)
+    }
+
+    if tag.RowsAffected() == 0 {
+        return fmt.Errorf("PostgresRepo - DeleteProject: %w", entity.ErrProjectNotFound)
+    }
+
+    return nil
+}
+
+func scanTopic(row pgx.Row) (entity.CourseTopic, error) {
+    ent := entity.CourseTopic{}
+
+    err := row.Scan(&ent.ID, &ent.Name, &ent.Description, &ent.Technologies, &ent.LaborIntensityHours,
+        &ent.ProjectsNumber)
+
+    if err != nil {
+        if errors.Is(err, pgx.ErrNoRows) {
+            return entity.CourseTopic{}, entity.ErrTopicNotFound
+        }
+
+        return entity.CourseTopic{}, err
+    }
+
+    return ent, nil
+}
+
+func scanProject(row pgx.Row) (entity.Project, error) {
+    ent := entity.Project{}
+
+    if err := row.Scan(&ent.ProjectID, &ent.TopicID, &ent.Name, &ent.Description); err != nil {
+        if errors.Is(err, pgx.ErrNoRows) {
+            return entity.Project{}, entity.ErrProjectNotFound
+        }
+
+        // The only foreign key of a project references its topic
+        var pgErr *pgconn.PgError
+        if errors.As(err, &pgErr) && pgErr.Code == _foreignKeyViolation {
+            return entity.Project{}, entity.ErrTopicNotFound
+        }
+
+        return entity.Project{}, err
+    }
+
+    return ent, nil
+}
@@ -0,0 +1,385 @@
+package persistent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/deadnotxaa/education-platform/backend/internal/entity"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+var _teacherProfileColumns = []string{
+    "t.employee_id",
+    "COALESCE(t.work_place, '')",
+    "COALESCE(t.overall_experience, 0)",
+    "COALESCE(t.specialization_experience, 0)",
+    "COALESCE(t.photo_url, '')",
+    "COALESCE(t.bio, '')",
+    "COALESCE(u.name, '')",
+    "COALESCE(u.surname, '')",
+    "COALESCE(e.user_id, 0)",
+    "COALESCE(tr.avg_rating, 0)",
+    "tr.reviews_count",
+}
+
+// _teacherRatingJoin aggregates approved reviews of the courses the teacher teaches.
+const _teacherRatingJoin = `LEFT JOIN LATERAL (
+    SELECT AVG(cr.rating)::float8 AS avg_rating, COUNT(cr.review_id)::int AS reviews_count
+    FROM course_teacher ct
+    JOIN course c ON c.course_id = ct.course_id AND c.deleted_at IS NULL
+    JOIN course_review cr ON cr.course_id = ct.course_id AND cr.moderation_status = 'Approved'
+    WHERE ct.teacher_id = t.employee_id AND ct.staff_role = 'Teacher'
+) tr ON TRUE`
+
+// GetEmployee retrieves an employee with the name of its role.
+func (r *PostgresRepo) GetEmployee(ctx context.Context, employeeID int) (entity.Employee, error) {
+    sql, args, err := r.Builder.
+        Select("e.id", "COALESCE(e.user_id, 0)", "COALESCE(e.role_id, 0)", "COALESCE(rl.name, '')").
+        From("employee e").
+        LeftJoin("role rl ON rl.id = e.role_id").
+        Where("e.id = ?", employeeID).
+        ToSql()
+
+    if err != nil {
+        return entity.Employee{}, fmt.Errorf("PostgresRepo - GetEmployee - r.Builder: %w", err)
+    }
+
+    ent := entity.Employee{}
+
+    err = r.db(ctx).QueryRow(ctx, sql, args...).Scan(&ent.ID, &ent.UserID, &ent.RoleID, &ent.RoleName)
+    if err != nil {
+        if errors.Is(err, pgx.ErrNoRows) {
+            return entity.Employee{}, fmt.Errorf("PostgresRepo - GetEmployee: %w", entity.ErrEmployeeNotFound)
+        }
+
+        return entity.Employee{}, fmt.Errorf("PostgresRepo - GetEmployee - row.Scan: %w", err)
+    }
+
+    return ent, nil
+}
+
+// GetTeacherProfile retrieves a teacher with the name and the rating, without the courses.
+func (r *PostgresRepo) GetTeacherProfile(ctx context.Context, employeeID int) (entity.TeacherProfile, error) {
+    sql, args, err := r.Builder.
+        Select(_teacherProfileColumns...).
+        From("teacher t").
+        Join("employee e ON e.id = t.employee_id").
+        LeftJoin("users u ON u.account_id = e.user_id").
+        JoinClause(_teacherRatingJoin).
+        Where("t.employee_id = ?", employeeID).
+        ToSql()
+
+    if err != nil {
+        return entity.TeacherProfile{}, fmt.Errorf("PostgresRepo - GetTeacherProfile - r.Builder: %w", err)
+    }
+
+    ent, err := scanTeacherProfile(r.db(ctx).QueryRow(ctx, sql, args...))
+    if err != nil {
+        return entity.TeacherProfile{}, fmt.Errorf("PostgresRepo - GetTeacherProfile - scanTeacherProfile: %w", err)
+    }
+
+    return ent, nil
+}
+
+// ListTeacherProfiles retrieves a page of teachers ordered by ID.
+func (r *PostgresRepo) ListTeacherProfiles(ctx context.Context, afterID int, limit uint32) (entity.TeacherPage, error) {
+    // One extra row tells whether there is a next page
+    sql, args, err := r.Builder.
+        Select(_teacherProfileColumns...).
+        From("teacher t").
+        Join("employee e ON e.id = t.employee_id").
+        LeftJoin("users u ON u.account_id = e.user_id").
+        JoinClause(_teacherRatingJoin).
+        Where(squirrel.Gt{"t.employee_id": afterID}).
+        OrderBy("t.employee_id").
+        Limit(uint64(limit) + 1).
+        ToSql()
+
+    if err != nil {
+        return entity.TeacherPage{}, fmt.Errorf("PostgresRepo - ListTeacherProfiles - r.Builder: %w", err)
+    }
+
+    rows, err := r.Pool.Query(ctx, sql, args...)
+    if err != nil {
+        return entity.TeacherPage{}, fmt.Errorf("PostgresRepo - ListTeacherProfiles - r.Pool.Query: %w", err)
+    }
+    defer rows.Close()
+
+    teachers := make([]entity.TeacherProfile, 0, limit+1)
+
+    for rows.Next() {
+        e, err := scanTeacherProfile(rows)
+        if err != nil {
+            return entity.TeacherPage{}, fmt.Errorf("PostgresRepo - ListTeacherProfiles - scanTeacherProfile: %w", err)
+        }
+
+        teachers = append(teachers, e)
+    }
+
+    if err = rows.Err(); err != nil {
+        return entity.TeacherPage{}, fmt.Errorf("PostgresRepo - ListTeacherProfiles - rows.Err: %w", err)
+    }
+
+    page := entity.TeacherPage{Teachers: teachers}
+
+    if len(teachers) > int(limit) {
+        page.Teachers = teachers[:limit]
+        page.NextID = page.Teachers[limit-1].EmployeeID
+    }
+
+    return page, nil
+}
+
+// GetTeacherProfilesByIDs retrieves the teachers among the employees, without the courses.
+func (r *PostgresRepo) GetTeacherProfilesByIDs(ctx context.Context, employeeIDs []int) ([]entity.TeacherProfile, error) {
+    sql, args, err := r.Builder.
+        Select(_teacherProfileColumns...).
+        From("teacher t").
+        Join("employee e ON e.id = t.employee_id").
+        LeftJoin("users u ON u.account_id = e.user_id").
+        JoinClause(_teacherRatingJoin).
+        Where(squirrel.Eq{"t.employee_id": employeeIDs}).
+        ToSql()
+
+    if err != nil {
+        return nil, fmt.Errorf("PostgresRepo - GetTeacherProfilesByIDs - r.Builder: %w", err)
+    }
+
+    rows, err := r.Pool.Query(ctx, sql, args...)
+    if err != nil {
+        return nil, fmt.Errorf("PostgresRepo - GetTeacherProfilesByIDs - r.Pool.Query: %w", err)
+    }
+    defer rows.Close()
+
+    teachers := make([]entity.TeacherProfile, 0, len(employeeIDs))
+
+    for rows.Next() {
+        e, err := scanTeacherProfile(rows)
+        if err != nil {
+            return nil, fmt.Errorf("PostgresRepo - GetTeacherProfilesByIDs - scanTeacherProfile: %w", err)
+        }
+
+        teachers = append(teachers, e)
+    }
+
+    if err = rows.Err(); err != nil {
+        return nil, fmt.Errorf("PostgresRepo - GetTeacherProfilesByIDs - rows.Err: %w", err)
+    }
+
+    return teachers, nil
+}
+
+// UpsertTeacher creates the teacher profile of an employee or replaces its fields.
+func (r *PostgresRepo) UpsertTeacher(ctx context.Context, teacher entity.Teacher) error {
+    sql, args, err := r.Builder.
+        Insert("teacher").
+        Columns("employee_id", "work_place", "overall_experience", "specialization_experience", "photo_url", "bio").
+        Values(teacher.EmployeeID, teacher.WorkPlace, teacher.OverallExperience, teacher.SpecializationExperience,
+            teacher.PhotoURL, teacher.Bio).
+        Suffix(`ON CONFLICT (employee_id) DO UPDATE SET
+            work_place = EXCLUDED.work_place,
+            overall_experience = EXCLUDED.overall_experience,
+            specialization_experience = EXCLUDED.specialization_experience,
+            photo_url = EXCLUDED.photo_url,
+            bio = EXCLUDED.bio`).
+        ToSql()
+
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - UpsertTeacher - r.Builder: %w", err)
+    }
+
+    if _, err = r.db(ctx).Exec(ctx, sql, args...); err != nil {
+        return fmt.Errorf("PostgresRepo - UpsertTeacher - r.db.Exec: %w", err)
+    }
+
+    return nil
+}
+
+// ListEmployeeCourses retrieves the courses an employee is assigned to, with the staff role in each.
+func (r *PostgresRepo) ListEmployeeCourses(ctx context.Context, employeeID int) ([]entity.TeacherCourse, error) {
+    courses, err := r.ListEmployeeCoursesByEmployees(ctx, []int{employeeID})
+    if err != nil {
+        return nil, fmt.Errorf("PostgresRepo - ListEmployeeCourses - r.ListEmployeeCoursesByEmployees: %w", err)
+    }
+
+    if courses[employeeID] == nil {
+        return make([]entity.TeacherCourse, 0), nil
+    }
+
+    return courses[employeeID], nil
+}
+
+// ListEmployeeCoursesByEmployees retrieves the courses of several employees by employee ID,
+// employees without courses are left out.
+func (r *PostgresRepo) ListEmployeeCoursesByEmployees(ctx context.Context, employeeIDs []int) (map[int][]entity.TeacherCourse, error) {
+    sql, args, err := r.Builder.
+        Select("ct.teacher_id", "c.course_id", "c.name", "ct.staff_role").
+        From("course_teacher ct").
+        Join("course c ON c.course_id = ct.course_id").
+        Where(squirrel.Eq{"ct.teacher_id": employeeIDs}).
+        Where("c.deleted_at IS NULL").
+        OrderBy("ct.teacher_id", "c.course_id", "ct.staff_role").
+        ToSql()
+
+    if err != nil {
+        return nil, fmt.Errorf("PostgresRepo - ListEmployeeCoursesByEmployees - r.Builder: %w", err)
+    }
+
+    rows, err := r.Pool.Query(ctx, sql, args...)
+    if err != nil {
+        return nil, fmt.Errorf("PostgresRepo - ListEmployeeCoursesByEmployees - r.Pool.Query: %w", err)
+    }
+    defer rows.Close()
+
+    courses := make(map[int][]entity.TeacherCourse, len(employeeIDs))
+
+    for rows.Next() {
+        e := entity.TeacherCourse{}
+        var employeeID int
+        var role string
+
+        if err = rows.Scan(&employeeID, &e.CourseID, &e.Name, &role); err != nil {
+            return nil, fmt.Errorf("PostgresRepo - ListEmployeeCoursesByEmployees - rows.Scan: %w", err)
+        }
+
+        e.StaffRole = entity.StaffRole(role)
+        courses[employeeID] = append(courses[employeeID], e)
+    }
+
+    if err = rows.Err(); err != nil {
+        return nil, fmt.Errorf("PostgresRepo - ListEmployeeCoursesByEmployees - rows.Err: %w", err)
+    }
+
+    return courses, nil
+}
+
+// ListCourseStaff retrieves the teachers, mentors and reviewers of a course.
+func (r *PostgresRepo) ListCourseStaff(ctx context.Context, courseID int) ([]entity.CourseStaffMember, error) {
+    staff, err := r.ListCourseStaffByCourses(ctx, []int{courseID})
+    if err != nil {
+        return nil, fmt.Errorf("PostgresRepo - ListCourseStaff - r.ListCourseStaffByCourses: %w", err)
+    }
+
+    if staff[courseID] == nil {
+        return make([]entity.CourseStaffMember, 0), nil
+    }
+
+    return staff[courseID], nil
+}
+
+// ListCourseStaffByCourses retrieves the staff of several courses by course ID, courses without staff are left out.
+func (r *PostgresRepo) ListCourseStaffByCourses(ctx context.Context, courseIDs []int) (map[int][]entity.CourseStaffMember, error) {
+    sql, args, err := r.Builder.
+        Select("ct.course_id", "ct.teacher_id", "COALESCE(u.name, '')", "COALESCE(u.surname, '')", "ct.staff_role").
+        From("course_teacher ct").
+        Join("employee e ON e.id = ct.teacher_id").
+        LeftJoin("users u ON u.account_id = e.user_id").
+        Where(squirrel.Eq{"ct.course_id": courseIDs}).
+        OrderBy("ct.course_id", "ct.staff_role DESC", "u.surname", "u.name").
+        ToSql()
+
+    if err != nil {
+        return nil, fmt.Errorf("PostgresRepo - ListCourseStaffByCourses - r.Builder: %w", err)
+    }
+
+    rows, err := r.Pool.Query(ctx, sql, args...)
+    if err != nil {
+        return nil, fmt.Errorf("PostgresRepo - ListCourseStaffByCourses - r.Pool.Query: %w", err)
+    }
+    defer rows.Close()
+
+    staff := make(map[int][]entity.CourseStaffMember, len(courseIDs))
+
+    for rows.Next() {
+        e := entity.CourseStaffMember{}
+        var role string
+
+        if err = rows.Scan(&e.CourseID, &e.EmployeeID, &e.Name, &e.Surname, &role); err != nil {
+            return nil, fmt.Errorf("PostgresRepo - ListCourseStaffByCourses - rows.Scan: %w", err)
+        }
+
+        e.StaffRole = entity.StaffRole(role)
+        staff[e.CourseID] = append(staff[e.CourseID], e)
+    }
+
+    if err = rows.Err(); err != nil {
+        return nil, fmt.Errorf("PostgresRepo - ListCourseStaffByCourses - rows.Err: %w", err)
+    }
+
+    return staff, nil
+}
+
+// AssignCourseStaff gives an employee a role in a course.
+func (r *PostgresRepo) AssignCourseStaff(ctx context.Context, member entity.CourseStaffMember) error {
+    sql, args, err := r.Builder.
+        Insert("course_teacher").
+        Columns("teacher_id", "course_id", "staff_role").
+        Values(member.EmployeeID, member.CourseID, string(member.StaffRole)).
+        ToSql()
+
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - AssignCourseStaff - r.Builder: %w", err)
+    }
+
+    if _, err = r.db(ctx).Exec(ctx, sql, args...); err != nil {
+        var pgErr *pgconn.PgError
+        if errors.As(err, &pgErr) {
+            switch pgErr.Code {
+            case _uniqueViolation:
+                return fmt.Errorf("PostgresRepo - AssignCourseStaff: %w", entity.ErrStaffAlreadyAssigned)
+            case _foreignKeyViolation:
+                return fmt.Errorf("PostgresRepo - AssignCourseStaff: %w", entity.ErrCourseNotFound)
+            }
+        }
+
+        return fmt.Errorf("PostgresRepo - AssignCourseStaff - r.db.Exec: %w", err)
+    }
+
+    return nil
+}
+
+// UnassignCourseStaff takes a role in a course away from an employee.
+func (r *PostgresRepo) UnassignCourseStaff(ctx context.Context, member entity.CourseStaffMember) error {
+    sql, args, err := r.Builder.
+        Delete("course_teacher").
+        Where(squirrel.Eq{
+            "teacher_id": member.EmployeeID,
+            "course_id":  member.CourseID,
+            "staff_role": string(member.StaffRole),
+        }).
+        ToSql()
+
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - UnassignCourseStaff - r.Builder: %w", err)
+    }
+
+    tag, err := r.db(ctx).Exec(ctx, sql, args...)
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - UnassignCourseStaff - r.db.Exec: %w", err)
+    }
+
+    if tag.RowsAffected() == 0 {
+        return fmt.Errorf("PostgresRepo - UnassignCourseStaff: %w", entity.ErrStaffNotAssigned)
+    }
+
+    return nil
+}
+
+func scanTeacherProfile(row pgx.Row) (entity.TeacherProfile, error) {
+    ent := entity.TeacherProfile{}
+
+    err := row.Scan(&ent.EmployeeID, &ent.WorkPlace, &ent.OverallExperience, &ent.SpecializationExperience,
+        &ent.PhotoURL, &ent.Bio, &ent.Name, &ent.Surname, &ent.UserID, &ent.AverageRating, &ent.ReviewsCount)
+    if err != nil {
+        if errors.Is(err, pgx.ErrNoRows) {
+            return entity.TeacherProfile{}, entity.ErrTeacherNotFound
+        }
+
+        return entity.TeacherProfile{}, err
+    }
+
+    return ent, nil
+}
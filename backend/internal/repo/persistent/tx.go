@@ -0,0 +1,59 @@
+package persistent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type txKey struct{}
+
+// querier - common part of pgxpool.Pool and pgx.Tx.
+type querier interface {
+    Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+    Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+    QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// WithinTransaction runs fn in a transaction, repo methods called with the context passed to fn
+// take part in it. Nested calls reuse the outer transaction.
+func (r *PostgresRepo) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+    if _, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+        return fn(ctx)
+    }
+
+    tx, err := r.Pool.Begin(ctx)
+    if err != nil {
+        return fmt.Errorf("PostgresRepo - WithinTransaction - r.Pool.Begin: %w", err)
+    }
+
+    defer func() {
+        if err != nil {
+            if rbErr := tx.Rollback(ctx); rbErr != nil && !errors.Is(rbErr, pgx.ErrTxClosed) {
+                err = errors.Join(err, fmt.Errorf("PostgresRepo - WithinTransaction - tx.Rollback: %w", rbErr))
+            }
+        }
+    }()
+
+    if err = fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+        return err
+    }
+
+    if err = tx.Commit(ctx); err != nil {
+        return fmt.Errorf("PostgresRepo - WithinTransaction - tx.Commit: %w", err)
+    }
+
+    return nil
+}
+
+// db returns the transaction started by WithinTransaction or the pool.
+func (r *PostgresRepo) db(ctx context.Context) querier {
+    if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+        return tx
+    }
+
+    return r.Pool
+}
@@ -0,0 +1,71 @@
+package persistent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/deadnotxaa/education-platform/backend/internal/entity"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const _uniqueViolation = "23505"
+
+// CreateUser inserts a new account, the password must already be hashed.
+func (r *PostgresRepo) CreateUser(ctx context.Context, user entity.User) (entity.User, error) {
+    builder := r.Builder.
+        Insert("users").
+        Columns("name", "surname", "email", "hashed_password", "phone_number")
+
+    if user.BirthDate != "" {
+        builder = builder.Columns("birthdate").
+            Values(user.Name, user.Surname, user.Email, user.HashedPassword, user.PhoneNumber, user.BirthDate)
+    } else {
+        builder = builder.Values(user.Name, user.Surname, user.Email, user.HashedPassword, user.PhoneNumber)
+    }
+
+    sql, args, err := builder.Suffix("RETURNING account_id").ToSql()
+    if err != nil {
+        return entity.User{}, fmt.Errorf("PostgresRepo - CreateUser - r.Builder: %w", err)
+    }
+
+    err = r.Pool.QueryRow(ctx, sql, args...).Scan(&user.AccountID)
+    if err != nil {
+        var pgErr *pgconn.PgError
+        if errors.As(err, &pgErr) && pgErr.Code == _uniqueViolation {
+            return entity.User{}, fmt.Errorf("PostgresRepo - CreateUser: %w", entity.ErrEmailTaken)
+        }
+
+        return entity.User{}, fmt.Errorf("PostgresRepo - CreateUser - row.Scan: %w", err)
+    }
+
+    return user, nil
+}
+
+// GetUserByEmail retrieves an account with its password hash for authentication.
+func (r *PostgresRepo) GetUserByEmail(ctx context.Context, email string) (entity.User, error) {
+    sql, args, err := r.Builder.
+        Select("account_id", "COALESCE(name, '')", "COALESCE(surname, '')", "email", "hashed_password").
+        From("users").
+        Where("email = ?", email).
+        ToSql()
+
+    if err != nil {
+        return entity.User{}, fmt.Errorf("PostgresRepo - GetUserByEmail - r.Builder: %w", err)
+    }
+
+    ent := entity.User{}
+    err = r.Pool.QueryRow(ctx, sql, args...).Scan(&ent.AccountID, &ent.Name, &ent.Surname, &ent.Email,
+        &ent.HashedPassword)
+
+    if err != nil {
+        if errors.Is(err, pgx.ErrNoRows) {
+            return entity.User{}, fmt.Errorf("PostgresRepo - GetUserByEmail: %w", entity.ErrUserNotFound)
+        }
+
+        return entity.User{}, fmt.Errorf("PostgresRepo - GetUserByEmail - row.Scan: %w", err)
+    }
+
+    return ent, nil
+}
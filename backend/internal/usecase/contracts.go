@@ -3,6 +3,7 @@ package usecase
 
 import (
     "context"
+    "time"
 
     "github.com/deadnotxaa/education-platform/backend/internal/entity"
 )
@@ -13,10 +14,258 @@ type (
         // GetCourseById retrieves a course by its ID.
         GetCourseById(ctx context.Context, courseID int) (entity.Course, error)
 
+        // GetCoursesByIDs retrieves the existing courses among the IDs keyed by ID.
+        GetCoursesByIDs(ctx context.Context, courseIDs []int) (map[int]entity.Course, error)
+
+        // CreateCourse adds a new course to the catalog.
+        CreateCourse(ctx context.Context, course entity.Course) (entity.Course, error)
+
+        // UpdateCourse changes an existing course.
+        UpdateCourse(ctx context.Context, course entity.Course) (entity.Course, error)
+
+        // DeleteCourse removes a course from the catalog.
+        DeleteCourse(ctx context.Context, courseID int) error
+
+        // ListCourses retrieves a filtered and sorted page of the course catalog.
+        ListCourses(ctx context.Context, filter entity.CourseFilter) (entity.CoursePage, error)
+
+        // Search performs a ranked full-text search across courses and blog posts.
+        Search(ctx context.Context, query entity.SearchQuery) ([]entity.SearchHit, error)
+
         // GetUserById retrieves some info about user by their ID.
         GetUserById(ctx context.Context, userID int) (entity.User, error)
 
         // GetTopCoursesReport retrieves a report of the top n courses.
         GetTopCoursesReport(ctx context.Context, limit uint32) ([]entity.TopCoursesReport, error)
+
+        // GetDetailedPurchaseReport retrieves the latest purchases filtered by date range and specialization.
+        GetDetailedPurchaseReport(ctx context.Context, filter entity.DetailedPurchaseReportFilter) ([]entity.DetailedPurchaseReport, error)
+
+        // Register creates a new account and logs it in.
+        Register(ctx context.Context, user entity.User, password string) (entity.TokenPair, error)
+
+        // Login checks the credentials and issues a new token pair.
+        Login(ctx context.Context, email, password string) (entity.TokenPair, error)
+
+        // RefreshTokens exchanges a refresh token for a new token pair, revoking the old refresh token.
+        RefreshTokens(ctx context.Context, refreshToken string) (entity.TokenPair, error)
+
+        // Logout revokes a refresh token.
+        Logout(ctx context.Context, refreshToken string) error
+
+        // ParseAccessToken validates an access token and returns its account ID.
+        ParseAccessToken(token string) (int, error)
+
+        // GetAccountPermissions retrieves permissions granted by the account's employee roles.
+        GetAccountPermissions(ctx context.Context, accountID int) ([]entity.Permission, error)
+
+        // HasPermission checks whether the account is allowed to perform an action.
+        HasPermission(ctx context.Context, accountID int, permission entity.Permission) (bool, error)
+
+        // PurchaseCourse reserves a seat in a course cohort and creates a pending purchase.
+        PurchaseCourse(ctx context.Context, accountID, calendarID, courseTypeID int) (entity.Purchase, error)
+
+        // CompletePurchase marks a pending purchase as completed.
+        CompletePurchase(ctx context.Context, purchaseID int) (entity.Purchase, error)
+
+        // CancelPurchase cancels a purchase and releases its seat.
+        CancelPurchase(ctx context.Context, accountID, purchaseID int) (entity.Purchase, error)
+
+        // CreatePayment creates a payment intent for a pending purchase.
+        CreatePayment(ctx context.Context, accountID, purchaseID int) (entity.PaymentIntent, error)
+
+        // ConfirmPayment captures the payment of a pending purchase.
+        ConfirmPayment(ctx context.Context, accountID, purchaseID int) error
+
+        // RefundPurchase refunds the payment of a completed purchase.
+        RefundPurchase(ctx context.Context, accountID, purchaseID int) error
+
+        // HandlePaymentWebhook verifies and applies a payment provider notification.
+        HandlePaymentWebhook(ctx context.Context, payload []byte, signature string) error
+
+        // CreateReview posts a review of a purchased course for moderation.
+        CreateReview(ctx context.Context, review entity.CourseReview) (entity.CourseReview, error)
+
+        // UpdateReview edits own review and sends it back to moderation.
+        UpdateReview(ctx context.Context, review entity.CourseReview) (entity.CourseReview, error)
+
+        // DeleteReview deletes own review or any review for moderators.
+        DeleteReview(ctx context.Context, accountID, reviewID int) error
+
+        // ListPendingReviews retrieves a page of the moderation queue.
+        ListPendingReviews(ctx context.Context, afterID int, limit uint32) (entity.ReviewPage, error)
+
+        // ListApprovedReviewsByCourses retrieves the latest approved reviews of several courses keyed by course ID.
+        ListApprovedReviewsByCourses(ctx context.Context, courseIDs []int, perCourse uint32) (map[int][]entity.CourseReview, error)
+
+        // ModerateReview approves or rejects a pending review.
+        ModerateReview(ctx context.Context, moderatorID, reviewID int, status entity.ReviewStatus) error
+
+        // IssueCertificate marks the course as completed by the student and issues the certificate,
+        // it is also issued automatically when the course reaches 100% progress.
+        IssueCertificate(ctx context.Context, userID, courseID int) (entity.Certificate, error)
+
+        // ListUserCertificates retrieves all certificates of the account.
+        ListUserCertificates(ctx context.Context, accountID int) ([]entity.Certificate, error)
+
+        // GetCertificatePDF renders a downloadable certificate.
+        GetCertificatePDF(ctx context.Context, accountID, certificateID int) ([]byte, error)
+
+        // VerifyCertificate confirms a certificate by its public verification code.
+        VerifyCertificate(ctx context.Context, code string) (entity.CertificateVerification, error)
+
+        // GetSyllabus retrieves the learning path of a course.
+        GetSyllabus(ctx context.Context, courseID int) (entity.Syllabus, error)
+
+        // CreateCourseTopic adds a topic to the end of the course.
+        CreateCourseTopic(ctx context.Context, courseID int, topic entity.CourseTopic) (entity.SyllabusTopic, error)
+
+        // UpdateCourseTopic edits a topic.
+        UpdateCourseTopic(ctx context.Context, topic entity.CourseTopic) (entity.CourseTopic, error)
+
+        // RemoveCourseTopic removes a topic from the course.
+        RemoveCourseTopic(ctx context.Context, courseID, topicID int) error
+
+        // ReorderCourseTopics changes the order of all topics of the course.
+        ReorderCourseTopics(ctx context.Context, courseID int, topicIDs []int) error
+
+        // GetCourseSyllabi retrieves the topics of several courses keyed by course ID.
+        GetCourseSyllabi(ctx context.Context, courseIDs []int) (map[int][]entity.SyllabusTopic, error)
+
+        // CreateProject adds a project to a topic.
+        CreateProject(ctx context.Context, project entity.Project) (entity.Project, error)
+
+        // UpdateProject edits a project or moves it to another topic.
+        UpdateProject(ctx context.Context, project entity.Project) (entity.Project, error)
+
+        // DeleteProject deletes a project.
+        DeleteProject(ctx context.Context, projectID int) error
+
+        // GetUserProgress retrieves own progress through purchased courses (or anyone's with user:read).
+        GetUserProgress(ctx context.Context, accountID, userID int) (entity.UserProgress, error)
+
+        // UpdateTopicProgress marks a topic of a purchased course as started or completed.
+        UpdateTopicProgress(ctx context.Context, userID, courseID, topicID int, status entity.TopicStatus) error
+
+        // SubmitProject submits a solution of a project of a purchased course for review.
+        SubmitProject(ctx context.Context, submission entity.ProjectSubmission) (entity.ProjectSubmission, error)
+
+        // ListPendingSubmissions retrieves a page of the project review queue.
+        ListPendingSubmissions(ctx context.Context, cursor string, limit uint32) (entity.SubmissionPage, error)
+
+        // GradeSubmission accepts or rejects a submitted project.
+        GradeSubmission(ctx context.Context, reviewerAccountID int, submission entity.ProjectSubmission) (entity.ProjectSubmission, error)
+
+        // CreateCourseCalendar schedules a new cohort of a course.
+        CreateCourseCalendar(ctx context.Context, calendar entity.CourseCalendar) (entity.CourseCalendar, error)
+
+        // UpdateCourseCalendar changes a cohort, added places are offered to the waitlist first.
+        UpdateCourseCalendar(ctx context.Context, calendar entity.CourseCalendar) (entity.CourseCalendar, error)
+
+        // ListUpcomingCourseCalendars retrieves cohorts of a course that haven't started yet.
+        ListUpcomingCourseCalendars(ctx context.Context, courseID int) ([]entity.CourseCalendar, error)
+
+        // ListUpcomingCourseCalendarsByCourses retrieves upcoming cohorts of several courses keyed by course ID.
+        ListUpcomingCourseCalendarsByCourses(ctx context.Context, courseIDs []int) (map[int][]entity.CourseCalendar, error)
+
+        // JoinWaitlist queues the user for a seat in a sold out cohort.
+        JoinWaitlist(ctx context.Context, userID, calendarID int) (entity.WaitlistEntry, error)
+
+        // LeaveWaitlist removes the user from the waitlist, a held seat goes to the next user.
+        LeaveWaitlist(ctx context.Context, userID, calendarID int) error
+
+        // ListMyWaitlist retrieves the active waitlist entries of the user.
+        ListMyWaitlist(ctx context.Context, userID int) ([]entity.WaitlistEntry, error)
+
+        // EnrollCareerStudent enrolls a graduate with a certificate into career support.
+        EnrollCareerStudent(ctx context.Context, student entity.CareerCenterStudent) (entity.CareerCenterStudent, error)
+
+        // UploadCV uploads or replaces the CV of the graduate for a course.
+        UploadCV(ctx context.Context, userID, courseID int, cvURL string) (entity.CareerCenterStudent, error)
+
+        // ListExpiringCareerSupport retrieves a page of students whose support ends within the number of days.
+        ListExpiringCareerSupport(ctx context.Context, days, afterID int, limit uint32) (entity.CareerStudentPage, error)
+
+        // ExtendCareerSupport adds days to the support period of a student.
+        ExtendCareerSupport(ctx context.Context, studentID, days int) (entity.CareerCenterStudent, error)
+
+        // ApplyForJob sends a job application of a graduate to a partner company.
+        ApplyForJob(ctx context.Context, userID, courseID, companyID int) (entity.JobApplication, error)
+
+        // ListMyJobApplications retrieves the job applications the user sent.
+        ListMyJobApplications(ctx context.Context, userID int) ([]entity.JobApplication, error)
+
+        // ChangeJobApplicationStatus moves a job application through the hiring pipeline.
+        ChangeJobApplicationStatus(ctx context.Context, actorID, applicationID int, status entity.JobApplicationStatus,
+            comment string) (entity.JobApplication, error)
+
+        // GetJobApplicationHistory retrieves the status changes of own application (or any with career:manage).
+        GetJobApplicationHistory(ctx context.Context, accountID, applicationID int) ([]entity.JobApplicationTransition, error)
+
+        // GetPartnerCompany -.
+        GetPartnerCompany(ctx context.Context, companyID int) (entity.PartnerCompany, error)
+
+        // ListPartnerCompanies retrieves a page of partner companies.
+        ListPartnerCompanies(ctx context.Context, afterID int, limit uint32) (entity.PartnerCompanyPage, error)
+
+        // CreatePartnerCompany -.
+        CreatePartnerCompany(ctx context.Context, company entity.PartnerCompany) (entity.PartnerCompany, error)
+
+        // UpdatePartnerCompany edits a partner company.
+        UpdatePartnerCompany(ctx context.Context, company entity.PartnerCompany) (entity.PartnerCompany, error)
+
+        // DeletePartnerCompany deletes a partner company without job applications.
+        DeletePartnerCompany(ctx context.Context, companyID int) error
+
+        // MatchStudents ranks career center students by how well they fit the requirements of a company.
+        MatchStudents(ctx context.Context, companyID int, limit uint32) (entity.CompanyMatches, error)
+
+        // CreateBlogPost creates a draft signed by the employee record of the writer.
+        CreateBlogPost(ctx context.Context, accountID int, post entity.BlogPost) (entity.BlogPost, error)
+
+        // UpdateBlogPost edits a post and replaces its tags, the reading time is recomputed.
+        UpdateBlogPost(ctx context.Context, post entity.BlogPost) (entity.BlogPost, error)
+
+        // ScheduleBlogPost schedules an unpublished post for publication at a future date.
+        ScheduleBlogPost(ctx context.Context, postID int, publicationDate time.Time) (entity.BlogPost, error)
+
+        // PublishBlogPost publishes an unpublished post right away.
+        PublishBlogPost(ctx context.Context, postID int) (entity.BlogPost, error)
+
+        // GetBlogPost returns a published post.
+        GetBlogPost(ctx context.Context, postID int) (entity.BlogPost, error)
+
+        // ListBlogPosts returns a page of published posts filtered by tag and topic.
+        ListBlogPosts(ctx context.Context, filter entity.BlogFilter) (entity.BlogPostPage, error)
+
+        // ListUnpublishedBlogPosts returns drafts and scheduled posts.
+        ListUnpublishedBlogPosts(ctx context.Context) ([]entity.BlogPost, error)
+
+        // ListTeachers returns a page of teacher profiles.
+        ListTeachers(ctx context.Context, afterID int, limit uint32) (entity.TeacherPage, error)
+
+        // GetTeacherProfile returns a teacher profile with the courses the teacher works on.
+        GetTeacherProfile(ctx context.Context, employeeID int) (entity.TeacherProfile, error)
+
+        // GetTeacherProfilesByIDs retrieves the teachers among the employees keyed by employee ID, without the courses.
+        GetTeacherProfilesByIDs(ctx context.Context, employeeIDs []int) (map[int]entity.TeacherProfile, error)
+
+        // ListEmployeeCoursesByEmployees retrieves the courses of several employees keyed by employee ID.
+        ListEmployeeCoursesByEmployees(ctx context.Context, employeeIDs []int) (map[int][]entity.TeacherCourse, error)
+
+        // UpdateTeacherProfile creates or edits a teacher profile, own profile or any with staff:manage.
+        UpdateTeacherProfile(ctx context.Context, accountID int, teacher entity.Teacher) (entity.TeacherProfile, error)
+
+        // ListCourseStaff returns the teachers, mentors and reviewers of a course.
+        ListCourseStaff(ctx context.Context, courseID int) ([]entity.CourseStaffMember, error)
+
+        // ListCourseStaffByCourses retrieves the staff of several courses keyed by course ID.
+        ListCourseStaffByCourses(ctx context.Context, courseIDs []int) (map[int][]entity.CourseStaffMember, error)
+
+        // AssignCourseStaff gives an employee a course role matching the employee's own role.
+        AssignCourseStaff(ctx context.Context, member entity.CourseStaffMember) error
+
+        // UnassignCourseStaff -.
+        UnassignCourseStaff(ctx context.Context, member entity.CourseStaffMember) error
     }
 )
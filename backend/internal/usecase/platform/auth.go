@@ -0,0 +1,195 @@
+package platform
+
+import (
+    "context"
+    "crypto/rand"
+    "encoding/hex"
+    "errors"
+    "fmt"
+    "strconv"
+    "time"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+    "github.com/golang-jwt/jwt/v5"
+    "golang.org/x/crypto/bcrypt"
+)
+
+const (
+    _accessTokenType  = "access"
+    _refreshTokenType = "refresh"
+)
+
+// Compared against when the email is unknown, so both branches of Login take the same time
+var _dummyPasswordHash = []byte("$2a$10$5PIR2G.jQpFbKYaHtxCrEumjWUjAWW2JrMp6G05EPzpPf2vva0sNm")
+
+// tokenClaims - claims of both access and refresh tokens, Subject holds the account ID.
+type tokenClaims struct {
+    jwt.RegisteredClaims
+    Type string `json:"typ"`
+}
+
+func (us *UseCase) Register(ctx context.Context, user entity.User, password string) (entity.TokenPair, error) {
+    hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+    if err != nil {
+        return entity.TokenPair{}, fmt.Errorf("platform - Register - bcrypt.GenerateFromPassword: %w", err)
+    }
+
+    user.HashedPassword = string(hash)
+
+    created, err := us.postgresRepo.CreateUser(ctx, user)
+    if err != nil {
+        return entity.TokenPair{}, fmt.Errorf("platform - Register - postgresRepo.CreateUser: %w", err)
+    }
+
+    // The new ID may have been cached as missing. The account is already committed,
+    // so a failure here is only logged, the marker expires with its TTL
+    if err = us.redisRepo.DeleteUser(ctx, created.AccountID); err != nil {
+        us.l.Error(fmt.Errorf("platform - Register - redisRepo.DeleteUser: %w", err))
+    }
+
+    tokens, err := us.issueTokens(ctx, created.AccountID)
+    if err != nil {
+        return entity.TokenPair{}, fmt.Errorf("platform - Register - us.issueTokens: %w", err)
+    }
+
+    return tokens, nil
+}
+
+func (us *UseCase) Login(ctx context.Context, email, password string) (entity.TokenPair, error) {
+    user, err := us.postgresRepo.GetUserByEmail(ctx, email)
+    if err != nil {
+        if errors.Is(err, entity.ErrUserNotFound) {
+            _ = bcrypt.CompareHashAndPassword(_dummyPasswordHash, []byte(password))
+
+            return entity.TokenPair{}, fmt.Errorf("platform - Login: %w", entity.ErrInvalidCredentials)
+        }
+
+        return entity.TokenPair{}, fmt.Errorf("platform - Login - postgresRepo.GetUserByEmail: %w", err)
+    }
+
+    if err = bcrypt.CompareHashAndPassword([]byte(user.HashedPassword), []byte(password)); err != nil {
+        return entity.TokenPair{}, fmt.Errorf("platform - Login: %w", entity.ErrInvalidCredentials)
+    }
+
+    tokens, err := us.issueTokens(ctx, user.AccountID)
+    if err != nil {
+        return entity.TokenPair{}, fmt.Errorf("platform - Login - us.issueTokens: %w", err)
+    }
+
+    return tokens, nil
+}
+
+// RefreshTokens rotates a refresh token: the old one is revoked and a new pair is issued.
+func (us *UseCase) RefreshTokens(ctx context.Context, refreshToken string) (entity.TokenPair, error) {
+    claims, err := us.parseToken(refreshToken, _refreshTokenType)
+    if err != nil {
+        return entity.TokenPair{}, fmt.Errorf("platform - RefreshTokens - us.parseToken: %w", err)
+    }
+
+    accountID, err := us.redisRepo.ConsumeRefreshToken(ctx, claims.ID)
+    if err != nil {
+        return entity.TokenPair{}, fmt.Errorf("platform - RefreshTokens - redisRepo.ConsumeRefreshToken: %w", err)
+    }
+
+    if strconv.Itoa(accountID) != claims.Subject {
+        return entity.TokenPair{}, fmt.Errorf("platform - RefreshTokens: %w", entity.ErrInvalidToken)
+    }
+
+    tokens, err := us.issueTokens(ctx, accountID)
+    if err != nil {
+        return entity.TokenPair{}, fmt.Errorf("platform - RefreshTokens - us.issueTokens: %w", err)
+    }
+
+    return tokens, nil
+}
+
+func (us *UseCase) Logout(ctx context.Context, refreshToken string) error {
+    claims, err := us.parseToken(refreshToken, _refreshTokenType)
+    if err != nil {
+        return fmt.Errorf("platform - Logout - us.parseToken: %w", err)
+    }
+
+    if err = us.redisRepo.DeleteRefreshToken(ctx, claims.ID); err != nil {
+        return fmt.Errorf("platform - Logout - redisRepo.DeleteRefreshToken: %w", err)
+    }
+
+    return nil
+}
+
+// ParseAccessToken validates an access token and returns the account ID it was issued for.
+func (us *UseCase) ParseAccessToken(token string) (int, error) {
+    claims, err := us.parseToken(token, _accessTokenType)
+    if err != nil {
+        return 0, fmt.Errorf("platform - ParseAccessToken - us.parseToken: %w", err)
+    }
+
+    accountID, err := strconv.Atoi(claims.Subject)
+    if err != nil {
+        return 0, fmt.Errorf("platform - ParseAccessToken: %w", entity.ErrInvalidToken)
+    }
+
+    return accountID, nil
+}
+
+func (us *UseCase) issueTokens(ctx context.Context, accountID int) (entity.TokenPair, error) {
+    now := time.Now()
+
+    accessToken, _, err := us.signToken(accountID, _accessTokenType, now, us.accessTokenTTL)
+    if err != nil {
+        return entity.TokenPair{}, fmt.Errorf("us.signToken: %w", err)
+    }
+
+    refreshToken, refreshID, err := us.signToken(accountID, _refreshTokenType, now, us.refreshTokenTTL)
+    if err != nil {
+        return entity.TokenPair{}, fmt.Errorf("us.signToken: %w", err)
+    }
+
+    if err = us.redisRepo.SaveRefreshToken(ctx, refreshID, accountID, us.refreshTokenTTL); err != nil {
+        return entity.TokenPair{}, fmt.Errorf("redisRepo.SaveRefreshToken: %w", err)
+    }
+
+    return entity.TokenPair{
+        AccessToken:  accessToken,
+        RefreshToken: refreshToken,
+        ExpiresIn:    int64(us.accessTokenTTL.Seconds()),
+    }, nil
+}
+
+// signToken returns a signed JWT together with its unique ID.
+func (us *UseCase) signToken(accountID int, tokenType string, now time.Time, ttl time.Duration) (string, string, error) {
+    id := make([]byte, 16)
+    if _, err := rand.Read(id); err != nil {
+        return "", "", fmt.Errorf("rand.Read: %w", err)
+    }
+
+    claims := tokenClaims{
+        RegisteredClaims: jwt.RegisteredClaims{
+            ID:        hex.EncodeToString(id),
+            Subject:   strconv.Itoa(accountID),
+            IssuedAt:  jwt.NewNumericDate(now),
+            ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+        },
+        Type: tokenType,
+    }
+
+    signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(us.jwtSecret)
+    if err != nil {
+        return "", "", fmt.Errorf("jwt.SignedString: %w", err)
+    }
+
+    return signed, claims.ID, nil
+}
+
+func (us *UseCase) parseToken(token string, tokenType string) (*tokenClaims, error) {
+    claims := &tokenClaims{}
+
+    _, err := jwt.ParseWithClaims(token, claims, func(*jwt.Token) (interface{}, error) {
+        return us.jwtSecret, nil
+    }, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithExpirationRequired())
+
+    if err != nil || claims.Type != tokenType {
+        return nil, entity.ErrInvalidToken
+    }
+
+    return claims, nil
+}
@@ -0,0 +1,214 @@
+package platform
+
+import (
+    "context"
+    "fmt"
+    "strings"
+    "time"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+)
+
+const (
+    _defaultBlogPageSize = 20
+    _maxBlogPageSize     = 100
+
+    _readingWordsPerMinute = 200
+)
+
+// CreateBlogPost creates a draft, the author is the employee record of the writer.
+func (us *UseCase) CreateBlogPost(ctx context.Context, accountID int, post entity.BlogPost) (entity.BlogPost, error) {
+    var postID int
+
+    err := us.postgresRepo.WithinTransaction(ctx, func(ctx context.Context) error {
+        authorID, err := us.postgresRepo.GetEmployeeWithPermission(ctx, accountID, entity.PermissionBlogWrite)
+        if err != nil {
+            return fmt.Errorf("postgresRepo.GetEmployeeWithPermission: %w", err)
+        }
+
+        post.AuthorID = authorID
+        post.ReadingTimeMinutes = readingTime(post.Content)
+
+        postID, err = us.postgresRepo.CreateBlogPost(ctx, post)
+        if err != nil {
+            return fmt.Errorf("postgresRepo.CreateBlogPost: %w", err)
+        }
+
+        if err = us.postgresRepo.SetBlogPostTags(ctx, postID, normalizeTags(post.Tags)); err != nil {
+            return fmt.Errorf("postgresRepo.SetBlogPostTags: %w", err)
+        }
+
+        return nil
+    })
+    if err != nil {
+        return entity.BlogPost{}, fmt.Errorf("platform - CreateBlogPost - postgresRepo.WithinTransaction: %w", err)
+    }
+
+    created, err := us.postgresRepo.GetBlogPost(ctx, postID)
+    if err != nil {
+        return entity.BlogPost{}, fmt.Errorf("platform - CreateBlogPost - postgresRepo.GetBlogPost: %w", err)
+    }
+
+    return created, nil
+}
+
+// UpdateBlogPost edits a post in any status and replaces its tags.
+func (us *UseCase) UpdateBlogPost(ctx context.Context, post entity.BlogPost) (entity.BlogPost, error) {
+    post.ReadingTimeMinutes = readingTime(post.Content)
+
+    err := us.postgresRepo.WithinTransaction(ctx, func(ctx context.Context) error {
+        if err := us.postgresRepo.UpdateBlogPost(ctx, post); err != nil {
+            return fmt.Errorf("postgresRepo.UpdateBlogPost: %w", err)
+        }
+
+        if err := us.postgresRepo.SetBlogPostTags(ctx, post.PostID, normalizeTags(post.Tags)); err != nil {
+            return fmt.Errorf("postgresRepo.SetBlogPostTags: %w", err)
+        }
+
+        return nil
+    })
+    if err != nil {
+        return entity.BlogPost{}, fmt.Errorf("platform - UpdateBlogPost - postgresRepo.WithinTransaction: %w", err)
+    }
+
+    updated, err := us.postgresRepo.GetBlogPost(ctx, post.PostID)
+    if err != nil {
+        return entity.BlogPost{}, fmt.Errorf("platform - UpdateBlogPost - postgresRepo.GetBlogPost: %w", err)
+    }
+
+    return updated, nil
+}
+
+// ScheduleBlogPost schedules a draft (or reschedules a scheduled post), it is published by PublishScheduledBlogPosts.
+func (us *UseCase) ScheduleBlogPost(ctx context.Context, postID int, publicationDate time.Time) (entity.BlogPost, error) {
+    if !publicationDate.After(time.Now()) {
+        return entity.BlogPost{}, fmt.Errorf("platform - ScheduleBlogPost: %w", entity.ErrPublicationDateInPast)
+    }
+
+    err := us.setUnpublishedPostStatus(ctx, postID, entity.BlogPostStatusScheduled, publicationDate)
+    if err != nil {
+        return entity.BlogPost{}, fmt.Errorf("platform - ScheduleBlogPost - us.setUnpublishedPostStatus: %w", err)
+    }
+
+    scheduled, err := us.postgresRepo.GetBlogPost(ctx, postID)
+    if err != nil {
+        return entity.BlogPost{}, fmt.Errorf("platform - ScheduleBlogPost - postgresRepo.GetBlogPost: %w", err)
+    }
+
+    return scheduled, nil
+}
+
+// PublishBlogPost publishes a draft or a scheduled post right away.
+func (us *UseCase) PublishBlogPost(ctx context.Context, postID int) (entity.BlogPost, error) {
+    err := us.setUnpublishedPostStatus(ctx, postID, entity.BlogPostStatusPublished, time.Now())
+    if err != nil {
+        return entity.BlogPost{}, fmt.Errorf("platform - PublishBlogPost - us.setUnpublishedPostStatus: %w", err)
+    }
+
+    published, err := us.postgresRepo.GetBlogPost(ctx, postID)
+    if err != nil {
+        return entity.BlogPost{}, fmt.Errorf("platform - PublishBlogPost - postgresRepo.GetBlogPost: %w", err)
+    }
+
+    return published, nil
+}
+
+// GetBlogPost returns a published post, drafts and scheduled posts are reported as missing.
+func (us *UseCase) GetBlogPost(ctx context.Context, postID int) (entity.BlogPost, error) {
+    post, err := us.postgresRepo.GetBlogPost(ctx, postID)
+    if err != nil {
+        return entity.BlogPost{}, fmt.Errorf("platform - GetBlogPost - postgresRepo.GetBlogPost: %w", err)
+    }
+
+    if post.Status != entity.BlogPostStatusPublished {
+        return entity.BlogPost{}, fmt.Errorf("platform - GetBlogPost: %w", entity.ErrBlogPostNotFound)
+    }
+
+    return post, nil
+}
+
+// ListBlogPosts returns a page of published posts, newest first.
+func (us *UseCase) ListBlogPosts(ctx context.Context, filter entity.BlogFilter) (entity.BlogPostPage, error) {
+    if filter.Limit == 0 {
+        filter.Limit = _defaultBlogPageSize
+    }
+    if filter.Limit > _maxBlogPageSize {
+        filter.Limit = _maxBlogPageSize
+    }
+
+    page, err := us.postgresRepo.ListBlogPosts(ctx, filter)
+    if err != nil {
+        return entity.BlogPostPage{}, fmt.Errorf("platform - ListBlogPosts - postgresRepo.ListBlogPosts: %w", err)
+    }
+
+    return page, nil
+}
+
+// ListUnpublishedBlogPosts -.
+func (us *UseCase) ListUnpublishedBlogPosts(ctx context.Context) ([]entity.BlogPost, error) {
+    posts, err := us.postgresRepo.ListUnpublishedBlogPosts(ctx)
+    if err != nil {
+        return nil, fmt.Errorf("platform - ListUnpublishedBlogPosts - postgresRepo.ListUnpublishedBlogPosts: %w", err)
+    }
+
+    return posts, nil
+}
+
+// PublishScheduledBlogPosts publishes scheduled posts whose publication date has come,
+// it is run periodically and returns the number of published posts.
+func (us *UseCase) PublishScheduledBlogPosts(ctx context.Context) (int, error) {
+    count, err := us.postgresRepo.PublishDueBlogPosts(ctx, time.Now())
+    if err != nil {
+        return 0, fmt.Errorf("platform - PublishScheduledBlogPosts - postgresRepo.PublishDueBlogPosts: %w", err)
+    }
+
+    return int(count), nil
+}
+
+func (us *UseCase) setUnpublishedPostStatus(ctx context.Context, postID int, status entity.BlogPostStatus,
+    publicationDate time.Time) error {
+    return us.postgresRepo.WithinTransaction(ctx, func(ctx context.Context) error {
+        current, err := us.postgresRepo.GetBlogPostStatusForUpdate(ctx, postID)
+        if err != nil {
+            return fmt.Errorf("postgresRepo.GetBlogPostStatusForUpdate: %w", err)
+        }
+
+        if current == entity.BlogPostStatusPublished {
+            return entity.ErrBlogPostPublished
+        }
+
+        if err = us.postgresRepo.SetBlogPostStatus(ctx, postID, status, publicationDate); err != nil {
+            return fmt.Errorf("postgresRepo.SetBlogPostStatus: %w", err)
+        }
+
+        return nil
+    })
+}
+
+// readingTime estimates the reading time in whole minutes, a non-empty post takes at least a minute.
+func readingTime(content string) int {
+    words := len(strings.Fields(content))
+    if words == 0 {
+        return 0
+    }
+
+    return (words + _readingWordsPerMinute - 1) / _readingWordsPerMinute
+}
+
+// normalizeTags trims tags and drops empty and repeated ones.
+func normalizeTags(tags []string) []string {
+    seen := make(map[string]bool, len(tags))
+    normalized := make([]string, 0, len(tags))
+
+    for _, tag := range tags {
+        tag = strings.TrimSpace(tag)
+        if tag == "" || seen[tag] {
+            continue
+        }
+
+        seen[tag] = true
+        normalized = append(normalized, tag)
+    }
+
+    return normalized
+}
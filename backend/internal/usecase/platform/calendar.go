@@ -0,0 +1,295 @@
+package platform
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "time"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+)
+
+// _expiredOffersBatchSize bounds the work of a single ExpireWaitlistOffers run, the rest waits for the next one.
+const _expiredOffersBatchSize = 100
+
+// CreateCourseCalendar schedules a new cohort of a course.
+func (us *UseCase) CreateCourseCalendar(ctx context.Context, calendar entity.CourseCalendar) (entity.CourseCalendar, error) {
+    // Dates are validated as YYYY-MM-DD, so they compare as strings
+    if calendar.EndSalesDate > calendar.StartDate {
+        return entity.CourseCalendar{}, fmt.Errorf("platform - CreateCourseCalendar: %w", entity.ErrInvalidCalendarDates)
+    }
+
+    created, err := us.postgresRepo.CreateCourseCalendar(ctx, calendar)
+    if err != nil {
+        return entity.CourseCalendar{}, fmt.Errorf("platform - CreateCourseCalendar - postgresRepo.CreateCourseCalendar: %w", err)
+    }
+
+    return created, nil
+}
+
+// UpdateCourseCalendar changes a cohort, free places are offered to the waitlist before they go on sale.
+func (us *UseCase) UpdateCourseCalendar(ctx context.Context, calendar entity.CourseCalendar) (entity.CourseCalendar, error) {
+    if calendar.EndSalesDate > calendar.StartDate {
+        return entity.CourseCalendar{}, fmt.Errorf("platform - UpdateCourseCalendar: %w", entity.ErrInvalidCalendarDates)
+    }
+
+    var updated entity.CourseCalendar
+
+    err := us.postgresRepo.WithinTransaction(ctx, func(ctx context.Context) error {
+        current, err := us.postgresRepo.GetCourseCalendarForUpdate(ctx, calendar.ID)
+        if err != nil {
+            return fmt.Errorf("postgresRepo.GetCourseCalendarForUpdate: %w", err)
+        }
+
+        calendar.CourseID = current.CourseID
+
+        updated, err = us.postgresRepo.UpdateCourseCalendar(ctx, calendar)
+        if err != nil {
+            return fmt.Errorf("postgresRepo.UpdateCourseCalendar: %w", err)
+        }
+
+        offered, err := us.offerSeats(ctx, updated.ID, updated.RemainingPlaces)
+        if err != nil {
+            return fmt.Errorf("us.offerSeats: %w", err)
+        }
+
+        if offered > 0 {
+            if err = us.postgresRepo.AdjustRemainingPlaces(ctx, updated.ID, -offered); err != nil {
+                return fmt.Errorf("postgresRepo.AdjustRemainingPlaces: %w", err)
+            }
+
+            updated.RemainingPlaces -= offered
+        }
+
+        return nil
+    })
+    if err != nil {
+        return entity.CourseCalendar{}, fmt.Errorf("platform - UpdateCourseCalendar - postgresRepo.WithinTransaction: %w", err)
+    }
+
+    return updated, nil
+}
+
+// ListUpcomingCourseCalendars returns cohorts of a course that haven't started yet, soonest first.
+func (us *UseCase) ListUpcomingCourseCalendars(ctx context.Context, courseID int) ([]entity.CourseCalendar, error) {
+    if _, err := us.GetCourseById(ctx, courseID); err != nil {
+        return nil, fmt.Errorf("platform - ListUpcomingCourseCalendars - us.GetCourseById: %w", err)
+    }
+
+    calendars, err := us.postgresRepo.ListUpcomingCourseCalendars(ctx, courseID, time.Now())
+    if err != nil {
+        return nil, fmt.Errorf("platform - ListUpcomingCourseCalendars - postgresRepo.ListUpcomingCourseCalendars: %w", err)
+    }
+
+    return calendars, nil
+}
+
+// ListUpcomingCourseCalendarsByCourses -.
+func (us *UseCase) ListUpcomingCourseCalendarsByCourses(ctx context.Context, courseIDs []int) (map[int][]entity.CourseCalendar, error) {
+    calendars, err := us.postgresRepo.ListUpcomingCourseCalendarsByCourses(ctx, courseIDs, time.Now())
+    if err != nil {
+        return nil, fmt.Errorf("platform - ListUpcomingCourseCalendarsByCourses - postgresRepo.ListUpcomingCourseCalendarsByCourses: %w", err)
+    }
+
+    return calendars, nil
+}
+
+// JoinWaitlist queues the user for a seat in a sold out cohort.
+func (us *UseCase) JoinWaitlist(ctx context.Context, userID, calendarID int) (entity.WaitlistEntry, error) {
+    var entry entity.WaitlistEntry
+
+    err := us.postgresRepo.WithinTransaction(ctx, func(ctx context.Context) error {
+        calendar, err := us.postgresRepo.GetCourseCalendarForUpdate(ctx, calendarID)
+        if err != nil {
+            return fmt.Errorf("postgresRepo.GetCourseCalendarForUpdate: %w", err)
+        }
+
+        closed, err := salesClosed(calendar)
+        if err != nil {
+            return fmt.Errorf("salesClosed: %w", err)
+        }
+
+        if closed {
+            return entity.ErrSalesClosed
+        }
+
+        if calendar.RemainingPlaces > 0 {
+            return entity.ErrPlacesAvailable
+        }
+
+        entry, err = us.postgresRepo.CreateWaitlistEntry(ctx, calendarID, userID)
+        if err != nil {
+            return fmt.Errorf("postgresRepo.CreateWaitlistEntry: %w", err)
+        }
+
+        return nil
+    })
+    if err != nil {
+        return entity.WaitlistEntry{}, fmt.Errorf("platform - JoinWaitlist - postgresRepo.WithinTransaction: %w", err)
+    }
+
+    return entry, nil
+}
+
+// LeaveWaitlist removes the user from the waitlist, a seat held for the user goes to the next one.
+func (us *UseCase) LeaveWaitlist(ctx context.Context, userID, calendarID int) error {
+    err := us.postgresRepo.WithinTransaction(ctx, func(ctx context.Context) error {
+        if _, err := us.postgresRepo.GetCourseCalendarForUpdate(ctx, calendarID); err != nil {
+            return fmt.Errorf("postgresRepo.GetCourseCalendarForUpdate: %w", err)
+        }
+
+        entry, err := us.postgresRepo.GetActiveWaitlistEntryForUpdate(ctx, calendarID, userID)
+        if err != nil {
+            return fmt.Errorf("postgresRepo.GetActiveWaitlistEntryForUpdate: %w", err)
+        }
+
+        if err = us.postgresRepo.SetWaitlistEntryStatus(ctx, entry.EntryID, entity.WaitlistStatusLeft); err != nil {
+            return fmt.Errorf("postgresRepo.SetWaitlistEntryStatus: %w", err)
+        }
+
+        if entry.Status == entity.WaitlistStatusOffered {
+            if err = us.releaseSeat(ctx, calendarID); err != nil {
+                return fmt.Errorf("us.releaseSeat: %w", err)
+            }
+        }
+
+        return nil
+    })
+    if err != nil {
+        return fmt.Errorf("platform - LeaveWaitlist - postgresRepo.WithinTransaction: %w", err)
+    }
+
+    return nil
+}
+
+// ListMyWaitlist returns the cohorts the user waits for, with the place in the queue or the held seat.
+func (us *UseCase) ListMyWaitlist(ctx context.Context, userID int) ([]entity.WaitlistEntry, error) {
+    entries, err := us.postgresRepo.ListUserWaitlistEntries(ctx, userID)
+    if err != nil {
+        return nil, fmt.Errorf("platform - ListMyWaitlist - postgresRepo.ListUserWaitlistEntries: %w", err)
+    }
+
+    return entries, nil
+}
+
+// ExpireWaitlistOffers releases seats held longer than the hold TTL to the next waiting users,
+// it is run periodically and returns the number of expired offers.
+func (us *UseCase) ExpireWaitlistOffers(ctx context.Context) (int, error) {
+    offers, err := us.postgresRepo.ListExpiredWaitlistOffers(ctx, time.Now(), _expiredOffersBatchSize)
+    if err != nil {
+        return 0, fmt.Errorf("platform - ExpireWaitlistOffers - postgresRepo.ListExpiredWaitlistOffers: %w", err)
+    }
+
+    count := 0
+
+    for _, offer := range offers {
+        expired := false
+
+        // The offer may have been claimed or left since it was listed, so it is checked again under the lock
+        err = us.postgresRepo.WithinTransaction(ctx, func(ctx context.Context) error {
+            if _, err := us.postgresRepo.GetCourseCalendarForUpdate(ctx, offer.CalendarID); err != nil {
+                return fmt.Errorf("postgresRepo.GetCourseCalendarForUpdate: %w", err)
+            }
+
+            entry, err := us.postgresRepo.GetWaitlistEntryForUpdate(ctx, offer.EntryID)
+            if err != nil {
+                return fmt.Errorf("postgresRepo.GetWaitlistEntryForUpdate: %w", err)
+            }
+
+            held, err := holdsSeat(entry)
+            if err != nil {
+                return fmt.Errorf("holdsSeat: %w", err)
+            }
+
+            if entry.Status != entity.WaitlistStatusOffered || held {
+                return nil
+            }
+
+            if err = us.postgresRepo.SetWaitlistEntryStatus(ctx, entry.EntryID, entity.WaitlistStatusExpired); err != nil {
+                return fmt.Errorf("postgresRepo.SetWaitlistEntryStatus: %w", err)
+            }
+
+            if err = us.releaseSeat(ctx, entry.CalendarID); err != nil {
+                return fmt.Errorf("us.releaseSeat: %w", err)
+            }
+
+            expired = true
+
+            return nil
+        })
+        if err != nil {
+            return count, fmt.Errorf("platform - ExpireWaitlistOffers - postgresRepo.WithinTransaction: %w", err)
+        }
+
+        if expired {
+            count++
+        }
+    }
+
+    return count, nil
+}
+
+// releaseSeat gives a freed seat of a locked cohort to the next waiting user, or puts it back on sale.
+func (us *UseCase) releaseSeat(ctx context.Context, calendarID int) error {
+    offered, err := us.offerSeats(ctx, calendarID, 1)
+    if err != nil {
+        return fmt.Errorf("us.offerSeats: %w", err)
+    }
+
+    if offered > 0 {
+        return nil
+    }
+
+    if err = us.postgresRepo.AdjustRemainingPlaces(ctx, calendarID, 1); err != nil {
+        return fmt.Errorf("postgresRepo.AdjustRemainingPlaces: %w", err)
+    }
+
+    return nil
+}
+
+// offerSeats holds up to n seats of a locked cohort for waiting users in FIFO order and returns how many were held.
+func (us *UseCase) offerSeats(ctx context.Context, calendarID, n int) (int, error) {
+    expiresAt := time.Now().Add(us.waitlistHoldTTL)
+
+    for offered := 0; offered < n; offered++ {
+        _, err := us.postgresRepo.OfferSeatToNextWaiting(ctx, calendarID, expiresAt)
+        if errors.Is(err, entity.ErrWaitlistEntryNotFound) {
+            return offered, nil
+        }
+
+        if err != nil {
+            return offered, fmt.Errorf("postgresRepo.OfferSeatToNextWaiting: %w", err)
+        }
+    }
+
+    return n, nil
+}
+
+// holdsSeat tells whether a seat is held for the waitlist entry right now.
+func holdsSeat(entry entity.WaitlistEntry) (bool, error) {
+    if entry.Status != entity.WaitlistStatusOffered {
+        return false, nil
+    }
+
+    expiresAt, err := time.Parse(time.RFC3339, entry.OfferExpiresAt)
+    if err != nil {
+        return false, fmt.Errorf("time.Parse: %w", err)
+    }
+
+    return time.Now().Before(expiresAt), nil
+}
+
+// salesClosed tells whether the end of sales date of the cohort has passed.
+func salesClosed(calendar entity.CourseCalendar) (bool, error) {
+    if calendar.EndSalesDate == "" {
+        return false, nil
+    }
+
+    endSales, err := time.Parse(time.DateOnly, calendar.EndSalesDate)
+    if err != nil {
+        return false, fmt.Errorf("time.Parse: %w", err)
+    }
+
+    // Sales are open for the whole end_sales_date day
+    return !time.Now().Before(endSales.AddDate(0, 0, 1)), nil
+}
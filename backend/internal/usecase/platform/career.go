@@ -0,0 +1,84 @@
+package platform
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "time"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+)
+
+const (
+    _defaultExpiringSupportDays = 30
+
+    _defaultCareerStudentPageSize = 20
+    _maxCareerStudentPageSize     = 100
+)
+
+// EnrollCareerStudent enrolls a graduate into career support, a certificate for the course is required.
+// Support starts today unless a start date is given.
+func (us *UseCase) EnrollCareerStudent(ctx context.Context, student entity.CareerCenterStudent) (entity.CareerCenterStudent, error) {
+    _, err := us.postgresRepo.GetUserCourseCertificate(ctx, student.UserID, student.CourseID)
+    if err != nil {
+        if errors.Is(err, entity.ErrCertificateNotFound) {
+            return entity.CareerCenterStudent{}, fmt.Errorf("platform - EnrollCareerStudent: %w", entity.ErrCertificateRequired)
+        }
+
+        return entity.CareerCenterStudent{}, fmt.Errorf("platform - EnrollCareerStudent - postgresRepo.GetUserCourseCertificate: %w", err)
+    }
+
+    if student.CareerSupportStart == "" {
+        student.CareerSupportStart = time.Now().Format(time.DateOnly)
+    }
+
+    enrolled, err := us.postgresRepo.CreateCareerStudent(ctx, student)
+    if err != nil {
+        return entity.CareerCenterStudent{}, fmt.Errorf("platform - EnrollCareerStudent - postgresRepo.CreateCareerStudent: %w", err)
+    }
+
+    return enrolled, nil
+}
+
+// UploadCV uploads or replaces the CV of the graduate enrolled into career support for the course.
+func (us *UseCase) UploadCV(ctx context.Context, userID, courseID int, cvURL string) (entity.CareerCenterStudent, error) {
+    student, err := us.postgresRepo.UpdateCareerStudentCV(ctx, userID, courseID, cvURL)
+    if err != nil {
+        return entity.CareerCenterStudent{}, fmt.Errorf("platform - UploadCV - postgresRepo.UpdateCareerStudentCV: %w", err)
+    }
+
+    return student, nil
+}
+
+// ListExpiringCareerSupport returns students whose support ends from today to days from now (30 by default).
+func (us *UseCase) ListExpiringCareerSupport(ctx context.Context, days, afterID int,
+    limit uint32) (entity.CareerStudentPage, error) {
+    if days == 0 {
+        days = _defaultExpiringSupportDays
+    }
+    if limit == 0 {
+        limit = _defaultCareerStudentPageSize
+    }
+    if limit > _maxCareerStudentPageSize {
+        limit = _maxCareerStudentPageSize
+    }
+
+    today := time.Now()
+
+    page, err := us.postgresRepo.ListExpiringCareerStudents(ctx, today, today.AddDate(0, 0, days), afterID, limit)
+    if err != nil {
+        return entity.CareerStudentPage{}, fmt.Errorf("platform - ListExpiringCareerSupport - postgresRepo.ListExpiringCareerStudents: %w", err)
+    }
+
+    return page, nil
+}
+
+// ExtendCareerSupport adds days to the support period of a student.
+func (us *UseCase) ExtendCareerSupport(ctx context.Context, studentID, days int) (entity.CareerCenterStudent, error) {
+    student, err := us.postgresRepo.ExtendSupportPeriod(ctx, studentID, days)
+    if err != nil {
+        return entity.CareerCenterStudent{}, fmt.Errorf("platform - ExtendCareerSupport - postgresRepo.ExtendSupportPeriod: %w", err)
+    }
+
+    return student, nil
+}
@@ -0,0 +1,161 @@
+package platform
+
+import (
+    "context"
+    "crypto/hmac"
+    "crypto/sha256"
+    "encoding/base32"
+    "encoding/binary"
+    "errors"
+    "fmt"
+    "strings"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+)
+
+// Length of the truncated HMAC in a verification code, 96 bits are enough to make forging impractical
+const _certificateSignatureSize = 12
+
+var _certificateCodeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// IssueCertificate marks the course as completed by the student and issues the certificate. Certificates are
+// issued automatically when the course reaches 100% progress, a direct call is the manual override.
+// Issuing is idempotent: the existing certificate is returned if the student already has one.
+func (us *UseCase) IssueCertificate(ctx context.Context, userID, courseID int) (entity.Certificate, error) {
+    var certificate entity.Certificate
+
+    err := us.postgresRepo.WithinTransaction(ctx, func(ctx context.Context) error {
+        // Also serializes concurrent issuing for the same student and course
+        if err := us.postgresRepo.LockCompletedPurchase(ctx, userID, courseID); err != nil {
+            return fmt.Errorf("postgresRepo.LockCompletedPurchase: %w", err)
+        }
+
+        var err error
+
+        certificate, err = us.postgresRepo.GetUserCourseCertificate(ctx, userID, courseID)
+        if err == nil {
+            return nil
+        }
+        if !errors.Is(err, entity.ErrCertificateNotFound) {
+            return fmt.Errorf("postgresRepo.GetUserCourseCertificate: %w", err)
+        }
+
+        certificate, err = us.postgresRepo.CreateCertificate(ctx, entity.Certificate{UserID: userID, CourseID: courseID})
+        if err != nil {
+            return fmt.Errorf("postgresRepo.CreateCertificate: %w", err)
+        }
+
+        certificate.VerificationCode = us.certificateCode(certificate.CertificateID)
+
+        event, err := entity.NewCertificateEvent(certificate)
+        if err != nil {
+            return fmt.Errorf("entity.NewCertificateEvent: %w", err)
+        }
+
+        if err = us.emit(ctx, event); err != nil {
+            return fmt.Errorf("us.emit: %w", err)
+        }
+
+        return nil
+    })
+    if err != nil {
+        return entity.Certificate{}, fmt.Errorf("platform - IssueCertificate - postgresRepo.WithinTransaction: %w", err)
+    }
+
+    certificate.VerificationCode = us.certificateCode(certificate.CertificateID)
+
+    return certificate, nil
+}
+
+func (us *UseCase) ListUserCertificates(ctx context.Context, accountID int) ([]entity.Certificate, error) {
+    certificates, err := us.postgresRepo.ListUserCertificates(ctx, accountID)
+    if err != nil {
+        return nil, fmt.Errorf("platform - ListUserCertificates - postgresRepo.ListUserCertificates: %w", err)
+    }
+
+    for i := range certificates {
+        certificates[i].VerificationCode = us.certificateCode(certificates[i].CertificateID)
+    }
+
+    return certificates, nil
+}
+
+// GetCertificatePDF renders own certificate (or any certificate with user:read).
+func (us *UseCase) GetCertificatePDF(ctx context.Context, accountID, certificateID int) ([]byte, error) {
+    details, err := us.postgresRepo.GetCertificateDetails(ctx, certificateID)
+    if err != nil {
+        return nil, fmt.Errorf("platform - GetCertificatePDF - postgresRepo.GetCertificateDetails: %w", err)
+    }
+
+    if details.UserID != accountID {
+        allowed, err := us.HasPermission(ctx, accountID, entity.PermissionUserRead)
+        if err != nil {
+            return nil, fmt.Errorf("platform - GetCertificatePDF - us.HasPermission: %w", err)
+        }
+
+        if !allowed {
+            return nil, fmt.Errorf("platform - GetCertificatePDF: %w", entity.ErrForbidden)
+        }
+    }
+
+    details.VerificationCode = us.certificateCode(details.CertificateID)
+
+    document, err := us.certificateRenderer.RenderCertificate(details, us.certificateVerifyURL+details.VerificationCode)
+    if err != nil {
+        return nil, fmt.Errorf("platform - GetCertificatePDF - certificateRenderer.RenderCertificate: %w", err)
+    }
+
+    return document, nil
+}
+
+// VerifyCertificate checks the signature of a public verification code and describes the certificate
+// without personal data of the student.
+func (us *UseCase) VerifyCertificate(ctx context.Context, code string) (entity.CertificateVerification, error) {
+    certificateID, ok := us.parseCertificateCode(code)
+    if !ok {
+        return entity.CertificateVerification{}, fmt.Errorf("platform - VerifyCertificate: %w",
+            entity.ErrCertificateNotFound)
+    }
+
+    details, err := us.postgresRepo.GetCertificateDetails(ctx, certificateID)
+    if err != nil {
+        return entity.CertificateVerification{}, fmt.Errorf("platform - VerifyCertificate - postgresRepo.GetCertificateDetails: %w", err)
+    }
+
+    return entity.CertificateVerification{
+        Valid:      true,
+        CourseName: details.CourseName,
+        IssueDate:  details.IssueDate,
+        Teachers:   details.Teachers,
+    }, nil
+}
+
+// certificateCode encodes the certificate ID together with its HMAC, so codes can't be enumerated or forged.
+func (us *UseCase) certificateCode(certificateID int) string {
+    id := make([]byte, 8)
+    binary.BigEndian.PutUint64(id, uint64(certificateID))
+
+    return strings.ToLower(_certificateCodeEncoding.EncodeToString(append(id, us.certificateSignature(id)...)))
+}
+
+func (us *UseCase) parseCertificateCode(code string) (int, bool) {
+    raw, err := _certificateCodeEncoding.DecodeString(strings.ToUpper(code))
+    if err != nil || len(raw) != 8+_certificateSignatureSize {
+        return 0, false
+    }
+
+    id, signature := raw[:8], raw[8:]
+    if !hmac.Equal(signature, us.certificateSignature(id)) {
+        return 0, false
+    }
+
+    return int(binary.BigEndian.Uint64(id)), true
+}
+
+func (us *UseCase) certificateSignature(id []byte) []byte {
+    mac := hmac.New(sha256.New, us.certificateKey)
+    mac.Write([]byte("certificate:"))
+    mac.Write(id)
+
+    return mac.Sum(nil)[:_certificateSignatureSize]
+}
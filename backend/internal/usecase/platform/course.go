@@ -0,0 +1,72 @@
+package platform
+
+import (
+    "context"
+    "fmt"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+)
+
+const (
+    _defaultCoursePageSize = 20
+    _maxCoursePageSize     = 100
+)
+
+func (us *UseCase) CreateCourse(ctx context.Context, course entity.Course) (entity.Course, error) {
+    created, err := us.postgresRepo.CreateCourse(ctx, course)
+    if err != nil {
+        return entity.Course{}, fmt.Errorf("platform - CreateCourse - postgresRepo.CreateCourse: %w", err)
+    }
+
+    // The new ID may have been cached as missing
+    if err = us.redisRepo.DeleteCourse(ctx, created.CourseID); err != nil {
+        us.l.Error(fmt.Errorf("platform - CreateCourse - redisRepo.DeleteCourse: %w", err))
+    }
+
+    return created, nil
+}
+
+func (us *UseCase) UpdateCourse(ctx context.Context, course entity.Course) (entity.Course, error) {
+    updated, err := us.postgresRepo.UpdateCourse(ctx, course)
+    if err != nil {
+        return entity.Course{}, fmt.Errorf("platform - UpdateCourse - postgresRepo.UpdateCourse: %w", err)
+    }
+
+    // The change is committed, a stale cache entry expires with its TTL
+    if err = us.redisRepo.DeleteCourse(ctx, updated.CourseID); err != nil {
+        us.l.Error(fmt.Errorf("platform - UpdateCourse - redisRepo.DeleteCourse: %w", err))
+    }
+
+    return updated, nil
+}
+
+func (us *UseCase) DeleteCourse(ctx context.Context, courseID int) error {
+    if err := us.postgresRepo.DeleteCourse(ctx, courseID); err != nil {
+        return fmt.Errorf("platform - DeleteCourse - postgresRepo.DeleteCourse: %w", err)
+    }
+
+    if err := us.redisRepo.DeleteCourse(ctx, courseID); err != nil {
+        us.l.Error(fmt.Errorf("platform - DeleteCourse - redisRepo.DeleteCourse: %w", err))
+    }
+
+    return nil
+}
+
+func (us *UseCase) ListCourses(ctx context.Context, filter entity.CourseFilter) (entity.CoursePage, error) {
+    if filter.Limit == 0 {
+        filter.Limit = _defaultCoursePageSize
+    }
+    if filter.Limit > _maxCoursePageSize {
+        filter.Limit = _maxCoursePageSize
+    }
+    if filter.SortBy == "" {
+        filter.SortBy = entity.CourseSortByID
+    }
+
+    page, err := us.postgresRepo.ListCourses(ctx, filter)
+    if err != nil {
+        return entity.CoursePage{}, fmt.Errorf("platform - ListCourses - postgresRepo.ListCourses: %w", err)
+    }
+
+    return page, nil
+}
@@ -0,0 +1,151 @@
+package platform
+
+import (
+    "context"
+    "fmt"
+    "time"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+)
+
+// ApplyForJob sends a job application of a graduate whose career support for the course is active
+// to a partner company with an active agreement, one open application per company.
+func (us *UseCase) ApplyForJob(ctx context.Context, userID, courseID, companyID int) (entity.JobApplication, error) {
+    var application entity.JobApplication
+
+    err := us.postgresRepo.WithinTransaction(ctx, func(ctx context.Context) error {
+        student, err := us.postgresRepo.GetCareerStudentByCourse(ctx, userID, courseID)
+        if err != nil {
+            return fmt.Errorf("postgresRepo.GetCareerStudentByCourse: %w", err)
+        }
+
+        if !student.SupportActiveOn(time.Now().Format(time.DateOnly)) {
+            return entity.ErrCareerSupportInactive
+        }
+
+        company, err := us.postgresRepo.GetPartnerCompany(ctx, companyID)
+        if err != nil {
+            return fmt.Errorf("postgresRepo.GetPartnerCompany: %w", err)
+        }
+
+        if !company.AgreementStatus {
+            return entity.ErrAgreementInactive
+        }
+
+        application, err = us.postgresRepo.CreateJobApplication(ctx, entity.JobApplication{
+            StudentID: student.ID,
+            CompanyID: company.CompanyID,
+        })
+        if err != nil {
+            return fmt.Errorf("postgresRepo.CreateJobApplication: %w", err)
+        }
+
+        _, err = us.postgresRepo.AddJobApplicationTransition(ctx, entity.JobApplicationTransition{
+            ApplicationID: application.ID,
+            ToStatus:      application.Status,
+            ActorID:       userID,
+        })
+        if err != nil {
+            return fmt.Errorf("postgresRepo.AddJobApplicationTransition: %w", err)
+        }
+
+        return nil
+    })
+    if err != nil {
+        return entity.JobApplication{}, fmt.Errorf("platform - ApplyForJob - postgresRepo.WithinTransaction: %w", err)
+    }
+
+    return application, nil
+}
+
+// ListMyJobApplications returns the job applications the user sent, newest first.
+func (us *UseCase) ListMyJobApplications(ctx context.Context, userID int) ([]entity.JobApplication, error) {
+    applications, err := us.postgresRepo.ListUserJobApplications(ctx, userID)
+    if err != nil {
+        return nil, fmt.Errorf("platform - ListMyJobApplications - postgresRepo.ListUserJobApplications: %w", err)
+    }
+
+    return applications, nil
+}
+
+// ChangeJobApplicationStatus moves a job application through the hiring pipeline and records the change,
+// a hire is counted for the partner company.
+func (us *UseCase) ChangeJobApplicationStatus(ctx context.Context, actorID, applicationID int,
+    status entity.JobApplicationStatus, comment string) (entity.JobApplication, error) {
+    var application entity.JobApplication
+
+    err := us.postgresRepo.WithinTransaction(ctx, func(ctx context.Context) error {
+        var err error
+
+        application, err = us.postgresRepo.GetJobApplicationForUpdate(ctx, applicationID)
+        if err != nil {
+            return fmt.Errorf("postgresRepo.GetJobApplicationForUpdate: %w", err)
+        }
+
+        if !application.Status.CanTransitionTo(status) {
+            return entity.ErrInvalidJobApplicationTransition
+        }
+
+        if err = us.postgresRepo.UpdateJobApplicationStatus(ctx, applicationID, status); err != nil {
+            return fmt.Errorf("postgresRepo.UpdateJobApplicationStatus: %w", err)
+        }
+
+        _, err = us.postgresRepo.AddJobApplicationTransition(ctx, entity.JobApplicationTransition{
+            ApplicationID: applicationID,
+            FromStatus:    application.Status,
+            ToStatus:      status,
+            ActorID:       actorID,
+            Comment:       comment,
+        })
+        if err != nil {
+            return fmt.Errorf("postgresRepo.AddJobApplicationTransition: %w", err)
+        }
+
+        if status == entity.JobApplicationStatusHired && application.CompanyID != 0 {
+            if err = us.postgresRepo.IncrementHiredGraduates(ctx, application.CompanyID); err != nil {
+                return fmt.Errorf("postgresRepo.IncrementHiredGraduates: %w", err)
+            }
+        }
+
+        application.Status = status
+
+        return nil
+    })
+    if err != nil {
+        return entity.JobApplication{}, fmt.Errorf("platform - ChangeJobApplicationStatus - postgresRepo.WithinTransaction: %w", err)
+    }
+
+    return application, nil
+}
+
+// GetJobApplicationHistory returns the status changes of own application (or of any with career:manage).
+func (us *UseCase) GetJobApplicationHistory(ctx context.Context, accountID,
+    applicationID int) ([]entity.JobApplicationTransition, error) {
+    application, err := us.postgresRepo.GetJobApplication(ctx, applicationID)
+    if err != nil {
+        return nil, fmt.Errorf("platform - GetJobApplicationHistory - postgresRepo.GetJobApplication: %w", err)
+    }
+
+    student, err := us.postgresRepo.GetCareerStudent(ctx, application.StudentID)
+    if err != nil {
+        return nil, fmt.Errorf("platform - GetJobApplicationHistory - postgresRepo.GetCareerStudent: %w", err)
+    }
+
+    if student.UserID != accountID {
+        allowed, err := us.HasPermission(ctx, accountID, entity.PermissionCareerManage)
+        if err != nil {
+            return nil, fmt.Errorf("platform - GetJobApplicationHistory - us.HasPermission: %w", err)
+        }
+
+        if !allowed {
+            return nil, fmt.Errorf("platform - GetJobApplicationHistory: %w", entity.ErrForbidden)
+        }
+    }
+
+    history, err := us.postgresRepo.ListJobApplicationHistory(ctx, applicationID)
+    if err != nil {
+        return nil, fmt.Errorf("platform - GetJobApplicationHistory - postgresRepo.ListJobApplicationHistory: %w", err)
+    }
+
+    return history, nil
+}
@@ -0,0 +1,83 @@
+package platform
+
+import (
+    "time"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/repo"
+)
+
+const (
+    _defaultAccessTokenTTL  = 15 * time.Minute
+    _defaultRefreshTokenTTL = 30 * 24 * time.Hour
+
+    _defaultCourseCacheTTL   = 10 * time.Minute
+    _defaultUserCacheTTL     = 10 * time.Minute
+    _defaultNotFoundCacheTTL = time.Minute
+
+    _defaultWaitlistHoldTTL = 24 * time.Hour
+
+    _defaultOutboxBatchSize    = 100
+    _defaultOutboxMaxAttempts  = 10
+    _defaultOutboxRetryBackoff = 5 * time.Second
+)
+
+// Option -.
+type Option func(*UseCase)
+
+// JWT -.
+func JWT(secret string, accessTTL, refreshTTL time.Duration) Option {
+    return func(us *UseCase) {
+        us.jwtSecret = []byte(secret)
+        us.accessTokenTTL = accessTTL
+        us.refreshTokenTTL = refreshTTL
+    }
+}
+
+// CacheTTL -.
+func CacheTTL(course, user, notFound time.Duration) Option {
+    return func(us *UseCase) {
+        us.courseCacheTTL = course
+        us.userCacheTTL = user
+        us.notFoundCacheTTL = notFound
+    }
+}
+
+// PaymentGateway -.
+func PaymentGateway(gateway repo.PaymentGateway) Option {
+    return func(us *UseCase) {
+        us.paymentGateway = gateway
+    }
+}
+
+// Certificates -.
+func Certificates(renderer repo.CertificateRenderer, signingKey, verifyURL string) Option {
+    return func(us *UseCase) {
+        us.certificateRenderer = renderer
+        us.certificateKey = []byte(signingKey)
+        us.certificateVerifyURL = verifyURL
+    }
+}
+
+// WaitlistHoldTTL -.
+func WaitlistHoldTTL(ttl time.Duration) Option {
+    return func(us *UseCase) {
+        us.waitlistHoldTTL = ttl
+    }
+}
+
+// EventPublisher -.
+func EventPublisher(publisher repo.EventPublisher) Option {
+    return func(us *UseCase) {
+        us.eventPublisher = publisher
+    }
+}
+
+// OutboxRelay - how many events one relay run publishes, how many times an event is tried
+// and the first retry delay, the delay doubles with every next attempt.
+func OutboxRelay(batchSize uint32, maxAttempts int, retryBackoff time.Duration) Option {
+    return func(us *UseCase) {
+        us.outboxBatchSize = batchSize
+        us.outboxMaxAttempts = maxAttempts
+        us.outboxRetryBackoff = retryBackoff
+    }
+}
@@ -0,0 +1,91 @@
+package platform
+
+import (
+    "context"
+    "fmt"
+    "time"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+)
+
+const (
+    // _outboxLease - how long claimed events are hidden from other relays, a relay that dies mid-batch
+    // leaves its events to be claimed again after it.
+    _outboxLease = time.Minute
+
+    _maxOutboxRetryBackoff = time.Hour
+)
+
+// emit writes the event to the outbox, called within the transaction of the change so the event is stored
+// only if the change is committed.
+func (us *UseCase) emit(ctx context.Context, event entity.Event) error {
+    if err := us.postgresRepo.AddOutboxEvent(ctx, event); err != nil {
+        return fmt.Errorf("postgresRepo.AddOutboxEvent: %w", err)
+    }
+
+    return nil
+}
+
+// RelayOutboxEvents publishes a batch of due events and returns how many were published.
+// An event that fails to publish is retried with exponential backoff until outboxMaxAttempts is reached,
+// then it is marked as failed and kept in the outbox.
+func (us *UseCase) RelayOutboxEvents(ctx context.Context) (int, error) {
+    // Events wait in the outbox until a publisher is configured
+    if us.eventPublisher == nil {
+        return 0, nil
+    }
+
+    now := time.Now()
+
+    events, err := us.postgresRepo.ClaimOutboxEvents(ctx, now, now.Add(_outboxLease), us.outboxBatchSize)
+    if err != nil {
+        return 0, fmt.Errorf("platform - RelayOutboxEvents - postgresRepo.ClaimOutboxEvents: %w", err)
+    }
+
+    count := 0
+    var publishErr error
+
+    for _, event := range events {
+        err = us.eventPublisher.Publish(ctx, event)
+        if err == nil {
+            if err = us.postgresRepo.MarkOutboxEventPublished(ctx, event.ID); err != nil {
+                return count, fmt.Errorf("platform - RelayOutboxEvents - postgresRepo.MarkOutboxEventPublished: %w", err)
+            }
+
+            count++
+
+            continue
+        }
+
+        // The rest of the batch is still tried, the first failure is reported
+        if publishErr == nil {
+            publishErr = fmt.Errorf("platform - RelayOutboxEvents - eventPublisher.Publish: %w", err)
+        }
+
+        if event.Attempts >= us.outboxMaxAttempts {
+            if err = us.postgresRepo.FailOutboxEvent(ctx, event.ID, err.Error()); err != nil {
+                return count, fmt.Errorf("platform - RelayOutboxEvents - postgresRepo.FailOutboxEvent: %w", err)
+            }
+
+            continue
+        }
+
+        err = us.postgresRepo.RetryOutboxEvent(ctx, event.ID, time.Now().Add(us.retryBackoff(event.Attempts)), err.Error())
+        if err != nil {
+            return count, fmt.Errorf("platform - RelayOutboxEvents - postgresRepo.RetryOutboxEvent: %w", err)
+        }
+    }
+
+    return count, publishErr
+}
+
+// retryBackoff doubles the delay with every attempt made, up to an hour.
+func (us *UseCase) retryBackoff(attempts int) time.Duration {
+    backoff := us.outboxRetryBackoff
+
+    for i := 1; i < attempts && backoff < _maxOutboxRetryBackoff; i++ {
+        backoff *= 2
+    }
+
+    return min(backoff, _maxOutboxRetryBackoff)
+}
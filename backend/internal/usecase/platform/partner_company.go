@@ -0,0 +1,208 @@
+package platform
+
+import (
+    "context"
+    "fmt"
+    "sort"
+    "strings"
+    "time"
+    "unicode"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+)
+
+const (
+    _defaultPartnerCompanyPageSize = 20
+    _maxPartnerCompanyPageSize     = 100
+
+    _defaultMatchesNumber = 20
+    _maxMatchesNumber     = 100
+)
+
+// _matchStopWords - words of requirements that don't name a skill.
+var _matchStopWords = map[string]bool{
+    "a": true, "an": true, "and": true, "or": true, "the": true, "of": true, "in": true, "on": true, "at": true,
+    "to": true, "for": true, "with": true, "by": true, "as": true, "is": true, "are": true, "be": true, "we": true,
+    "you": true, "our": true, "your": true, "etc": true, "plus": true, "year": true, "years": true,
+    "good": true, "strong": true, "solid": true, "basic": true, "basics": true, "deep": true,
+    "knowledge": true, "experience": true, "understanding": true, "ability": true, "skills": true, "skill": true,
+    "familiarity": true, "proficiency": true, "work": true, "working": true, "using": true,
+}
+
+// GetPartnerCompany -.
+func (us *UseCase) GetPartnerCompany(ctx context.Context, companyID int) (entity.PartnerCompany, error) {
+    company, err := us.postgresRepo.GetPartnerCompany(ctx, companyID)
+    if err != nil {
+        return entity.PartnerCompany{}, fmt.Errorf("platform - GetPartnerCompany - postgresRepo.GetPartnerCompany: %w", err)
+    }
+
+    return company, nil
+}
+
+// ListPartnerCompanies returns a page of partner companies.
+func (us *UseCase) ListPartnerCompanies(ctx context.Context, afterID int, limit uint32) (entity.PartnerCompanyPage, error) {
+    if limit == 0 {
+        limit = _defaultPartnerCompanyPageSize
+    }
+    if limit > _maxPartnerCompanyPageSize {
+        limit = _maxPartnerCompanyPageSize
+    }
+
+    page, err := us.postgresRepo.ListPartnerCompanies(ctx, afterID, limit)
+    if err != nil {
+        return entity.PartnerCompanyPage{}, fmt.Errorf("platform - ListPartnerCompanies - postgresRepo.ListPartnerCompanies: %w", err)
+    }
+
+    return page, nil
+}
+
+// CreatePartnerCompany -.
+func (us *UseCase) CreatePartnerCompany(ctx context.Context, company entity.PartnerCompany) (entity.PartnerCompany, error) {
+    created, err := us.postgresRepo.CreatePartnerCompany(ctx, company)
+    if err != nil {
+        return entity.PartnerCompany{}, fmt.Errorf("platform - CreatePartnerCompany - postgresRepo.CreatePartnerCompany: %w", err)
+    }
+
+    return created, nil
+}
+
+// UpdatePartnerCompany edits a partner company, hired graduates are counted by the application pipeline.
+func (us *UseCase) UpdatePartnerCompany(ctx context.Context, company entity.PartnerCompany) (entity.PartnerCompany, error) {
+    updated, err := us.postgresRepo.UpdatePartnerCompany(ctx, company)
+    if err != nil {
+        return entity.PartnerCompany{}, fmt.Errorf("platform - UpdatePartnerCompany - postgresRepo.UpdatePartnerCompany: %w", err)
+    }
+
+    return updated, nil
+}
+
+// DeletePartnerCompany deletes a partner company, companies with job applications can only be deactivated.
+func (us *UseCase) DeletePartnerCompany(ctx context.Context, companyID int) error {
+    if err := us.postgresRepo.DeletePartnerCompany(ctx, companyID); err != nil {
+        return fmt.Errorf("platform - DeletePartnerCompany - postgresRepo.DeletePartnerCompany: %w", err)
+    }
+
+    return nil
+}
+
+// MatchStudents ranks students with active career support for a company. The score is the percent of
+// requirement keywords found in the technologies of the course topics the student has completed.
+func (us *UseCase) MatchStudents(ctx context.Context, companyID int, limit uint32) (entity.CompanyMatches, error) {
+    if limit == 0 {
+        limit = _defaultMatchesNumber
+    }
+    if limit > _maxMatchesNumber {
+        limit = _maxMatchesNumber
+    }
+
+    company, err := us.postgresRepo.GetPartnerCompany(ctx, companyID)
+    if err != nil {
+        return entity.CompanyMatches{}, fmt.Errorf("platform - MatchStudents - postgresRepo.GetPartnerCompany: %w", err)
+    }
+
+    students, err := us.postgresRepo.ListCareerStudentSkills(ctx, time.Now())
+    if err != nil {
+        return entity.CompanyMatches{}, fmt.Errorf("platform - MatchStudents - postgresRepo.ListCareerStudentSkills: %w", err)
+    }
+
+    keywords := extractKeywords(company.Requirements)
+
+    matches := make([]entity.StudentMatch, 0, len(students))
+    for _, student := range students {
+        matches = append(matches, matchStudent(keywords, student))
+    }
+
+    sort.SliceStable(matches, func(i, j int) bool {
+        if matches[i].Score != matches[j].Score {
+            return matches[i].Score > matches[j].Score
+        }
+
+        return matches[i].CompletedTopics > matches[j].CompletedTopics
+    })
+
+    if len(matches) > int(limit) {
+        matches = matches[:limit]
+    }
+
+    return entity.CompanyMatches{CompanyID: company.CompanyID, Keywords: keywords, Matches: matches}, nil
+}
+
+// matchStudent scores the student against the requirement keywords and explains the score.
+func matchStudent(keywords []string, student entity.CareerStudentSkills) entity.StudentMatch {
+    skills := make(map[string]bool)
+    for _, technologies := range student.Technologies {
+        for _, word := range extractKeywords(technologies) {
+            skills[word] = true
+        }
+    }
+
+    match := entity.StudentMatch{
+        Student:         student.Student,
+        CompletedTopics: student.CompletedTopics,
+        MatchedKeywords: make([]string, 0),
+        MissingKeywords: make([]string, 0),
+    }
+
+    for _, keyword := range keywords {
+        if skills[keyword] {
+            match.MatchedKeywords = append(match.MatchedKeywords, keyword)
+        } else {
+            match.MissingKeywords = append(match.MissingKeywords, keyword)
+        }
+    }
+
+    if len(keywords) == 0 {
+        match.Explanation = "The company requirements name no technologies to match"
+
+        return match
+    }
+
+    // Rounded down, so 100 always means that every keyword is covered
+    match.Score = len(match.MatchedKeywords) * 100 / len(keywords)
+
+    match.Explanation = fmt.Sprintf("Covers %d of %d requirement keywords over %d completed topics",
+        len(match.MatchedKeywords), len(keywords), student.CompletedTopics)
+    if len(match.MatchedKeywords) > 0 {
+        match.Explanation += " (" + strings.Join(match.MatchedKeywords, ", ") + ")"
+    }
+    if len(match.MissingKeywords) > 0 {
+        match.Explanation += ", missing: " + strings.Join(match.MissingKeywords, ", ")
+    }
+
+    return match
+}
+
+// extractKeywords splits a free-form text into unique lowercase words in order of appearance,
+// stop words and words without letters ("5+", "2024") are dropped. Symbols of names like "c++", "c#" and "node.js" are kept.
+func extractKeywords(text string) []string {
+    words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+        return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !strings.ContainsRune("+#.", r)
+    })
+
+    keywords := make([]string, 0, len(words))
+    seen := make(map[string]bool, len(words))
+
+    for _, word := range words {
+        // Dots end sentences as often as they are part of a name
+        word = strings.Trim(word, ".")
+
+        if !hasLetter(word) || _matchStopWords[word] || seen[word] {
+            continue
+        }
+
+        seen[word] = true
+        keywords = append(keywords, word)
+    }
+
+    return keywords
+}
+
+func hasLetter(word string) bool {
+    for _, r := range word {
+        if unicode.IsLetter(r) {
+            return true
+        }
+    }
+
+    return false
+}
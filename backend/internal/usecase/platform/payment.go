@@ -0,0 +1,182 @@
+package platform
+
+import (
+    "context"
+    "fmt"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+)
+
+// CreatePayment creates a payment intent at the provider for a pending purchase of the account.
+// A purchase gets one intent, a repeated call would leave the first one paid but not linked.
+func (us *UseCase) CreatePayment(ctx context.Context, accountID, purchaseID int) (entity.PaymentIntent, error) {
+    var intent entity.PaymentIntent
+
+    err := us.postgresRepo.WithinTransaction(ctx, func(ctx context.Context) error {
+        purchase, err := us.postgresRepo.GetPurchaseForUpdate(ctx, purchaseID)
+        if err != nil {
+            return fmt.Errorf("postgresRepo.GetPurchaseForUpdate: %w", err)
+        }
+
+        if purchase.UserID != accountID {
+            return entity.ErrForbidden
+        }
+
+        if purchase.PurchaseStatus != entity.PurchaseStatusPending {
+            return entity.ErrInvalidPurchaseTransition
+        }
+
+        if purchase.PaymentIntent != "" {
+            return entity.ErrPaymentExists
+        }
+
+        intent, err = us.paymentGateway.CreatePaymentIntent(ctx, purchase)
+        if err != nil {
+            return fmt.Errorf("paymentGateway.CreatePaymentIntent: %w", err)
+        }
+
+        if err = us.postgresRepo.SetPurchasePaymentIntent(ctx, purchaseID, intent.ID); err != nil {
+            return fmt.Errorf("postgresRepo.SetPurchasePaymentIntent: %w", err)
+        }
+
+        return nil
+    })
+    if err != nil {
+        return entity.PaymentIntent{}, fmt.Errorf("platform - CreatePayment - postgresRepo.WithinTransaction: %w", err)
+    }
+
+    return intent, nil
+}
+
+// ConfirmPayment captures the payment of a pending purchase of the account.
+// The purchase is completed once the provider reports the result with a webhook.
+func (us *UseCase) ConfirmPayment(ctx context.Context, accountID, purchaseID int) error {
+    // The purchase stays locked during the capture, so concurrent calls can't capture it twice
+    err := us.postgresRepo.WithinTransaction(ctx, func(ctx context.Context) error {
+        purchase, err := us.postgresRepo.GetPurchaseForUpdate(ctx, purchaseID)
+        if err != nil {
+            return fmt.Errorf("postgresRepo.GetPurchaseForUpdate: %w", err)
+        }
+
+        if purchase.UserID != accountID {
+            return entity.ErrForbidden
+        }
+
+        if purchase.PaymentIntent == "" {
+            return entity.ErrPaymentNotFound
+        }
+
+        if purchase.PurchaseStatus != entity.PurchaseStatusPending {
+            return entity.ErrInvalidPurchaseTransition
+        }
+
+        if err = us.paymentGateway.CapturePayment(ctx, purchase.PaymentIntent); err != nil {
+            return fmt.Errorf("paymentGateway.CapturePayment: %w", err)
+        }
+
+        return nil
+    })
+    if err != nil {
+        return fmt.Errorf("platform - ConfirmPayment - postgresRepo.WithinTransaction: %w", err)
+    }
+
+    return nil
+}
+
+// RefundPurchase refunds a completed purchase of the account (or any purchase for managers).
+// The purchase is cancelled once the provider reports the refund with a webhook.
+func (us *UseCase) RefundPurchase(ctx context.Context, accountID, purchaseID int) error {
+    // The purchase stays locked during the refund, so concurrent calls can't refund it twice
+    err := us.postgresRepo.WithinTransaction(ctx, func(ctx context.Context) error {
+        purchase, err := us.postgresRepo.GetPurchaseForUpdate(ctx, purchaseID)
+        if err != nil {
+            return fmt.Errorf("postgresRepo.GetPurchaseForUpdate: %w", err)
+        }
+
+        if purchase.UserID != accountID {
+            allowed, err := us.HasPermission(ctx, accountID, entity.PermissionPurchaseManage)
+            if err != nil {
+                return fmt.Errorf("us.HasPermission: %w", err)
+            }
+
+            if !allowed {
+                return entity.ErrForbidden
+            }
+        }
+
+        if purchase.PaymentIntent == "" {
+            return entity.ErrPaymentNotFound
+        }
+
+        if purchase.PurchaseStatus != entity.PurchaseStatusCompleted {
+            return entity.ErrInvalidPurchaseTransition
+        }
+
+        if err = us.paymentGateway.RefundPayment(ctx, purchase.PaymentIntent); err != nil {
+            return fmt.Errorf("paymentGateway.RefundPayment: %w", err)
+        }
+
+        return nil
+    })
+    if err != nil {
+        return fmt.Errorf("platform - RefundPurchase - postgresRepo.WithinTransaction: %w", err)
+    }
+
+    return nil
+}
+
+// HandlePaymentWebhook verifies a provider notification and applies it to the purchase.
+// Redelivered events and events that were already applied are ignored.
+func (us *UseCase) HandlePaymentWebhook(ctx context.Context, payload []byte, signature string) error {
+    event, err := us.paymentGateway.VerifyWebhook(payload, signature)
+    if err != nil {
+        return fmt.Errorf("platform - HandlePaymentWebhook - paymentGateway.VerifyWebhook: %w", err)
+    }
+
+    var status entity.PurchaseStatus
+    switch event.Type {
+    case entity.PaymentEventSucceeded:
+        status = entity.PurchaseStatusCompleted
+    case entity.PaymentEventFailed, entity.PaymentEventRefunded:
+        status = entity.PurchaseStatusCancelled
+    default:
+        // Events we are not interested in are acknowledged as is
+        return nil
+    }
+
+    err = us.postgresRepo.WithinTransaction(ctx, func(ctx context.Context) error {
+        isNew, err := us.postgresRepo.MarkPaymentEventProcessed(ctx, event)
+        if err != nil {
+            return fmt.Errorf("postgresRepo.MarkPaymentEventProcessed: %w", err)
+        }
+
+        if !isNew {
+            return nil
+        }
+
+        purchase, err := us.postgresRepo.GetPurchaseByPaymentIntentForUpdate(ctx, event.IntentID)
+        if err != nil {
+            return fmt.Errorf("postgresRepo.GetPurchaseByPaymentIntentForUpdate: %w", err)
+        }
+
+        if purchase.PurchaseStatus == status {
+            return nil
+        }
+
+        // A late or replayed failure doesn't cancel a paid purchase, only a refund does
+        if event.Type == entity.PaymentEventFailed && purchase.PurchaseStatus == entity.PurchaseStatusCompleted {
+            return nil
+        }
+
+        if _, err = us.changePurchaseStatus(ctx, purchase.PurchaseID, status, nil); err != nil {
+            return fmt.Errorf("us.changePurchaseStatus: %w", err)
+        }
+
+        return nil
+    })
+    if err != nil {
+        return fmt.Errorf("platform - HandlePaymentWebhook - postgresRepo.WithinTransaction: %w", err)
+    }
+
+    return nil
+}
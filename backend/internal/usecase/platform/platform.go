@@ -2,45 +2,153 @@ package platform
 
 import (
     "context"
+    "errors"
     "fmt"
+    "time"
 
     "github.com/deadnotxaa/education-platform/backend/internal/entity"
     "github.com/deadnotxaa/education-platform/backend/internal/repo"
+    "github.com/deadnotxaa/education-platform/backend/pkg/logger"
 )
 
+// _defaultReportSize - number of report rows when the limit isn't given.
+const _defaultReportSize = 10
+
 // UseCase - Platform use case
 type UseCase struct {
     postgresRepo repo.PostgresRepo
     redisRepo    repo.RedisRepo
+    l            logger.Interface // Failures that don't fail the request, e.g. of cache writes
+
+    paymentGateway      repo.PaymentGateway
+    certificateRenderer repo.CertificateRenderer
+    eventPublisher      repo.EventPublisher
+
+    jwtSecret       []byte
+    accessTokenTTL  time.Duration
+    refreshTokenTTL time.Duration
+
+    courseCacheTTL   time.Duration
+    userCacheTTL     time.Duration
+    notFoundCacheTTL time.Duration
+
+    certificateKey       []byte
+    certificateVerifyURL string // Verification code is appended to it
+
+    waitlistHoldTTL time.Duration
+
+    outboxBatchSize    uint32
+    outboxMaxAttempts  int
+    outboxRetryBackoff time.Duration
 }
 
 // New -.
-func New(pgr repo.PostgresRepo, rr repo.RedisRepo) *UseCase {
-    return &UseCase{
-        postgresRepo: pgr,
-        redisRepo:    rr,
+func New(pgr repo.PostgresRepo, rr repo.RedisRepo, l logger.Interface, opts ...Option) *UseCase {
+    us := &UseCase{
+        postgresRepo:    pgr,
+        redisRepo:       rr,
+        l:               l,
+        accessTokenTTL:  _defaultAccessTokenTTL,
+        refreshTokenTTL: _defaultRefreshTokenTTL,
+
+        courseCacheTTL:   _defaultCourseCacheTTL,
+        userCacheTTL:     _defaultUserCacheTTL,
+        notFoundCacheTTL: _defaultNotFoundCacheTTL,
+
+        waitlistHoldTTL: _defaultWaitlistHoldTTL,
+
+        outboxBatchSize:    _defaultOutboxBatchSize,
+        outboxMaxAttempts:  _defaultOutboxMaxAttempts,
+        outboxRetryBackoff: _defaultOutboxRetryBackoff,
     }
+
+    // Custom options
+    for _, opt := range opts {
+        opt(us)
+    }
+
+    return us
 }
 
+// GetCourseById reads through the Redis cache, missing IDs are cached too.
+// Cache write failures are only logged, the cache must not make reads fail.
 func (us *UseCase) GetCourseById(ctx context.Context, courseID int) (entity.Course, error) {
-    course, err := us.postgresRepo.GetCourseById(ctx, courseID)
+    course, err := us.redisRepo.GetCourseById(ctx, courseID)
+    if err == nil {
+        return course, nil
+    }
+    if errors.Is(err, entity.ErrCourseNotFound) {
+        return entity.Course{}, fmt.Errorf("platform - GetCourse - redisRepo.GetCourse: %w", err)
+    }
+
+    course, err = us.postgresRepo.GetCourseById(ctx, courseID)
     if err != nil {
+        if errors.Is(err, entity.ErrCourseNotFound) {
+            if cacheErr := us.redisRepo.SetCourseNotFound(ctx, courseID, us.notFoundCacheTTL); cacheErr != nil {
+                us.l.Error(fmt.Errorf("platform - GetCourse - redisRepo.SetCourseNotFound: %w", cacheErr))
+            }
+        }
+
         return entity.Course{}, fmt.Errorf("platform - GetCourse - postgresRepo.GetCourse: %w", err)
     }
 
+    if err = us.redisRepo.SetCourse(ctx, course, us.courseCacheTTL); err != nil {
+        us.l.Error(fmt.Errorf("platform - GetCourse - redisRepo.SetCourse: %w", err))
+    }
+
     return course, nil
 }
 
+// GetCoursesByIDs returns the existing courses among the IDs keyed by ID, it skips the cache
+// since batches come from GraphQL loaders and are cached per request.
+func (us *UseCase) GetCoursesByIDs(ctx context.Context, courseIDs []int) (map[int]entity.Course, error) {
+    courses, err := us.postgresRepo.GetCoursesByIDs(ctx, courseIDs)
+    if err != nil {
+        return nil, fmt.Errorf("platform - GetCoursesByIDs - postgresRepo.GetCoursesByIDs: %w", err)
+    }
+
+    byID := make(map[int]entity.Course, len(courses))
+    for _, course := range courses {
+        byID[course.CourseID] = course
+    }
+
+    return byID, nil
+}
+
+// GetUserById reads through the Redis cache, missing IDs are cached too.
+// Cache write failures are only logged, the cache must not make reads fail.
 func (us *UseCase) GetUserById(ctx context.Context, userID int) (entity.User, error) {
-    user, err := us.postgresRepo.GetUserById(ctx, userID)
+    user, err := us.redisRepo.GetUserById(ctx, userID)
+    if err == nil {
+        return user, nil
+    }
+    if errors.Is(err, entity.ErrUserNotFound) {
+        return entity.User{}, fmt.Errorf("platform - GetUser - redisRepo.GetUser: %w", err)
+    }
+
+    user, err = us.postgresRepo.GetUserById(ctx, userID)
     if err != nil {
+        if errors.Is(err, entity.ErrUserNotFound) {
+            if cacheErr := us.redisRepo.SetUserNotFound(ctx, userID, us.notFoundCacheTTL); cacheErr != nil {
+                us.l.Error(fmt.Errorf("platform - GetUser - redisRepo.SetUserNotFound: %w", cacheErr))
+            }
+        }
+
         return entity.User{}, fmt.Errorf("platform - GetUser - postgresRepo.GetUser: %w", err)
     }
 
+    if err = us.redisRepo.SetUser(ctx, user, us.userCacheTTL); err != nil {
+        us.l.Error(fmt.Errorf("platform - GetUser - redisRepo.SetUser: %w", err))
+    }
+
     return user, nil
 }
 
 func (us *UseCase) GetTopCoursesReport(ctx context.Context, limit uint32) ([]entity.TopCoursesReport, error) {
+    if limit == 0 {
+        limit = _defaultReportSize
+    }
+
     reports, err := us.postgresRepo.GetTopCoursesReport(ctx, limit)
     if err != nil {
         return nil, fmt.Errorf("platform - GetTopCoursesReport - postgresRepo.GetTopCoursesReport: %w", err)
@@ -48,3 +156,17 @@ func (us *UseCase) GetTopCoursesReport(ctx context.Context, limit uint32) ([]ent
 
     return reports, nil
 }
+
+func (us *UseCase) GetDetailedPurchaseReport(ctx context.Context,
+    filter entity.DetailedPurchaseReportFilter) ([]entity.DetailedPurchaseReport, error) {
+    if filter.Limit == 0 {
+        filter.Limit = _defaultReportSize
+    }
+
+    reports, err := us.postgresRepo.GetDetailedPurchaseReport(ctx, filter)
+    if err != nil {
+        return nil, fmt.Errorf("platform - GetDetailedPurchaseReport - postgresRepo.GetDetailedPurchaseReport: %w", err)
+    }
+
+    return reports, nil
+}
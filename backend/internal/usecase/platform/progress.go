@@ -0,0 +1,232 @@
+package platform
+
+import (
+    "context"
+    "errors"
+    "fmt"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+)
+
+const (
+    _defaultSubmissionPageSize = 20
+    _maxSubmissionPageSize     = 100
+)
+
+// GetUserProgress returns own progress through purchased courses (or anyone's with user:read).
+func (us *UseCase) GetUserProgress(ctx context.Context, accountID, userID int) (entity.UserProgress, error) {
+    if accountID != userID {
+        allowed, err := us.HasPermission(ctx, accountID, entity.PermissionUserRead)
+        if err != nil {
+            return entity.UserProgress{}, fmt.Errorf("platform - GetUserProgress - us.HasPermission: %w", err)
+        }
+
+        if !allowed {
+            return entity.UserProgress{}, fmt.Errorf("platform - GetUserProgress: %w", entity.ErrForbidden)
+        }
+    }
+
+    if _, err := us.GetUserById(ctx, userID); err != nil {
+        return entity.UserProgress{}, fmt.Errorf("platform - GetUserProgress - us.GetUserById: %w", err)
+    }
+
+    courses, err := us.postgresRepo.GetUserProgress(ctx, userID)
+    if err != nil {
+        return entity.UserProgress{}, fmt.Errorf("platform - GetUserProgress - postgresRepo.GetUserProgress: %w", err)
+    }
+
+    for i := range courses {
+        countCompletion(&courses[i])
+    }
+
+    return entity.UserProgress{UserID: userID, Courses: courses}, nil
+}
+
+// UpdateTopicProgress marks a topic of a purchased course as started or completed.
+func (us *UseCase) UpdateTopicProgress(ctx context.Context, userID, courseID, topicID int,
+    status entity.TopicStatus) error {
+    if err := us.checkCoursePurchased(ctx, userID, courseID); err != nil {
+        return fmt.Errorf("platform - UpdateTopicProgress - us.checkCoursePurchased: %w", err)
+    }
+
+    found, err := us.postgresRepo.CourseHasTopic(ctx, courseID, topicID)
+    if err != nil {
+        return fmt.Errorf("platform - UpdateTopicProgress - postgresRepo.CourseHasTopic: %w", err)
+    }
+
+    if !found {
+        return fmt.Errorf("platform - UpdateTopicProgress: %w", entity.ErrTopicNotFound)
+    }
+
+    err = us.postgresRepo.WithinTransaction(ctx, func(ctx context.Context) error {
+        if err := us.postgresRepo.SetTopicStatus(ctx, userID, courseID, topicID, status); err != nil {
+            return fmt.Errorf("postgresRepo.SetTopicStatus: %w", err)
+        }
+
+        if status != entity.TopicStatusCompleted {
+            return nil
+        }
+
+        if err := us.issueCertificateOnCompletion(ctx, userID, courseID); err != nil {
+            return fmt.Errorf("us.issueCertificateOnCompletion: %w", err)
+        }
+
+        return nil
+    })
+    if err != nil {
+        return fmt.Errorf("platform - UpdateTopicProgress - postgresRepo.WithinTransaction: %w", err)
+    }
+
+    return nil
+}
+
+// SubmitProject submits a solution of a project of a purchased course, it is graded by a reviewer.
+func (us *UseCase) SubmitProject(ctx context.Context, submission entity.ProjectSubmission) (entity.ProjectSubmission, error) {
+    if err := us.checkCoursePurchased(ctx, submission.UserID, submission.CourseID); err != nil {
+        return entity.ProjectSubmission{}, fmt.Errorf("platform - SubmitProject - us.checkCoursePurchased: %w", err)
+    }
+
+    found, err := us.postgresRepo.CourseHasProject(ctx, submission.CourseID, submission.ProjectID)
+    if err != nil {
+        return entity.ProjectSubmission{}, fmt.Errorf("platform - SubmitProject - postgresRepo.CourseHasProject: %w", err)
+    }
+
+    if !found {
+        return entity.ProjectSubmission{}, fmt.Errorf("platform - SubmitProject: %w", entity.ErrProjectNotFound)
+    }
+
+    submitted, err := us.postgresRepo.SubmitProject(ctx, submission)
+    if err != nil {
+        return entity.ProjectSubmission{}, fmt.Errorf("platform - SubmitProject - postgresRepo.SubmitProject: %w", err)
+    }
+
+    return submitted, nil
+}
+
+// ListPendingSubmissions returns the project review queue, oldest submissions first.
+func (us *UseCase) ListPendingSubmissions(ctx context.Context, cursor string, limit uint32) (entity.SubmissionPage, error) {
+    if limit == 0 {
+        limit = _defaultSubmissionPageSize
+    }
+    if limit > _maxSubmissionPageSize {
+        limit = _maxSubmissionPageSize
+    }
+
+    page, err := us.postgresRepo.ListPendingSubmissions(ctx, cursor, limit)
+    if err != nil {
+        return entity.SubmissionPage{}, fmt.Errorf("platform - ListPendingSubmissions - postgresRepo.ListPendingSubmissions: %w", err)
+    }
+
+    return page, nil
+}
+
+// GradeSubmission accepts or rejects a submitted project, the grade is signed by the reviewer's employee record.
+func (us *UseCase) GradeSubmission(ctx context.Context, reviewerAccountID int,
+    submission entity.ProjectSubmission) (entity.ProjectSubmission, error) {
+    var graded entity.ProjectSubmission
+
+    err := us.postgresRepo.WithinTransaction(ctx, func(ctx context.Context) error {
+        reviewerID, err := us.postgresRepo.GetEmployeeWithPermission(ctx, reviewerAccountID,
+            entity.PermissionProjectReview)
+        if err != nil {
+            return fmt.Errorf("postgresRepo.GetEmployeeWithPermission: %w", err)
+        }
+
+        current, err := us.postgresRepo.GetSubmissionForUpdate(ctx, submission.SubmissionID)
+        if err != nil {
+            return fmt.Errorf("postgresRepo.GetSubmissionForUpdate: %w", err)
+        }
+
+        if current.Status != entity.SubmissionStatusSubmitted {
+            return entity.ErrSubmissionNotPending
+        }
+
+        submission.ReviewerID = reviewerID
+
+        graded, err = us.postgresRepo.GradeSubmission(ctx, submission)
+        if err != nil {
+            return fmt.Errorf("postgresRepo.GradeSubmission: %w", err)
+        }
+
+        if graded.Status != entity.SubmissionStatusAccepted {
+            return nil
+        }
+
+        if err = us.issueCertificateOnCompletion(ctx, graded.UserID, graded.CourseID); err != nil {
+            return fmt.Errorf("us.issueCertificateOnCompletion: %w", err)
+        }
+
+        return nil
+    })
+    if err != nil {
+        return entity.ProjectSubmission{}, fmt.Errorf("platform - GradeSubmission - postgresRepo.WithinTransaction: %w", err)
+    }
+
+    return graded, nil
+}
+
+func (us *UseCase) checkCoursePurchased(ctx context.Context, userID, courseID int) error {
+    purchased, err := us.postgresRepo.HasCompletedPurchase(ctx, userID, courseID)
+    if err != nil {
+        return fmt.Errorf("postgresRepo.HasCompletedPurchase: %w", err)
+    }
+
+    if !purchased {
+        return entity.ErrCourseNotPurchased
+    }
+
+    return nil
+}
+
+// issueCertificateOnCompletion issues the certificate once every topic is completed and every project accepted,
+// called within the transaction that recorded the progress. The lock of the purchase makes the last of two
+// concurrent completions see the other one, a student whose purchase is no longer completed is skipped.
+func (us *UseCase) issueCertificateOnCompletion(ctx context.Context, userID, courseID int) error {
+    err := us.postgresRepo.LockCompletedPurchase(ctx, userID, courseID)
+    if errors.Is(err, entity.ErrCourseNotPurchased) {
+        return nil
+    }
+
+    if err != nil {
+        return fmt.Errorf("postgresRepo.LockCompletedPurchase: %w", err)
+    }
+
+    course, err := us.postgresRepo.GetUserCourseProgress(ctx, userID, courseID)
+    if err != nil {
+        return fmt.Errorf("postgresRepo.GetUserCourseProgress: %w", err)
+    }
+
+    countCompletion(&course)
+
+    if course.CompletionPercent < 100 {
+        return nil
+    }
+
+    if _, err = us.IssueCertificate(ctx, userID, courseID); err != nil {
+        return fmt.Errorf("us.IssueCertificate: %w", err)
+    }
+
+    return nil
+}
+
+// countCompletion fills the completion numbers of a course, every topic and every project weighs the same.
+func countCompletion(course *entity.CourseProgress) {
+    for _, topic := range course.Topics {
+        course.TotalTopics++
+        if topic.Status == entity.TopicStatusCompleted {
+            course.CompletedTopics++
+        }
+
+        for _, project := range topic.Projects {
+            course.TotalProjects++
+            if project.Status == entity.SubmissionStatusAccepted {
+                course.AcceptedProjects++
+            }
+        }
+    }
+
+    if total := course.TotalTopics + course.TotalProjects; total > 0 {
+        // Rounded down, so 100% always means that everything is done
+        course.CompletionPercent = (course.CompletedTopics + course.AcceptedProjects) * 100 / total
+    }
+}
@@ -0,0 +1,230 @@
+package platform
+
+import (
+    "context"
+    "errors"
+    "fmt"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+)
+
+// PurchaseCourse reserves a seat in the cohort and creates a pending purchase with the discount applied.
+func (us *UseCase) PurchaseCourse(ctx context.Context, accountID, calendarID, courseTypeID int) (entity.Purchase, error) {
+    var purchase entity.Purchase
+
+    err := us.postgresRepo.WithinTransaction(ctx, func(ctx context.Context) error {
+        calendar, err := us.postgresRepo.GetCourseCalendarForUpdate(ctx, calendarID)
+        if err != nil {
+            return fmt.Errorf("postgresRepo.GetCourseCalendarForUpdate: %w", err)
+        }
+
+        closed, err := salesClosed(calendar)
+        if err != nil {
+            return fmt.Errorf("salesClosed: %w", err)
+        }
+
+        if closed {
+            return entity.ErrSalesClosed
+        }
+
+        // A seat freed for the user by the waitlist is already taken out of remaining places
+        entry, err := us.postgresRepo.GetActiveWaitlistEntryForUpdate(ctx, calendar.ID, accountID)
+        if err != nil && !errors.Is(err, entity.ErrWaitlistEntryNotFound) {
+            return fmt.Errorf("postgresRepo.GetActiveWaitlistEntryForUpdate: %w", err)
+        }
+
+        inWaitlist := err == nil
+
+        held, err := holdsSeat(entry)
+        if err != nil {
+            return fmt.Errorf("holdsSeat: %w", err)
+        }
+
+        if !held && calendar.RemainingPlaces <= 0 {
+            return entity.ErrNoPlacesLeft
+        }
+
+        course, err := us.postgresRepo.GetCourseById(ctx, calendar.CourseID)
+        if err != nil {
+            return fmt.Errorf("postgresRepo.GetCourseById: %w", err)
+        }
+
+        discount := 0
+        if courseTypeID != 0 {
+            courseType, err := us.postgresRepo.GetCourseType(ctx, courseTypeID)
+            if err != nil {
+                return fmt.Errorf("postgresRepo.GetCourseType: %w", err)
+            }
+
+            discount = min(max(courseType.Discount, 0), 100)
+        }
+
+        purchase, err = us.postgresRepo.CreatePurchase(ctx, entity.Purchase{
+            UserID:         accountID,
+            CourseID:       course.CourseID,
+            CalendarID:     calendar.ID,
+            CourseTypeID:   courseTypeID,
+            TotalPrice:     course.Price * (100 - discount) / 100,
+            PurchaseStatus: entity.PurchaseStatusPending,
+        })
+        if err != nil {
+            return fmt.Errorf("postgresRepo.CreatePurchase: %w", err)
+        }
+
+        event, err := entity.NewPurchaseEvent(entity.EventPurchaseCreated, purchase)
+        if err != nil {
+            return fmt.Errorf("entity.NewPurchaseEvent: %w", err)
+        }
+
+        if err = us.emit(ctx, event); err != nil {
+            return fmt.Errorf("us.emit: %w", err)
+        }
+
+        // An expired offer is left to ExpireWaitlistOffers, which passes the seat on
+        if held || (inWaitlist && entry.Status == entity.WaitlistStatusWaiting) {
+            err = us.postgresRepo.SetWaitlistEntryStatus(ctx, entry.EntryID, entity.WaitlistStatusClaimed)
+            if err != nil {
+                return fmt.Errorf("postgresRepo.SetWaitlistEntryStatus: %w", err)
+            }
+        }
+
+        if held {
+            return nil
+        }
+
+        if err = us.postgresRepo.AdjustRemainingPlaces(ctx, calendar.ID, -1); err != nil {
+            return fmt.Errorf("postgresRepo.AdjustRemainingPlaces: %w", err)
+        }
+
+        return nil
+    })
+    if err != nil {
+        return entity.Purchase{}, fmt.Errorf("platform - PurchaseCourse - postgresRepo.WithinTransaction: %w", err)
+    }
+
+    return purchase, nil
+}
+
+// CompletePurchase marks a pending purchase as paid.
+func (us *UseCase) CompletePurchase(ctx context.Context, purchaseID int) (entity.Purchase, error) {
+    purchase, err := us.changePurchaseStatus(ctx, purchaseID, entity.PurchaseStatusCompleted, nil)
+    if err != nil {
+        return entity.Purchase{}, fmt.Errorf("platform - CompletePurchase - us.changePurchaseStatus: %w", err)
+    }
+
+    return purchase, nil
+}
+
+// CancelPurchase cancels a purchase of the account (or any purchase for managers) and releases its seat.
+// A paid purchase is cancelled by RefundPurchase instead, so the provider returns the money. A completed
+// purchase without a payment, e.g. completed by a manager, can be cancelled only by a manager.
+func (us *UseCase) CancelPurchase(ctx context.Context, accountID, purchaseID int) (entity.Purchase, error) {
+    authorize := func(ctx context.Context, purchase entity.Purchase) error {
+        completed := purchase.PurchaseStatus == entity.PurchaseStatusCompleted
+        if completed && purchase.PaymentIntent != "" {
+            return entity.ErrRefundRequired
+        }
+
+        if purchase.UserID == accountID && !completed {
+            return nil
+        }
+
+        allowed, err := us.HasPermission(ctx, accountID, entity.PermissionPurchaseManage)
+        if err != nil {
+            return fmt.Errorf("us.HasPermission: %w", err)
+        }
+
+        if allowed {
+            return nil
+        }
+
+        if purchase.UserID == accountID {
+            return entity.ErrRefundRequired
+        }
+
+        return entity.ErrForbidden
+    }
+
+    purchase, err := us.changePurchaseStatus(ctx, purchaseID, entity.PurchaseStatusCancelled, authorize)
+    if err != nil {
+        return entity.Purchase{}, fmt.Errorf("platform - CancelPurchase - us.changePurchaseStatus: %w", err)
+    }
+
+    return purchase, nil
+}
+
+// changePurchaseStatus locks the purchase, validates the transition and releases the seat on cancellation.
+func (us *UseCase) changePurchaseStatus(ctx context.Context, purchaseID int, status entity.PurchaseStatus,
+    authorize func(ctx context.Context, purchase entity.Purchase) error) (entity.Purchase, error) {
+    var purchase entity.Purchase
+
+    err := us.postgresRepo.WithinTransaction(ctx, func(ctx context.Context) error {
+        var err error
+
+        purchase, err = us.postgresRepo.GetPurchaseForUpdate(ctx, purchaseID)
+        if err != nil {
+            return fmt.Errorf("postgresRepo.GetPurchaseForUpdate: %w", err)
+        }
+
+        if authorize != nil {
+            if err = authorize(ctx, purchase); err != nil {
+                return err
+            }
+        }
+
+        if !purchase.PurchaseStatus.CanTransitionTo(status) {
+            return entity.ErrInvalidPurchaseTransition
+        }
+
+        if err = us.postgresRepo.UpdatePurchaseStatus(ctx, purchaseID, status); err != nil {
+            return fmt.Errorf("postgresRepo.UpdatePurchaseStatus: %w", err)
+        }
+
+        if status == entity.PurchaseStatusCancelled && purchase.CalendarID != 0 {
+            if err = us.releasePurchaseSeat(ctx, purchase.CalendarID); err != nil {
+                return fmt.Errorf("us.releasePurchaseSeat: %w", err)
+            }
+        }
+
+        purchase.PurchaseStatus = status
+
+        eventType := entity.EventPurchaseCompleted
+        if status == entity.PurchaseStatusCancelled {
+            eventType = entity.EventPurchaseCancelled
+        }
+
+        event, err := entity.NewPurchaseEvent(eventType, purchase)
+        if err != nil {
+            return fmt.Errorf("entity.NewPurchaseEvent: %w", err)
+        }
+
+        if err = us.emit(ctx, event); err != nil {
+            return fmt.Errorf("us.emit: %w", err)
+        }
+
+        return nil
+    })
+    if err != nil {
+        return entity.Purchase{}, fmt.Errorf("postgresRepo.WithinTransaction: %w", err)
+    }
+
+    return purchase, nil
+}
+
+// releasePurchaseSeat passes the seat of a cancelled purchase to the waitlist, deleted cohorts are skipped.
+func (us *UseCase) releasePurchaseSeat(ctx context.Context, calendarID int) error {
+    _, err := us.postgresRepo.GetCourseCalendarForUpdate(ctx, calendarID)
+    if errors.Is(err, entity.ErrCourseCalendarNotFound) {
+        return nil
+    }
+
+    if err != nil {
+        return fmt.Errorf("postgresRepo.GetCourseCalendarForUpdate: %w", err)
+    }
+
+    if err = us.releaseSeat(ctx, calendarID); err != nil {
+        return fmt.Errorf("us.releaseSeat: %w", err)
+    }
+
+    return nil
+}
@@ -0,0 +1,42 @@
+package platform
+
+import (
+    "context"
+    "fmt"
+    "slices"
+    "time"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+)
+
+// Role changes reach running instances after at most this delay
+const _permissionsCacheTTL = 5 * time.Minute
+
+// GetAccountPermissions returns permissions of the account, reading through the Redis cache.
+func (us *UseCase) GetAccountPermissions(ctx context.Context, accountID int) ([]entity.Permission, error) {
+    permissions, err := us.redisRepo.GetAccountPermissions(ctx, accountID)
+    if err == nil {
+        return permissions, nil
+    }
+
+    permissions, err = us.postgresRepo.GetAccountPermissions(ctx, accountID)
+    if err != nil {
+        return nil, fmt.Errorf("platform - GetAccountPermissions - postgresRepo.GetAccountPermissions: %w", err)
+    }
+
+    err = us.redisRepo.SetAccountPermissions(ctx, accountID, permissions, _permissionsCacheTTL)
+    if err != nil {
+        return nil, fmt.Errorf("platform - GetAccountPermissions - redisRepo.SetAccountPermissions: %w", err)
+    }
+
+    return permissions, nil
+}
+
+func (us *UseCase) HasPermission(ctx context.Context, accountID int, permission entity.Permission) (bool, error) {
+    permissions, err := us.GetAccountPermissions(ctx, accountID)
+    if err != nil {
+        return false, fmt.Errorf("platform - HasPermission - us.GetAccountPermissions: %w", err)
+    }
+
+    return slices.Contains(permissions, permission), nil
+}
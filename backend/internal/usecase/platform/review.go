@@ -0,0 +1,229 @@
+package platform
+
+import (
+    "context"
+    "fmt"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+)
+
+const (
+    _defaultReviewPageSize = 20
+    _maxReviewPageSize     = 100
+)
+
+// CreateReview posts a review of a purchased course, it is published after moderation.
+func (us *UseCase) CreateReview(ctx context.Context, review entity.CourseReview) (entity.CourseReview, error) {
+    purchased, err := us.postgresRepo.HasCompletedPurchase(ctx, review.UserID, review.CourseID)
+    if err != nil {
+        return entity.CourseReview{}, fmt.Errorf("platform - CreateReview - postgresRepo.HasCompletedPurchase: %w", err)
+    }
+
+    if !purchased {
+        return entity.CourseReview{}, fmt.Errorf("platform - CreateReview: %w", entity.ErrCourseNotPurchased)
+    }
+
+    var created entity.CourseReview
+
+    err = us.postgresRepo.WithinTransaction(ctx, func(ctx context.Context) error {
+        var err error
+
+        created, err = us.postgresRepo.CreateReview(ctx, review)
+        if err != nil {
+            return fmt.Errorf("postgresRepo.CreateReview: %w", err)
+        }
+
+        event, err := entity.NewReviewEvent(entity.EventReviewCreated, created, 0)
+        if err != nil {
+            return fmt.Errorf("entity.NewReviewEvent: %w", err)
+        }
+
+        if err = us.emit(ctx, event); err != nil {
+            return fmt.Errorf("us.emit: %w", err)
+        }
+
+        return nil
+    })
+    if err != nil {
+        return entity.CourseReview{}, fmt.Errorf("platform - CreateReview - postgresRepo.WithinTransaction: %w", err)
+    }
+
+    return created, nil
+}
+
+// UpdateReview edits own review and sends it back to moderation.
+func (us *UseCase) UpdateReview(ctx context.Context, review entity.CourseReview) (entity.CourseReview, error) {
+    var updated entity.CourseReview
+    var wasApproved bool
+
+    err := us.postgresRepo.WithinTransaction(ctx, func(ctx context.Context) error {
+        current, err := us.postgresRepo.GetReviewForUpdate(ctx, review.ReviewID)
+        if err != nil {
+            return fmt.Errorf("postgresRepo.GetReviewForUpdate: %w", err)
+        }
+
+        if current.UserID != review.UserID {
+            return entity.ErrForbidden
+        }
+
+        wasApproved = current.Status == entity.ReviewStatusApproved
+
+        updated, err = us.postgresRepo.UpdateReview(ctx, review)
+        if err != nil {
+            return fmt.Errorf("postgresRepo.UpdateReview: %w", err)
+        }
+
+        event, err := entity.NewReviewEvent(entity.EventReviewUpdated, updated, 0)
+        if err != nil {
+            return fmt.Errorf("entity.NewReviewEvent: %w", err)
+        }
+
+        if err = us.emit(ctx, event); err != nil {
+            return fmt.Errorf("us.emit: %w", err)
+        }
+
+        return nil
+    })
+    if err != nil {
+        return entity.CourseReview{}, fmt.Errorf("platform - UpdateReview - postgresRepo.WithinTransaction: %w", err)
+    }
+
+    if wasApproved {
+        if err = us.redisRepo.DeleteTopCoursesReports(ctx); err != nil {
+            return entity.CourseReview{}, fmt.Errorf("platform - UpdateReview - redisRepo.DeleteTopCoursesReports: %w", err)
+        }
+    }
+
+    return updated, nil
+}
+
+// DeleteReview deletes own review (or any review for moderators).
+func (us *UseCase) DeleteReview(ctx context.Context, accountID, reviewID int) error {
+    var wasApproved bool
+
+    err := us.postgresRepo.WithinTransaction(ctx, func(ctx context.Context) error {
+        review, err := us.postgresRepo.GetReviewForUpdate(ctx, reviewID)
+        if err != nil {
+            return fmt.Errorf("postgresRepo.GetReviewForUpdate: %w", err)
+        }
+
+        moderatorID := 0
+
+        if review.UserID != accountID {
+            allowed, err := us.HasPermission(ctx, accountID, entity.PermissionReviewModerate)
+            if err != nil {
+                return fmt.Errorf("us.HasPermission: %w", err)
+            }
+
+            if !allowed {
+                return entity.ErrForbidden
+            }
+
+            moderatorID = accountID
+        }
+
+        wasApproved = review.Status == entity.ReviewStatusApproved
+
+        if err = us.postgresRepo.DeleteReview(ctx, reviewID); err != nil {
+            return fmt.Errorf("postgresRepo.DeleteReview: %w", err)
+        }
+
+        event, err := entity.NewReviewEvent(entity.EventReviewDeleted, review, moderatorID)
+        if err != nil {
+            return fmt.Errorf("entity.NewReviewEvent: %w", err)
+        }
+
+        if err = us.emit(ctx, event); err != nil {
+            return fmt.Errorf("us.emit: %w", err)
+        }
+
+        return nil
+    })
+    if err != nil {
+        return fmt.Errorf("platform - DeleteReview - postgresRepo.WithinTransaction: %w", err)
+    }
+
+    if wasApproved {
+        if err = us.redisRepo.DeleteTopCoursesReports(ctx); err != nil {
+            return fmt.Errorf("platform - DeleteReview - redisRepo.DeleteTopCoursesReports: %w", err)
+        }
+    }
+
+    return nil
+}
+
+// ListPendingReviews returns the moderation queue, oldest reviews first.
+func (us *UseCase) ListPendingReviews(ctx context.Context, afterID int, limit uint32) (entity.ReviewPage, error) {
+    if limit == 0 {
+        limit = _defaultReviewPageSize
+    }
+    if limit > _maxReviewPageSize {
+        limit = _maxReviewPageSize
+    }
+
+    page, err := us.postgresRepo.ListPendingReviews(ctx, afterID, limit)
+    if err != nil {
+        return entity.ReviewPage{}, fmt.Errorf("platform - ListPendingReviews - postgresRepo.ListPendingReviews: %w", err)
+    }
+
+    return page, nil
+}
+
+// ListApprovedReviewsByCourses returns the latest approved reviews of each course, perCourse is bounded as a page size.
+func (us *UseCase) ListApprovedReviewsByCourses(ctx context.Context, courseIDs []int, perCourse uint32) (map[int][]entity.CourseReview, error) {
+    if perCourse == 0 {
+        perCourse = _defaultReviewPageSize
+    }
+    if perCourse > _maxReviewPageSize {
+        perCourse = _maxReviewPageSize
+    }
+
+    reviews, err := us.postgresRepo.ListApprovedReviewsByCourses(ctx, courseIDs, perCourse)
+    if err != nil {
+        return nil, fmt.Errorf("platform - ListApprovedReviewsByCourses - postgresRepo.ListApprovedReviewsByCourses: %w", err)
+    }
+
+    return reviews, nil
+}
+
+// ModerateReview approves or rejects a pending review.
+func (us *UseCase) ModerateReview(ctx context.Context, moderatorID, reviewID int, status entity.ReviewStatus) error {
+    err := us.postgresRepo.WithinTransaction(ctx, func(ctx context.Context) error {
+        review, err := us.postgresRepo.GetReviewForUpdate(ctx, reviewID)
+        if err != nil {
+            return fmt.Errorf("postgresRepo.GetReviewForUpdate: %w", err)
+        }
+
+        if review.Status != entity.ReviewStatusPending {
+            return entity.ErrReviewNotPending
+        }
+
+        if err = us.postgresRepo.SetReviewStatus(ctx, reviewID, status, moderatorID); err != nil {
+            return fmt.Errorf("postgresRepo.SetReviewStatus: %w", err)
+        }
+
+        review.Status = status
+
+        event, err := entity.NewReviewEvent(entity.EventReviewModerated, review, moderatorID)
+        if err != nil {
+            return fmt.Errorf("entity.NewReviewEvent: %w", err)
+        }
+
+        if err = us.emit(ctx, event); err != nil {
+            return fmt.Errorf("us.emit: %w", err)
+        }
+
+        return nil
+    })
+    if err != nil {
+        return fmt.Errorf("platform - ModerateReview - postgresRepo.WithinTransaction: %w", err)
+    }
+
+    if status == entity.ReviewStatusApproved {
+        if err = us.redisRepo.DeleteTopCoursesReports(ctx); err != nil {
+            return fmt.Errorf("platform - ModerateReview - redisRepo.DeleteTopCoursesReports: %w", err)
+        }
+    }
+
+    return nil
+}
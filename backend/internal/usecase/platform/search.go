@@ -0,0 +1,57 @@
+package platform
+
+import (
+    "context"
+    "fmt"
+    "sort"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+)
+
+const (
+    _defaultSearchLimit = 20
+    _maxSearchLimit     = 100
+)
+
+func (us *UseCase) Search(ctx context.Context, query entity.SearchQuery) ([]entity.SearchHit, error) {
+    if query.Limit == 0 {
+        query.Limit = _defaultSearchLimit
+    }
+    if query.Limit > _maxSearchLimit {
+        query.Limit = _maxSearchLimit
+    }
+    if !query.Courses && !query.BlogPosts {
+        query.Courses, query.BlogPosts = true, true
+    }
+
+    hits := make([]entity.SearchHit, 0, query.Limit)
+
+    if query.Courses {
+        courses, err := us.postgresRepo.SearchCourses(ctx, query.Text, query.Limit)
+        if err != nil {
+            return nil, fmt.Errorf("platform - Search - postgresRepo.SearchCourses: %w", err)
+        }
+
+        hits = append(hits, courses...)
+    }
+
+    if query.BlogPosts {
+        posts, err := us.postgresRepo.SearchBlogPosts(ctx, query.Text, query.Limit)
+        if err != nil {
+            return nil, fmt.Errorf("platform - Search - postgresRepo.SearchBlogPosts: %w", err)
+        }
+
+        hits = append(hits, posts...)
+    }
+
+    // Both sources are ranked by ts_rank, so they can be merged directly
+    sort.SliceStable(hits, func(i, j int) bool {
+        return hits[i].Rank > hits[j].Rank
+    })
+
+    if len(hits) > int(query.Limit) {
+        hits = hits[:query.Limit]
+    }
+
+    return hits, nil
+}
@@ -0,0 +1,193 @@
+package platform
+
+import (
+    "context"
+    "fmt"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+)
+
+// GetSyllabus returns the topics of a course in learning path order with their projects and totals.
+func (us *UseCase) GetSyllabus(ctx context.Context, courseID int) (entity.Syllabus, error) {
+    course, err := us.GetCourseById(ctx, courseID)
+    if err != nil {
+        return entity.Syllabus{}, fmt.Errorf("platform - GetSyllabus - us.GetCourseById: %w", err)
+    }
+
+    topics, err := us.postgresRepo.GetCourseSyllabus(ctx, courseID)
+    if err != nil {
+        return entity.Syllabus{}, fmt.Errorf("platform - GetSyllabus - postgresRepo.GetCourseSyllabus: %w", err)
+    }
+
+    syllabus := entity.Syllabus{
+        CourseID:   course.CourseID,
+        CourseName: course.Name,
+        Topics:     topics,
+    }
+
+    for _, topic := range topics {
+        syllabus.TotalLaborIntensityHours += topic.LaborIntensityHours
+        syllabus.TotalProjects += topic.ProjectsNumber
+    }
+
+    return syllabus, nil
+}
+
+// GetCourseSyllabi returns the topics of several courses keyed by course ID, without the totals.
+func (us *UseCase) GetCourseSyllabi(ctx context.Context, courseIDs []int) (map[int][]entity.SyllabusTopic, error) {
+    topics, err := us.postgresRepo.GetCourseSyllabi(ctx, courseIDs)
+    if err != nil {
+        return nil, fmt.Errorf("platform - GetCourseSyllabi - postgresRepo.GetCourseSyllabi: %w", err)
+    }
+
+    return topics, nil
+}
+
+// CreateCourseTopic adds a topic to the end of the course.
+func (us *UseCase) CreateCourseTopic(ctx context.Context, courseID int, topic entity.CourseTopic) (entity.SyllabusTopic, error) {
+    var created entity.SyllabusTopic
+
+    err := us.postgresRepo.WithinTransaction(ctx, func(ctx context.Context) error {
+        if err := us.postgresRepo.LockCourse(ctx, courseID); err != nil {
+            return fmt.Errorf("postgresRepo.LockCourse: %w", err)
+        }
+
+        var err error
+
+        created, err = us.postgresRepo.CreateCourseTopic(ctx, courseID, topic)
+        if err != nil {
+            return fmt.Errorf("postgresRepo.CreateCourseTopic: %w", err)
+        }
+
+        return nil
+    })
+    if err != nil {
+        return entity.SyllabusTopic{}, fmt.Errorf("platform - CreateCourseTopic - postgresRepo.WithinTransaction: %w", err)
+    }
+
+    return created, nil
+}
+
+// UpdateCourseTopic edits a topic in every course that uses it.
+func (us *UseCase) UpdateCourseTopic(ctx context.Context, topic entity.CourseTopic) (entity.CourseTopic, error) {
+    updated, err := us.postgresRepo.UpdateCourseTopic(ctx, topic)
+    if err != nil {
+        return entity.CourseTopic{}, fmt.Errorf("platform - UpdateCourseTopic - postgresRepo.UpdateCourseTopic: %w", err)
+    }
+
+    return updated, nil
+}
+
+// RemoveCourseTopic removes a topic from the course, the following topics move up by one position.
+func (us *UseCase) RemoveCourseTopic(ctx context.Context, courseID, topicID int) error {
+    err := us.postgresRepo.WithinTransaction(ctx, func(ctx context.Context) error {
+        if err := us.postgresRepo.LockCourse(ctx, courseID); err != nil {
+            return fmt.Errorf("postgresRepo.LockCourse: %w", err)
+        }
+
+        if err := us.postgresRepo.RemoveCourseTopic(ctx, courseID, topicID); err != nil {
+            return fmt.Errorf("postgresRepo.RemoveCourseTopic: %w", err)
+        }
+
+        // Close the gap left by the removed topic
+        remaining, err := us.postgresRepo.GetCourseTopicIDs(ctx, courseID)
+        if err != nil {
+            return fmt.Errorf("postgresRepo.GetCourseTopicIDs: %w", err)
+        }
+
+        if len(remaining) == 0 {
+            return nil
+        }
+
+        if err = us.postgresRepo.ReorderCourseTopics(ctx, courseID, remaining); err != nil {
+            return fmt.Errorf("postgresRepo.ReorderCourseTopics: %w", err)
+        }
+
+        return nil
+    })
+    if err != nil {
+        return fmt.Errorf("platform - RemoveCourseTopic - postgresRepo.WithinTransaction: %w", err)
+    }
+
+    return nil
+}
+
+// ReorderCourseTopics changes the order of the topics, topicIDs must list every topic of the course exactly once.
+func (us *UseCase) ReorderCourseTopics(ctx context.Context, courseID int, topicIDs []int) error {
+    err := us.postgresRepo.WithinTransaction(ctx, func(ctx context.Context) error {
+        if err := us.postgresRepo.LockCourse(ctx, courseID); err != nil {
+            return fmt.Errorf("postgresRepo.LockCourse: %w", err)
+        }
+
+        current, err := us.postgresRepo.GetCourseTopicIDs(ctx, courseID)
+        if err != nil {
+            return fmt.Errorf("postgresRepo.GetCourseTopicIDs: %w", err)
+        }
+
+        if !isPermutation(current, topicIDs) {
+            return entity.ErrInvalidTopicOrder
+        }
+
+        if err = us.postgresRepo.ReorderCourseTopics(ctx, courseID, topicIDs); err != nil {
+            return fmt.Errorf("postgresRepo.ReorderCourseTopics: %w", err)
+        }
+
+        return nil
+    })
+    if err != nil {
+        return fmt.Errorf("platform - ReorderCourseTopics - postgresRepo.WithinTransaction: %w", err)
+    }
+
+    return nil
+}
+
+// CreateProject adds a project to a topic, the projects number of the topic is kept in sync by the database.
+func (us *UseCase) CreateProject(ctx context.Context, project entity.Project) (entity.Project, error) {
+    created, err := us.postgresRepo.CreateProject(ctx, project)
+    if err != nil {
+        return entity.Project{}, fmt.Errorf("platform - CreateProject - postgresRepo.CreateProject: %w", err)
+    }
+
+    return created, nil
+}
+
+// UpdateProject edits a project or moves it to another topic.
+func (us *UseCase) UpdateProject(ctx context.Context, project entity.Project) (entity.Project, error) {
+    updated, err := us.postgresRepo.UpdateProject(ctx, project)
+    if err != nil {
+        return entity.Project{}, fmt.Errorf("platform - UpdateProject - postgresRepo.UpdateProject: %w", err)
+    }
+
+    return updated, nil
+}
+
+// DeleteProject -.
+func (us *UseCase) DeleteProject(ctx context.Context, projectID int) error {
+    if err := us.postgresRepo.DeleteProject(ctx, projectID); err != nil {
+        return fmt.Errorf("platform - DeleteProject - postgresRepo.DeleteProject: %w", err)
+    }
+
+    return nil
+}
+
+// isPermutation tells whether b contains exactly the elements of a, in any order.
+func isPermutation(a, b []int) bool {
+    if len(a) != len(b) {
+        return false
+    }
+
+    seen := make(map[int]bool, len(a))
+    for _, id := range a {
+        seen[id] = true
+    }
+
+    for _, id := range b {
+        if !seen[id] {
+            return false
+        }
+
+        delete(seen, id)
+    }
+
+    return true
+}
@@ -0,0 +1,160 @@
+package platform
+
+import (
+    "context"
+    "fmt"
+
+    "github.com/deadnotxaa/education-platform/backend/internal/entity"
+)
+
+const (
+    _defaultTeacherPageSize = 20
+    _maxTeacherPageSize     = 100
+)
+
+// ListTeachers returns a page of teacher profiles, their courses are only listed by GetTeacherProfile.
+func (us *UseCase) ListTeachers(ctx context.Context, afterID int, limit uint32) (entity.TeacherPage, error) {
+    if limit == 0 {
+        limit = _defaultTeacherPageSize
+    }
+    if limit > _maxTeacherPageSize {
+        limit = _maxTeacherPageSize
+    }
+
+    page, err := us.postgresRepo.ListTeacherProfiles(ctx, afterID, limit)
+    if err != nil {
+        return entity.TeacherPage{}, fmt.Errorf("platform - ListTeachers - postgresRepo.ListTeacherProfiles: %w", err)
+    }
+
+    return page, nil
+}
+
+// GetTeacherProfile returns a teacher profile with every course the teacher is assigned to in any staff role.
+func (us *UseCase) GetTeacherProfile(ctx context.Context, employeeID int) (entity.TeacherProfile, error) {
+    profile, err := us.postgresRepo.GetTeacherProfile(ctx, employeeID)
+    if err != nil {
+        return entity.TeacherProfile{}, fmt.Errorf("platform - GetTeacherProfile - postgresRepo.GetTeacherProfile: %w", err)
+    }
+
+    profile.Courses, err = us.postgresRepo.ListEmployeeCourses(ctx, employeeID)
+    if err != nil {
+        return entity.TeacherProfile{}, fmt.Errorf("platform - GetTeacherProfile - postgresRepo.ListEmployeeCourses: %w", err)
+    }
+
+    return profile, nil
+}
+
+// GetTeacherProfilesByIDs returns the teachers among the employees keyed by employee ID, without their courses.
+func (us *UseCase) GetTeacherProfilesByIDs(ctx context.Context, employeeIDs []int) (map[int]entity.TeacherProfile, error) {
+    profiles, err := us.postgresRepo.GetTeacherProfilesByIDs(ctx, employeeIDs)
+    if err != nil {
+        return nil, fmt.Errorf("platform - GetTeacherProfilesByIDs - postgresRepo.GetTeacherProfilesByIDs: %w", err)
+    }
+
+    byID := make(map[int]entity.TeacherProfile, len(profiles))
+    for _, profile := range profiles {
+        byID[profile.EmployeeID] = profile
+    }
+
+    return byID, nil
+}
+
+// ListEmployeeCoursesByEmployees -.
+func (us *UseCase) ListEmployeeCoursesByEmployees(ctx context.Context, employeeIDs []int) (map[int][]entity.TeacherCourse, error) {
+    courses, err := us.postgresRepo.ListEmployeeCoursesByEmployees(ctx, employeeIDs)
+    if err != nil {
+        return nil, fmt.Errorf("platform - ListEmployeeCoursesByEmployees - postgresRepo.ListEmployeeCoursesByEmployees: %w", err)
+    }
+
+    return courses, nil
+}
+
+// UpdateTeacherProfile creates or edits the profile of an employee with the Teacher role,
+// teachers edit their own profile, others require staff:manage.
+func (us *UseCase) UpdateTeacherProfile(ctx context.Context, accountID int, teacher entity.Teacher) (entity.TeacherProfile, error) {
+    employee, err := us.postgresRepo.GetEmployee(ctx, teacher.EmployeeID)
+    if err != nil {
+        return entity.TeacherProfile{}, fmt.Errorf("platform - UpdateTeacherProfile - postgresRepo.GetEmployee: %w", err)
+    }
+
+    if employee.UserID != accountID {
+        allowed, err := us.HasPermission(ctx, accountID, entity.PermissionStaffManage)
+        if err != nil {
+            return entity.TeacherProfile{}, fmt.Errorf("platform - UpdateTeacherProfile - us.HasPermission: %w", err)
+        }
+
+        if !allowed {
+            return entity.TeacherProfile{}, fmt.Errorf("platform - UpdateTeacherProfile: %w", entity.ErrForbidden)
+        }
+    }
+
+    if employee.RoleName != entity.RoleTeacher {
+        return entity.TeacherProfile{}, fmt.Errorf("platform - UpdateTeacherProfile: %w", entity.ErrStaffRoleMismatch)
+    }
+
+    if err = us.postgresRepo.UpsertTeacher(ctx, teacher); err != nil {
+        return entity.TeacherProfile{}, fmt.Errorf("platform - UpdateTeacherProfile - postgresRepo.UpsertTeacher: %w", err)
+    }
+
+    profile, err := us.GetTeacherProfile(ctx, teacher.EmployeeID)
+    if err != nil {
+        return entity.TeacherProfile{}, fmt.Errorf("platform - UpdateTeacherProfile - us.GetTeacherProfile: %w", err)
+    }
+
+    return profile, nil
+}
+
+// ListCourseStaff -.
+func (us *UseCase) ListCourseStaff(ctx context.Context, courseID int) ([]entity.CourseStaffMember, error) {
+    if _, err := us.GetCourseById(ctx, courseID); err != nil {
+        return nil, fmt.Errorf("platform - ListCourseStaff - us.GetCourseById: %w", err)
+    }
+
+    staff, err := us.postgresRepo.ListCourseStaff(ctx, courseID)
+    if err != nil {
+        return nil, fmt.Errorf("platform - ListCourseStaff - postgresRepo.ListCourseStaff: %w", err)
+    }
+
+    return staff, nil
+}
+
+// ListCourseStaffByCourses -.
+func (us *UseCase) ListCourseStaffByCourses(ctx context.Context, courseIDs []int) (map[int][]entity.CourseStaffMember, error) {
+    staff, err := us.postgresRepo.ListCourseStaffByCourses(ctx, courseIDs)
+    if err != nil {
+        return nil, fmt.Errorf("platform - ListCourseStaffByCourses - postgresRepo.ListCourseStaffByCourses: %w", err)
+    }
+
+    return staff, nil
+}
+
+// AssignCourseStaff gives an employee a role in a course, the employee's own role must be the same.
+func (us *UseCase) AssignCourseStaff(ctx context.Context, member entity.CourseStaffMember) error {
+    if _, err := us.GetCourseById(ctx, member.CourseID); err != nil {
+        return fmt.Errorf("platform - AssignCourseStaff - us.GetCourseById: %w", err)
+    }
+
+    employee, err := us.postgresRepo.GetEmployee(ctx, member.EmployeeID)
+    if err != nil {
+        return fmt.Errorf("platform - AssignCourseStaff - postgresRepo.GetEmployee: %w", err)
+    }
+
+    if employee.RoleName != string(member.StaffRole) {
+        return fmt.Errorf("platform - AssignCourseStaff: %w", entity.ErrStaffRoleMismatch)
+    }
+
+    if err = us.postgresRepo.AssignCourseStaff(ctx, member); err != nil {
+        return fmt.Errorf("platform - AssignCourseStaff - postgresRepo.AssignCourseStaff: %w", err)
+    }
+
+    return nil
+}
+
+// UnassignCourseStaff -.
+func (us *UseCase) UnassignCourseStaff(ctx context.Context, member entity.CourseStaffMember) error {
+    if err := us.postgresRepo.UnassignCourseStaff(ctx, member); err != nil {
+        return fmt.Errorf("platform - UnassignCourseStaff - postgresRepo.UnassignCourseStaff: %w", err)
+    }
+
+    return nil
+}
@@ -0,0 +1,32 @@
+package grpcserver
+
+import (
+    "net"
+    "time"
+
+    "google.golang.org/grpc"
+)
+
+// Option -.
+type Option func(*Server)
+
+// Port -.
+func Port(port string) Option {
+    return func(s *Server) {
+        s.address = net.JoinHostPort("", port)
+    }
+}
+
+// UnaryInterceptors - chained in the given order, the first one is the outermost.
+func UnaryInterceptors(interceptors ...grpc.UnaryServerInterceptor) Option {
+    return func(s *Server) {
+        s.interceptors = append(s.interceptors, interceptors...)
+    }
+}
+
+// ShutdownTimeout - how long in-flight calls may finish before they are cancelled.
+func ShutdownTimeout(timeout time.Duration) Option {
+    return func(s *Server) {
+        s.shutdownTimeout = timeout
+    }
+}
@@ -0,0 +1,83 @@
+// Package grpcserver implements gRPC server.
+package grpcserver
+
+import (
+    "fmt"
+    "net"
+    "time"
+
+    "google.golang.org/grpc"
+)
+
+const (
+    _defaultAddr            = ":81"
+    _defaultShutdownTimeout = 3 * time.Second
+)
+
+// Server -.
+type Server struct {
+    App    *grpc.Server
+    notify chan error
+
+    address         string
+    interceptors    []grpc.UnaryServerInterceptor
+    shutdownTimeout time.Duration
+}
+
+// New -.
+func New(opts ...Option) *Server {
+    s := &Server{
+        App:             nil,
+        notify:          make(chan error, 1),
+        address:         _defaultAddr,
+        shutdownTimeout: _defaultShutdownTimeout,
+    }
+
+    // Custom options
+    for _, opt := range opts {
+        opt(s)
+    }
+
+    s.App = grpc.NewServer(grpc.ChainUnaryInterceptor(s.interceptors...))
+
+    return s
+}
+
+// Start -.
+func (s *Server) Start() {
+    go func() {
+        ln, err := net.Listen("tcp", s.address)
+        if err != nil {
+            s.notify <- fmt.Errorf("grpcserver - Start - net.Listen: %w", err)
+            close(s.notify)
+
+            return
+        }
+
+        s.notify <- s.App.Serve(ln)
+        close(s.notify)
+    }()
+}
+
+// Notify -.
+func (s *Server) Notify() <-chan error {
+    return s.notify
+}
+
+// Shutdown waits for in-flight calls up to the shutdown timeout, then closes the remaining connections.
+func (s *Server) Shutdown() error {
+    stopped := make(chan struct{})
+
+    go func() {
+        s.App.GracefulStop()
+        close(stopped)
+    }()
+
+    select {
+    case <-stopped:
+    case <-time.After(s.shutdownTimeout):
+        s.App.Stop()
+    }
+
+    return nil
+}
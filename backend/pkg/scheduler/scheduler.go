@@ -0,0 +1,56 @@
+// Package scheduler implements periodic background jobs.
+package scheduler
+
+import (
+    "context"
+    "sync"
+    "time"
+)
+
+// Job - background work, it is expected to handle (log) its own errors.
+type Job func(ctx context.Context)
+
+// Scheduler -.
+type Scheduler struct {
+    ctx    context.Context
+    cancel context.CancelFunc
+    wg     sync.WaitGroup
+}
+
+// New -.
+func New() *Scheduler {
+    ctx, cancel := context.WithCancel(context.Background())
+
+    return &Scheduler{
+        ctx:    ctx,
+        cancel: cancel,
+    }
+}
+
+// Every runs the job every interval until Shutdown, the first run happens after one interval.
+// Runs of the same job never overlap.
+func (s *Scheduler) Every(interval time.Duration, job Job) {
+    s.wg.Add(1)
+
+    go func() {
+        defer s.wg.Done()
+
+        ticker := time.NewTicker(interval)
+        defer ticker.Stop()
+
+        for {
+            select {
+            case <-s.ctx.Done():
+                return
+            case <-ticker.C:
+                job(s.ctx)
+            }
+        }
+    }()
+}
+
+// Shutdown cancels the context of running jobs and waits for them to return.
+func (s *Scheduler) Shutdown() {
+    s.cancel()
+    s.wg.Wait()
+}
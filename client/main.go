@@ -1,8 +1,6 @@
 package main
 
 import (
-	"bytes"
-	"encoding/json"
 	"fmt"
 	"log"
 	"math/rand"
@@ -20,10 +18,6 @@ type Client struct {
 	rng              *rand.Rand
 }
 
-type ReportRequest struct {
-	LimitNumber int `json:"limit_number"`
-}
-
 func NewClient(baseURL string) *Client {
 	return &Client{
 		baseURL: baseURL,
@@ -43,26 +37,13 @@ func (c *Client) logRequest(endpoint, params string, responseTime time.Duration,
 
 func (c *Client) getCourse() {
 	courseID := c.rng.Intn(1000) + 1
-	url := fmt.Sprintf("%s/v1/course/getcourse", c.baseURL)
-
-	// Create JSON body as expected by the backend
-	requestData := map[string]int{
-		"id": courseID,
-	}
-
-	jsonData, err := json.Marshal(requestData)
-	if err != nil {
-		log.Printf("Error marshaling JSON: %v", err)
-		return
-	}
+	url := fmt.Sprintf("%s/v2/courses/%d", c.baseURL, courseID)
 
-	// Create GET request with body (unusual but that's what your backend expects)
-	req, err := http.NewRequest("GET", url, bytes.NewBuffer(jsonData))
+	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
 		log.Printf("Error creating request: %v", err)
 		return
 	}
-	req.Header.Set("Content-Type", "application/json")
 
 	start := time.Now()
 	resp, err := c.httpClient.Do(req)
@@ -78,31 +59,18 @@ func (c *Client) getCourse() {
 		}
 	}()
 
-	c.logRequest("GET /v1/course/getcourse", fmt.Sprintf("id=%d", courseID), responseTime, resp.StatusCode)
+	c.logRequest("GET /v2/courses/{id}", fmt.Sprintf("id=%d", courseID), responseTime, resp.StatusCode)
 }
 
 func (c *Client) getUser() {
 	userID := c.rng.Intn(1000) + 1
-	url := fmt.Sprintf("%s/v1/user/getuser", c.baseURL)
-
-	// Create JSON body as expected by the backend
-	requestData := map[string]int{
-		"id": userID,
-	}
+	url := fmt.Sprintf("%s/v2/users/%d", c.baseURL, userID)
 
-	jsonData, err := json.Marshal(requestData)
-	if err != nil {
-		log.Printf("Error marshaling JSON: %v", err)
-		return
-	}
-
-	// Create GET request with body (unusual but that's what your backend expects)
-	req, err := http.NewRequest("GET", url, bytes.NewBuffer(jsonData))
+	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
 		log.Printf("Error creating request: %v", err)
 		return
 	}
-	req.Header.Set("Content-Type", "application/json")
 
 	start := time.Now()
 	resp, err := c.httpClient.Do(req)
@@ -118,7 +86,7 @@ func (c *Client) getUser() {
 		}
 	}()
 
-	c.logRequest("GET /v1/user/getuser", fmt.Sprintf("id=%d", userID), responseTime, resp.StatusCode)
+	c.logRequest("GET /v2/users/{id}", fmt.Sprintf("id=%d", userID), responseTime, resp.StatusCode)
 }
 
 func (c *Client) getTopCoursesReport() {
@@ -131,7 +99,7 @@ func (c *Client) getTopCoursesReport() {
 		limitNumber = c.usedReportLimits[c.rng.Intn(len(c.usedReportLimits))]
 		cacheIndicator = "(cached)"
 	} else {
-		limitNumber = c.rng.Intn(1000) + 1
+		limitNumber = c.rng.Intn(100) + 1
 		cacheIndicator = "(fresh)"
 
 		// Store this limit for future cache testing (keep only last 10)
@@ -142,25 +110,13 @@ func (c *Client) getTopCoursesReport() {
 	}
 	c.mu.Unlock()
 
-	url := fmt.Sprintf("%s/v1/report/get-top-courses-report", c.baseURL)
-
-	requestData := ReportRequest{
-		LimitNumber: limitNumber,
-	}
-
-	jsonData, err := json.Marshal(requestData)
-	if err != nil {
-		log.Printf("Error marshaling JSON: %v", err)
-		return
-	}
+	url := fmt.Sprintf("%s/v2/reports/top-courses?limit=%d", c.baseURL, limitNumber)
 
-	// Create GET request with body (unusual but that's what your backend expects)
-	req, err := http.NewRequest("GET", url, bytes.NewBuffer(jsonData))
+	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
 		log.Printf("Error creating request: %v", err)
 		return
 	}
-	req.Header.Set("Content-Type", "application/json")
 
 	start := time.Now()
 	resp, err := c.httpClient.Do(req)
@@ -177,7 +133,7 @@ func (c *Client) getTopCoursesReport() {
 	}()
 
 	params := fmt.Sprintf("limit=%d %s", limitNumber, cacheIndicator)
-	c.logRequest("GET /v1/report/get-top-courses-report", params, responseTime, resp.StatusCode)
+	c.logRequest("GET /v2/reports/top-courses", params, responseTime, resp.StatusCode)
 }
 
 func (c *Client) frequentRequestsWorker() {
@@ -0,0 +1,25 @@
+-- Purchases reserve a seat in a specific cohort
+ALTER TABLE purchase ADD COLUMN IF NOT EXISTS course_calendar_id INTEGER REFERENCES course_calendar(id);
+
+CREATE INDEX idx_purchase_course_calendar_id ON purchase(course_calendar_id);
+
+-- Seeded data is not checked, new and updated rows are
+ALTER TABLE course_calendar
+    ADD CONSTRAINT course_calendar_remaining_places_check CHECK (remaining_places >= 0) NOT VALID;
+
+ALTER TABLE purchase
+    ADD CONSTRAINT purchase_status_check CHECK (purchase_status IN ('Pending', 'Completed', 'Cancelled')) NOT VALID;
+
+INSERT INTO permission (name, description) VALUES
+    ('purchase:manage', 'Complete and cancel purchases of any user')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permission (role_id, permission_id)
+SELECT r.id, p.id
+FROM (VALUES
+    ('Administrator', 'purchase:manage'),
+    ('Support', 'purchase:manage')
+) AS m(role_name, permission_name)
+JOIN role r ON r.name = m.role_name
+JOIN permission p ON p.name = m.permission_name
+ON CONFLICT DO NOTHING;
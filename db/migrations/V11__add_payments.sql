@@ -0,0 +1,12 @@
+-- Payment provider's intent that pays for the purchase
+ALTER TABLE purchase ADD COLUMN IF NOT EXISTS payment_intent_id VARCHAR(255);
+
+CREATE UNIQUE INDEX idx_purchase_payment_intent_id ON purchase(payment_intent_id) WHERE payment_intent_id IS NOT NULL;
+
+-- Webhook events already applied, providers may deliver the same event several times
+CREATE TABLE IF NOT EXISTS processed_payment_event (
+    event_id VARCHAR(255) PRIMARY KEY,
+    event_type VARCHAR(50) NOT NULL,
+    payment_intent_id VARCHAR(255) NOT NULL,
+    processed_at timestamptz NOT NULL DEFAULT NOW()
+);
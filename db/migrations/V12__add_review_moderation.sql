@@ -0,0 +1,44 @@
+-- Reviews posted through the API wait for moderation, existing (seeded) reviews are treated as approved
+ALTER TABLE course_review
+    ADD COLUMN IF NOT EXISTS moderation_status VARCHAR(20) NOT NULL DEFAULT 'Approved',
+    ADD COLUMN IF NOT EXISTS moderated_by INTEGER REFERENCES users(account_id),
+    ADD COLUMN IF NOT EXISTS moderated_at TIMESTAMP;
+
+ALTER TABLE course_review
+    ADD CONSTRAINT course_review_moderation_status_check
+        CHECK (moderation_status IN ('Pending', 'Approved', 'Rejected')),
+    ADD CONSTRAINT course_review_rating_check CHECK (rating BETWEEN 1 AND 5);
+
+-- One review per user per course, the latest seeded duplicate is kept
+DELETE FROM course_review a
+USING course_review b
+WHERE a.course_id = b.course_id
+  AND a.user_id = b.user_id
+  AND a.review_id < b.review_id;
+
+CREATE UNIQUE INDEX uq_course_review_course_user ON course_review(course_id, user_id);
+
+-- Only approved reviews count towards ratings
+DROP INDEX IF EXISTS idx_course_review_course_id;
+CREATE INDEX idx_course_review_course_id ON course_review(course_id) INCLUDE (rating)
+    WHERE moderation_status = 'Approved';
+
+-- Moderation queue, oldest first
+CREATE INDEX idx_course_review_pending ON course_review(review_id) WHERE moderation_status = 'Pending';
+
+-- Eligibility check: completed purchase of the course by the user
+CREATE INDEX idx_purchase_user_course_status ON purchase(user_id, course_id, purchase_status);
+
+INSERT INTO permission (name, description) VALUES
+    ('review:moderate', 'Approve, reject and delete course reviews')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permission (role_id, permission_id)
+SELECT r.id, p.id
+FROM (VALUES
+    ('Administrator', 'review:moderate'),
+    ('Support', 'review:moderate')
+) AS m(role_name, permission_name)
+JOIN role r ON r.name = m.role_name
+JOIN permission p ON p.name = m.permission_name
+ON CONFLICT DO NOTHING;
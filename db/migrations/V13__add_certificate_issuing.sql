@@ -0,0 +1,19 @@
+-- Lookup of the certificate of a student for a course and of all certificates of a student
+CREATE INDEX idx_certificate_user_course ON certificate(user_id, course_id);
+
+-- Teacher names printed on certificates
+CREATE INDEX idx_course_teacher_course_id ON course_teacher(course_id);
+
+INSERT INTO permission (name, description) VALUES
+    ('certificate:issue', 'Mark a course as completed by a student and issue the certificate')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permission (role_id, permission_id)
+SELECT r.id, p.id
+FROM (VALUES
+    ('Administrator', 'certificate:issue'),
+    ('Teacher', 'certificate:issue')
+) AS m(role_name, permission_name)
+JOIN role r ON r.name = m.role_name
+JOIN permission p ON p.name = m.permission_name
+ON CONFLICT DO NOTHING;
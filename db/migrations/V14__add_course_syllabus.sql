@@ -0,0 +1,57 @@
+-- Explicit order of topics within a course, topics can be shared by several courses
+ALTER TABLE course_topic_association ADD COLUMN IF NOT EXISTS position SMALLINT;
+
+UPDATE course_topic_association cta
+SET position = o.position
+FROM (
+    SELECT course_id, topic_id, ROW_NUMBER() OVER (PARTITION BY course_id ORDER BY topic_id) AS position
+    FROM course_topic_association
+) o
+WHERE o.course_id = cta.course_id AND o.topic_id = cta.topic_id;
+
+ALTER TABLE course_topic_association
+    ALTER COLUMN position SET NOT NULL,
+    ADD CONSTRAINT course_topic_association_position_check CHECK (position > 0),
+    ADD CONSTRAINT uq_course_topic_association_position UNIQUE (course_id, position);
+
+-- projects_number always equals the number of project rows of the topic
+UPDATE course_topic ct
+SET projects_number = (SELECT COUNT(*) FROM project p WHERE p.topic_id = ct.id);
+
+ALTER TABLE course_topic
+    ALTER COLUMN projects_number SET DEFAULT 0,
+    ALTER COLUMN projects_number SET NOT NULL,
+    ADD CONSTRAINT course_topic_projects_number_check CHECK (projects_number >= 0);
+
+CREATE INDEX idx_project_topic_id ON project(topic_id);
+
+-- Written values are ignored, the counter is derived from the project table
+CREATE OR REPLACE FUNCTION set_course_topic_projects_number()
+RETURNS TRIGGER AS $$
+BEGIN
+    SELECT COUNT(*) INTO NEW.projects_number FROM project WHERE topic_id = NEW.id;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_course_topic_set_projects_number
+BEFORE INSERT OR UPDATE ON course_topic
+FOR EACH ROW EXECUTE FUNCTION set_course_topic_projects_number();
+
+-- Touching the topic row lets trg_course_topic_set_projects_number recount its projects
+CREATE OR REPLACE FUNCTION sync_course_topic_projects_number()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP IN ('UPDATE', 'DELETE') THEN
+        UPDATE course_topic SET projects_number = projects_number WHERE id = OLD.topic_id;
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') THEN
+        UPDATE course_topic SET projects_number = projects_number WHERE id = NEW.topic_id;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_project_sync_projects_number
+AFTER INSERT OR DELETE OR UPDATE OF topic_id ON project
+FOR EACH ROW EXECUTE FUNCTION sync_course_topic_projects_number();
@@ -0,0 +1,42 @@
+-- Progress of a student through the topics of a purchased course, a missing row means the topic isn't started
+CREATE TABLE IF NOT EXISTS topic_progress (
+    user_id INTEGER REFERENCES users(account_id),
+    course_id INTEGER REFERENCES course(course_id),
+    topic_id INTEGER REFERENCES course_topic(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('InProgress', 'Completed')),
+    started_at TIMESTAMP NOT NULL,
+    completed_at TIMESTAMP,
+    PRIMARY KEY (user_id, course_id, topic_id)
+);
+
+-- Latest solution of a project by a student, graded by a Reviewer
+CREATE TABLE IF NOT EXISTS project_submission (
+    submission_id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(account_id),
+    course_id INTEGER NOT NULL REFERENCES course(course_id),
+    project_id INTEGER NOT NULL REFERENCES project(project_id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('Submitted', 'Accepted', 'Rejected')),
+    solution_url VARCHAR(2048) NOT NULL,
+    submitted_at TIMESTAMP NOT NULL,
+    reviewer_id INTEGER REFERENCES employee(id),
+    grade SMALLINT CHECK (grade BETWEEN 0 AND 100),
+    review_comment TEXT,
+    reviewed_at TIMESTAMP,
+    CONSTRAINT uq_project_submission_user_course_project UNIQUE (user_id, course_id, project_id)
+);
+
+-- Review queue, oldest first
+CREATE INDEX idx_project_submission_submitted ON project_submission(submission_id) WHERE status = 'Submitted';
+
+INSERT INTO permission (name, description) VALUES
+    ('project:review', 'Grade project submissions of students')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permission (role_id, permission_id)
+SELECT r.id, p.id
+FROM (VALUES
+    ('Reviewer', 'project:review')
+) AS m(role_name, permission_name)
+JOIN role r ON r.name = m.role_name
+JOIN permission p ON p.name = m.permission_name
+ON CONFLICT DO NOTHING;
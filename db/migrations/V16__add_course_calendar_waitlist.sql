@@ -0,0 +1,27 @@
+-- Upcoming cohorts of a course
+CREATE INDEX idx_course_calendar_course_start_date ON course_calendar(course_id, start_date);
+
+-- Users queued for a sold out cohort. A freed seat is held for the first waiting user until offer_expires_at,
+-- then it goes to the next one; held seats are not counted in course_calendar.remaining_places
+CREATE TABLE IF NOT EXISTS waitlist_entry (
+    entry_id SERIAL PRIMARY KEY,
+    course_calendar_id INTEGER NOT NULL REFERENCES course_calendar(id),
+    user_id INTEGER NOT NULL REFERENCES users(account_id),
+    status VARCHAR(20) NOT NULL CHECK (status IN ('Waiting', 'Offered', 'Claimed', 'Expired', 'Left')),
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    offered_at timestamptz,
+    offer_expires_at timestamptz,
+    CONSTRAINT waitlist_entry_offer_check CHECK (status <> 'Offered' OR offer_expires_at IS NOT NULL)
+);
+
+-- A user is queued for a cohort at most once at a time
+CREATE UNIQUE INDEX uq_waitlist_entry_active ON waitlist_entry(course_calendar_id, user_id)
+    WHERE status IN ('Waiting', 'Offered');
+
+-- Next user in FIFO order
+CREATE INDEX idx_waitlist_entry_waiting ON waitlist_entry(course_calendar_id, entry_id) WHERE status = 'Waiting';
+
+-- Expired holds, picked up by the background job
+CREATE INDEX idx_waitlist_entry_offer_expires_at ON waitlist_entry(offer_expires_at) WHERE status = 'Offered';
+
+CREATE INDEX idx_waitlist_entry_user_id ON waitlist_entry(user_id);
@@ -0,0 +1,33 @@
+-- A graduate is enrolled into career support once per course: duplicates are merged into the oldest record
+UPDATE job_application ja
+SET student_id = k.keep_id
+FROM (
+    SELECT id, MIN(id) OVER (PARTITION BY user_id, course_id) AS keep_id
+    FROM career_center_student
+) k
+WHERE ja.student_id = k.id AND k.id <> k.keep_id;
+
+DELETE FROM career_center_student c
+USING career_center_student k
+WHERE c.user_id = k.user_id AND c.course_id = k.course_id AND c.id > k.id;
+
+ALTER TABLE career_center_student
+    ADD CONSTRAINT career_center_student_user_course_key UNIQUE (user_id, course_id);
+
+-- Support ends career_support_start + support_period days, used to find expiring support periods
+CREATE INDEX idx_career_center_student_support_end
+    ON career_center_student ((career_support_start + support_period));
+
+INSERT INTO permission (name, description) VALUES
+    ('career:manage', 'Enroll graduates into career support, manage their CVs and support periods')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permission (role_id, permission_id)
+SELECT r.id, p.id
+FROM (VALUES
+    ('Administrator', 'career:manage'),
+    ('Mentor', 'career:manage')
+) AS m(role_name, permission_name)
+JOIN role r ON r.name = m.role_name
+JOIN permission p ON p.name = m.permission_name
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,35 @@
+-- Statuses of the hiring pipeline: Submitted -> Screening -> Interview -> Offer -> Hired, Rejected from any open stage
+UPDATE job_application
+SET status = CASE status
+    WHEN 'Applied' THEN 'Submitted'
+    WHEN 'Interviewed' THEN 'Interview'
+    ELSE 'Submitted'
+END
+WHERE status IS NULL OR status NOT IN ('Submitted', 'Screening', 'Interview', 'Offer', 'Hired', 'Rejected');
+
+ALTER TABLE job_application
+    ALTER COLUMN status SET DEFAULT 'Submitted',
+    ALTER COLUMN status SET NOT NULL,
+    ADD CONSTRAINT job_application_status_check
+        CHECK (status IN ('Submitted', 'Screening', 'Interview', 'Offer', 'Hired', 'Rejected'));
+
+CREATE INDEX idx_job_application_student_id ON job_application(student_id);
+
+-- Every status change of an application, actor is the account that made it
+CREATE TABLE IF NOT EXISTS job_application_status_history (
+    id SERIAL PRIMARY KEY,
+    application_id INTEGER NOT NULL REFERENCES job_application(id) ON DELETE CASCADE,
+    from_status VARCHAR(50),
+    to_status VARCHAR(50) NOT NULL,
+    actor_id INTEGER REFERENCES users(account_id),
+    comment TEXT,
+    changed_at timestamptz NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_job_application_status_history_application_id
+    ON job_application_status_history(application_id, id);
+
+-- Existing applications start their history with the current status, the actor is unknown
+INSERT INTO job_application_status_history (application_id, to_status, changed_at)
+SELECT id, status, COALESCE(application_date, NOW())
+FROM job_application;
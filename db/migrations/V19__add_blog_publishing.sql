@@ -0,0 +1,32 @@
+-- Posts are written as drafts, optionally scheduled and then published; existing posts are already published
+ALTER TABLE blog_post
+    ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'Published'
+        CHECK (status IN ('Draft', 'Scheduled', 'Published'));
+
+-- Posts without a publication date were never published
+UPDATE blog_post SET status = 'Draft' WHERE publication_date IS NULL;
+
+ALTER TABLE blog_post
+    ADD CONSTRAINT blog_post_scheduled_check CHECK (status = 'Draft' OR publication_date IS NOT NULL);
+
+-- Public listing, newest first, optionally filtered by topic or tag
+CREATE INDEX idx_blog_post_published ON blog_post(publication_date DESC, post_id DESC) WHERE status = 'Published';
+CREATE INDEX idx_blog_post_topic ON blog_post(topic);
+CREATE INDEX idx_post_tag_tag_id ON post_tag(tag_id);
+
+-- Scheduled posts due for publication, picked up by the background job
+CREATE INDEX idx_blog_post_scheduled ON blog_post(publication_date) WHERE status = 'Scheduled';
+
+INSERT INTO permission (name, description) VALUES
+    ('blog:write', 'Write, schedule and publish blog posts')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permission (role_id, permission_id)
+SELECT r.id, p.id
+FROM (VALUES
+    ('Administrator', 'blog:write'),
+    ('SMM-manager', 'blog:write')
+) AS m(role_name, permission_name)
+JOIN role r ON r.name = m.role_name
+JOIN permission p ON p.name = m.permission_name
+ON CONFLICT DO NOTHING;
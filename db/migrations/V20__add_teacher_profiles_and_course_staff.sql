@@ -0,0 +1,31 @@
+-- Public teacher profile
+ALTER TABLE teacher
+    ADD COLUMN IF NOT EXISTS photo_url VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS bio TEXT;
+
+-- Course staff: teachers, mentors and reviewers, existing rows are teachers. Mentors and reviewers have no teacher
+-- profile, so staff references employee instead of teacher
+ALTER TABLE course_teacher
+    ADD COLUMN IF NOT EXISTS staff_role VARCHAR(20) NOT NULL DEFAULT 'Teacher'
+        CHECK (staff_role IN ('Teacher', 'Mentor', 'Reviewer'));
+
+ALTER TABLE course_teacher DROP CONSTRAINT IF EXISTS course_teacher_teacher_id_fkey;
+ALTER TABLE course_teacher
+    ADD CONSTRAINT course_teacher_teacher_id_fkey FOREIGN KEY (teacher_id) REFERENCES employee(id);
+
+-- The same employee may teach and review a course
+ALTER TABLE course_teacher DROP CONSTRAINT IF EXISTS course_teacher_pkey;
+ALTER TABLE course_teacher ADD PRIMARY KEY (teacher_id, course_id, staff_role);
+
+INSERT INTO permission (name, description) VALUES
+    ('staff:manage', 'Assign teachers, mentors and reviewers to courses and edit teacher profiles')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permission (role_id, permission_id)
+SELECT r.id, p.id
+FROM (VALUES
+    ('Administrator', 'staff:manage')
+) AS m(role_name, permission_name)
+JOIN role r ON r.name = m.role_name
+JOIN permission p ON p.name = m.permission_name
+ON CONFLICT DO NOTHING;
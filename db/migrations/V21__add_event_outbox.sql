@@ -0,0 +1,19 @@
+-- Transactional outbox: domain events are inserted in the transaction of the change and published to the broker
+-- by the relay, an event is retried with backoff until it is published or runs out of attempts
+CREATE TABLE IF NOT EXISTS outbox_event (
+    event_id BIGSERIAL PRIMARY KEY,
+    event_type VARCHAR(100) NOT NULL,
+    event_version INTEGER NOT NULL,
+    aggregate_id INTEGER NOT NULL,
+    payload JSONB NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at timestamptz NOT NULL DEFAULT NOW(), -- Also the end of the lease of a claimed event
+    last_error TEXT,
+    published_at timestamptz,
+    failed_at timestamptz -- Gave up after the last attempt
+);
+
+-- Events due for publishing, picked up by the relay in insertion order
+CREATE INDEX idx_outbox_event_pending ON outbox_event(next_attempt_at, event_id)
+    WHERE published_at IS NULL AND failed_at IS NULL;
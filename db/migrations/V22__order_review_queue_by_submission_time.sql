@@ -0,0 +1,5 @@
+-- Review queue, oldest first: a resubmitted project keeps its ID, so the queue is ordered by submission time
+DROP INDEX IF EXISTS idx_project_submission_submitted;
+
+CREATE INDEX idx_project_submission_submitted ON project_submission(submitted_at, submission_id)
+    WHERE status = 'Submitted';
@@ -0,0 +1,24 @@
+-- A graduate has one open application per company: older open duplicates are rejected, the newest one is kept
+WITH duplicates AS (
+    SELECT id, status
+    FROM (
+        SELECT id, status, ROW_NUMBER() OVER (PARTITION BY student_id, company_id ORDER BY id DESC) AS rn
+        FROM job_application
+        WHERE status NOT IN ('Hired', 'Rejected') AND student_id IS NOT NULL AND company_id IS NOT NULL
+    ) a
+    WHERE rn > 1
+), rejected AS (
+    UPDATE job_application ja
+    SET status = 'Rejected'
+    FROM duplicates d
+    WHERE ja.id = d.id
+    RETURNING ja.id
+)
+INSERT INTO job_application_status_history (application_id, from_status, to_status, comment)
+SELECT d.id, d.status, 'Rejected', 'Duplicate of a newer application to the company'
+FROM duplicates d
+JOIN rejected r ON r.id = d.id;
+
+CREATE UNIQUE INDEX idx_job_application_open_student_company
+    ON job_application(student_id, company_id)
+    WHERE status NOT IN ('Hired', 'Rejected');
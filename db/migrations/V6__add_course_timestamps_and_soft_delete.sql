@@ -0,0 +1,26 @@
+-- Backfill missing timestamps so the columns can become NOT NULL
+UPDATE course SET created_at = NOW() WHERE created_at IS NULL;
+UPDATE course SET updated_at = created_at WHERE updated_at IS NULL;
+
+ALTER TABLE course
+    ALTER COLUMN created_at SET DEFAULT NOW(),
+    ALTER COLUMN created_at SET NOT NULL,
+    ALTER COLUMN updated_at SET DEFAULT NOW(),
+    ALTER COLUMN updated_at SET NOT NULL,
+    ADD COLUMN IF NOT EXISTS deleted_at timestamptz;
+
+-- Keeps updated_at in sync on every row modification
+CREATE OR REPLACE FUNCTION set_updated_at()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = NOW();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_course_set_updated_at
+BEFORE UPDATE ON course
+FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- Most reads only touch courses that were not soft-deleted
+CREATE INDEX idx_course_not_deleted ON course(course_id) WHERE deleted_at IS NULL;
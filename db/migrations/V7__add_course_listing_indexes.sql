@@ -0,0 +1,10 @@
+-- Keyset pagination of the course catalog: (sort column, course_id) over non-deleted courses
+CREATE INDEX idx_course_price_id ON course((COALESCE(price, 0)), course_id) WHERE deleted_at IS NULL;
+CREATE INDEX idx_course_created_at_id ON course(created_at, course_id) WHERE deleted_at IS NULL;
+
+-- Catalog filters
+CREATE INDEX idx_course_difficulty_level_id ON course(difficulty_level_id) WHERE deleted_at IS NULL;
+CREATE INDEX idx_course_duration ON course(duration) WHERE deleted_at IS NULL;
+
+-- Average rating per course
+CREATE INDEX idx_course_review_course_id ON course_review(course_id) INCLUDE (rating);
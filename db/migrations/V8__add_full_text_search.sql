@@ -0,0 +1,27 @@
+-- Full-text search vectors, names/titles weigh more than descriptions and content
+ALTER TABLE course
+    ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('english', COALESCE(name, '')), 'A') ||
+        setweight(to_tsvector('english', COALESCE(description, '')), 'B')
+    ) STORED;
+
+ALTER TABLE course_topic
+    ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('english', COALESCE(name, '')), 'A') ||
+        setweight(to_tsvector('english', COALESCE(technologies, '')), 'A') ||
+        setweight(to_tsvector('english', COALESCE(description, '')), 'C')
+    ) STORED;
+
+ALTER TABLE blog_post
+    ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('english', COALESCE(title, '')), 'A') ||
+        setweight(to_tsvector('english', COALESCE(topic, '')), 'B') ||
+        setweight(to_tsvector('english', COALESCE(content, '')), 'C')
+    ) STORED;
+
+CREATE INDEX idx_course_search_vector ON course USING GIN (search_vector);
+CREATE INDEX idx_course_topic_search_vector ON course_topic USING GIN (search_vector);
+CREATE INDEX idx_blog_post_search_vector ON blog_post USING GIN (search_vector);
+
+-- Topic hits are mapped back to their courses
+CREATE INDEX idx_course_topic_association_topic_id ON course_topic_association(topic_id);
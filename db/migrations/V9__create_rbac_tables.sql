@@ -0,0 +1,46 @@
+-- Normalize role names used by the seeder to the platform's staff roles
+UPDATE role SET name = 'Administrator' WHERE name = 'admin';
+UPDATE role SET name = 'Teacher' WHERE name = 'teacher';
+UPDATE role SET name = 'Mentor' WHERE name = 'mentor';
+UPDATE role SET name = 'Support' WHERE name = 'technical support';
+UPDATE role SET name = 'SMM-manager' WHERE name = 'smm manager';
+
+INSERT INTO role (name)
+SELECT r.name
+FROM (VALUES ('Administrator'), ('Teacher'), ('Mentor'), ('Reviewer'), ('Support'), ('SMM-manager')) AS r(name)
+WHERE NOT EXISTS (SELECT 1 FROM role WHERE role.name = r.name);
+
+ALTER TABLE role ADD CONSTRAINT role_name_key UNIQUE (name);
+
+CREATE TABLE IF NOT EXISTS permission (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) UNIQUE NOT NULL,
+    description TEXT
+);
+
+CREATE TABLE IF NOT EXISTS role_permission (
+    role_id INTEGER REFERENCES role(id) ON DELETE CASCADE,
+    permission_id INTEGER REFERENCES permission(id) ON DELETE CASCADE,
+    PRIMARY KEY (role_id, permission_id)
+);
+
+INSERT INTO permission (name, description) VALUES
+    ('course:write', 'Create, update and delete courses'),
+    ('user:read', 'Read personal data of any user'),
+    ('report:read', 'Read analytical reports')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permission (role_id, permission_id)
+SELECT r.id, p.id
+FROM (VALUES
+    ('Administrator', 'course:write'),
+    ('Administrator', 'user:read'),
+    ('Administrator', 'report:read'),
+    ('Teacher', 'course:write'),
+    ('Support', 'user:read')
+) AS m(role_name, permission_name)
+JOIN role r ON r.name = m.role_name
+JOIN permission p ON p.name = m.permission_name
+ON CONFLICT DO NOTHING;
+
+CREATE INDEX idx_role_permission_permission_id ON role_permission(permission_id);
@@ -190,12 +190,35 @@ func seedCourseTopicAssociation(db *sql.DB, seedCount int, insertBatchSize int)
         return fmt.Errorf("failed to get course_topic count: %v", err)
     }
 
-    query := `INSERT INTO course_topic_association (course_id, topic_id)`
+    query := `INSERT INTO course_topic_association (course_id, topic_id, position)`
+
+    // A topic appears in a course only once, topics of a course are numbered 1..n
+    if maxPairs := courseCount * topicCount; seedCount > maxPairs {
+        seedCount = maxPairs
+    }
+
+    usedPairs := make(map[[2]int]struct{}, seedCount)
+    nextPosition := make(map[int]int, courseCount)
+    rowValues := make(map[int][3]int, seedCount) // Batch insert may request the same row twice
 
     dataFunc := func(i int) []interface{} {
+        row, ok := rowValues[i]
+        for !ok {
+            pair := [2]int{gofakeit.Number(1, courseCount), gofakeit.Number(1, topicCount)}
+
+            if _, used := usedPairs[pair]; !used {
+                usedPairs[pair] = struct{}{}
+                nextPosition[pair[0]]++
+                row = [3]int{pair[0], pair[1], nextPosition[pair[0]]}
+                rowValues[i] = row
+                ok = true
+            }
+        }
+
         return []interface{}{
-            gofakeit.Number(1, courseCount),
-            gofakeit.Number(1, topicCount),
+            row[0],
+            row[1],
+            row[2],
         }
     }
 
@@ -288,7 +288,7 @@ func seedJobApplication(db *sql.DB, seedCount int, insertBatchSize int) error {
             studentID,
             companyID,
             gofakeit.Date(),
-            gofakeit.RandomString([]string{"Applied", "Interviewed", "Rejected", "Hired"}),
+            gofakeit.RandomString([]string{"Submitted", "Screening", "Interview", "Offer", "Hired", "Rejected"}),
         }
     }
 
@@ -387,9 +387,26 @@ func seedCourseReview(db *sql.DB, seedCount int, insertBatchSize int) error {
 
     query := `INSERT INTO course_review (course_id, user_id, rating, comment, review_date)`
 
+    // A user can review a course only once
+    if maxPairs := len(courseIDs) * len(userIDs); seedCount > maxPairs {
+        seedCount = maxPairs
+    }
+
+    usedPairs := make(map[[2]int]struct{}, seedCount)
+    rowPairs := make(map[int][2]int, seedCount) // Batch insert may request the same row twice
+
     dataFunc := func(i int) []interface{} {
-        courseID := courseIDs[gofakeit.Number(0, len(courseIDs)-1)]
-        userID := userIDs[gofakeit.Number(0, len(userIDs)-1)]
+        pair, ok := rowPairs[i]
+        for !ok {
+            pair = [2]int{courseIDs[gofakeit.Number(0, len(courseIDs)-1)], userIDs[gofakeit.Number(0, len(userIDs)-1)]}
+
+            if _, used := usedPairs[pair]; !used {
+                usedPairs[pair] = struct{}{}
+                rowPairs[i] = pair
+                ok = true
+            }
+        }
+        courseID, userID := pair[0], pair[1]
 
         return []interface{}{
             courseID,